@@ -11,12 +11,14 @@ package main
 import (
 	"runtime"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
 	cr "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	grpcruntime "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime"
 	restruntime "github.com/cloud-barista/cb-spider/api-runtime/rest-runtime"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/spf13/cobra"
@@ -37,6 +39,16 @@ func NewRootCmd() *cobra.Command {
 	rootCmd := &cobra.Command{
 		Run: func(cmd *cobra.Command, args []string) {
 
+			// do not start with an unintended encryption key of the credentials
+			if err := cim.InitEncryptionKeys(); err != nil {
+				fmt.Printf("cb-spider terminated with error: %v\n", err)
+				os.Exit(1)
+			}
+
+			if err := cr.StartCallLogStore(); err != nil {
+				fmt.Printf("failed to start the call-log store: %v\n", err)
+			}
+
 			wg := new(sync.WaitGroup)
 
 			wg.Add(2)
@@ -0,0 +1,514 @@
+// Admission Policy Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+const RULE_NAME_COLUMN = "rule_name"
+
+// connection name of the global admission rules, applied to all connections
+const GLOBAL_ADMISSION_RULE = "*"
+
+// Admission Rule Types
+const (
+	ADMISSION_ALLOWED_VMSPEC    = "AllowedVMSpec"   // ValueList: VMSpec name patterns, ex) {"t3.*", "m5.large"}
+	ADMISSION_DENIED_CIDR_PORT  = "DeniedCIDRPort"  // ValueList: "<CIDR>:<Port(-Port)|*>", ex) {"0.0.0.0/0:22", "::/0:3389"}
+	ADMISSION_MAX_DISK_SIZE_GB  = "MaxDiskSizeGB"   // ValueList: {"<GB>"}, ex) {"500"}
+	ADMISSION_ALLOWED_REGION    = "AllowedRegion"   // ValueList: region name patterns, ex) {"ap-northeast-*"}
+	ADMISSION_REQUIRED_TAG_KEYS = "RequiredTagKeys" // ValueList: tag keys, ex) {"owner", "cost-center"}
+)
+
+// AdmissionRuleInfo is a declarative rule evaluated before the driver is called.
+type AdmissionRuleInfo struct {
+	ConnectionName string            `gorm:"primaryKey"` // "*": global rule
+	RuleName       string            `gorm:"primaryKey"`
+	RuleType       string            // AllowedVMSpec | DeniedCIDRPort | MaxDiskSizeGB | AllowedRegion | RequiredTagKeys
+	ValueList      infostore.StrList `gorm:"type:text"` // stored with json format
+	Description    string
+}
+
+func (AdmissionRuleInfo) TableName() string {
+	return "admission_rule_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&AdmissionRuleInfo{})
+	infostore.Close(db)
+}
+
+//================ Admission Rule Handler
+
+// SetAdmissionRule registers or replaces a rule.
+// An empty ConnectionName or "*" sets a global rule.
+func SetAdmissionRule(reqInfo AdmissionRuleInfo) (*AdmissionRuleInfo, error) {
+	cblog.Info("call SetAdmissionRule()")
+
+	reqInfo.ConnectionName = getAdmissionRuleConnectionName(reqInfo.ConnectionName)
+	ruleName, err := EmptyCheckAndTrim("RuleName", reqInfo.RuleName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	reqInfo.RuleName = ruleName
+
+	err = checkAdmissionRule(&reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err = infostore.Insert(&reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &reqInfo, nil
+}
+
+// ListAdmissionRule returns the rules of the connection, or all rules when the connectionName is empty.
+func ListAdmissionRule(connectionName string) ([]*AdmissionRuleInfo, error) {
+	cblog.Info("call ListAdmissionRule()")
+
+	var ruleList []*AdmissionRuleInfo
+	var err error
+	connectionName = strings.TrimSpace(connectionName)
+	if connectionName == "" {
+		err = infostore.List(&ruleList)
+	} else {
+		err = infostore.ListByCondition(&ruleList, CONNECTION_NAME_COLUMN, connectionName)
+	}
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return ruleList, nil
+}
+
+func GetAdmissionRule(connectionName string, ruleName string) (*AdmissionRuleInfo, error) {
+	cblog.Info("call GetAdmissionRule()")
+
+	connectionName = getAdmissionRuleConnectionName(connectionName)
+	ruleName, err := EmptyCheckAndTrim("ruleName", ruleName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var rule AdmissionRuleInfo
+	err = infostore.GetByConditions(&rule, CONNECTION_NAME_COLUMN, connectionName, RULE_NAME_COLUMN, ruleName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+func DeleteAdmissionRule(connectionName string, ruleName string) (bool, error) {
+	cblog.Info("call DeleteAdmissionRule()")
+
+	connectionName = getAdmissionRuleConnectionName(connectionName)
+	ruleName, err := EmptyCheckAndTrim("ruleName", ruleName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	bool_ret, err := infostore.HasByConditions(&AdmissionRuleInfo{}, CONNECTION_NAME_COLUMN, connectionName, RULE_NAME_COLUMN, ruleName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if !bool_ret {
+		err := fmt.Errorf("The Admission Rule '%s' of '%s' does not exist!", ruleName, connectionName)
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := infostore.DeleteByConditions(&AdmissionRuleInfo{}, CONNECTION_NAME_COLUMN, connectionName, RULE_NAME_COLUMN, ruleName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+func getAdmissionRuleConnectionName(connectionName string) string {
+	connectionName = strings.TrimSpace(connectionName)
+	if connectionName == "" {
+		return GLOBAL_ADMISSION_RULE
+	}
+	return connectionName
+}
+
+func checkAdmissionRule(rule *AdmissionRuleInfo) error {
+	values := []string{}
+	for _, value := range rule.ValueList {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	if len(values) == 0 {
+		return fmt.Errorf("The ValueList of the Admission Rule '%s' is empty!", rule.RuleName)
+	}
+	rule.ValueList = values
+
+	switch rule.RuleType {
+	case ADMISSION_ALLOWED_VMSPEC, ADMISSION_ALLOWED_REGION:
+		for _, pattern := range values {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("The pattern '%s' is invalid: %v", pattern, err)
+			}
+		}
+	case ADMISSION_DENIED_CIDR_PORT:
+		for _, value := range values {
+			if _, _, _, err := parseDeniedCIDRPort(value); err != nil {
+				return err
+			}
+		}
+	case ADMISSION_MAX_DISK_SIZE_GB:
+		if size, err := strconv.Atoi(values[0]); err != nil || size <= 0 {
+			return fmt.Errorf("The MaxDiskSizeGB '%s' is not a positive integer!", values[0])
+		}
+	case ADMISSION_REQUIRED_TAG_KEYS:
+	default:
+		return fmt.Errorf("The Rule Type '%s' is not supported! (%s | %s | %s | %s | %s)", rule.RuleType,
+			ADMISSION_ALLOWED_VMSPEC, ADMISSION_DENIED_CIDR_PORT, ADMISSION_MAX_DISK_SIZE_GB,
+			ADMISSION_ALLOWED_REGION, ADMISSION_REQUIRED_TAG_KEYS)
+	}
+	return nil
+}
+
+//================ Admission Check
+
+// admissionTargetInfo is the normalized request to be evaluated.
+type admissionTargetInfo struct {
+	rsType string
+	nameId string
+
+	vmSpecNameList   []string
+	diskSizeList     []string // "", "default": not checked
+	securityRuleList []cres.SecurityRuleInfo
+
+	checkTag bool // tags are checked only for the create requests
+	tagList  []cres.KeyValue
+}
+
+func admitVM(connectionName string, reqInfo cres.VMReqInfo) error {
+	return evaluateAdmission(connectionName, admissionTargetInfo{
+		rsType:         VM,
+		nameId:         reqInfo.IId.NameId,
+		vmSpecNameList: []string{reqInfo.VMSpecName},
+		diskSizeList:   []string{reqInfo.RootDiskSize},
+		checkTag:       true,
+		tagList:        reqInfo.TagList,
+	})
+}
+
+func admitSecurity(connectionName string, reqInfo cres.SecurityReqInfo) error {
+	target := admissionTargetInfo{
+		rsType:   SG,
+		nameId:   reqInfo.IId.NameId,
+		checkTag: true,
+		tagList:  reqInfo.TagList,
+	}
+	if reqInfo.SecurityRules != nil {
+		target.securityRuleList = *reqInfo.SecurityRules
+	}
+	return evaluateAdmission(connectionName, target)
+}
+
+func admitSecurityRules(connectionName string, sgName string, ruleList []cres.SecurityRuleInfo) error {
+	return evaluateAdmission(connectionName, admissionTargetInfo{
+		rsType:           SG,
+		nameId:           sgName,
+		securityRuleList: ruleList,
+	})
+}
+
+// A public NLB listener opens the port to the internet.
+func admitNLB(connectionName string, reqInfo cres.NLBInfo) error {
+	target := admissionTargetInfo{
+		rsType:   NLB,
+		nameId:   reqInfo.IId.NameId,
+		checkTag: true,
+		tagList:  reqInfo.TagList,
+	}
+	if !strings.EqualFold(reqInfo.Type, "INTERNAL") {
+		rule := cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: reqInfo.Listener.Protocol,
+			FromPort: reqInfo.Listener.Port, ToPort: reqInfo.Listener.Port}
+		for _, cidr := range []string{"0.0.0.0/0", "::/0"} {
+			rule.CIDR = cidr
+			target.securityRuleList = append(target.securityRuleList, rule)
+		}
+	}
+	return evaluateAdmission(connectionName, target)
+}
+
+func admitCluster(connectionName string, reqInfo cres.ClusterInfo) error {
+	target := admissionTargetInfo{
+		rsType:   CLUSTER,
+		nameId:   reqInfo.IId.NameId,
+		checkTag: true,
+		tagList:  reqInfo.TagList,
+	}
+	for _, nodeGroup := range reqInfo.NodeGroupList {
+		target.vmSpecNameList = append(target.vmSpecNameList, nodeGroup.VMSpecName)
+		target.diskSizeList = append(target.diskSizeList, nodeGroup.RootDiskSize)
+	}
+	return evaluateAdmission(connectionName, target)
+}
+
+func admitNodeGroup(connectionName string, reqInfo cres.NodeGroupInfo) error {
+	return evaluateAdmission(connectionName, admissionTargetInfo{
+		rsType:         NODEGROUP,
+		nameId:         reqInfo.IId.NameId,
+		vmSpecNameList: []string{reqInfo.VMSpecName},
+		diskSizeList:   []string{reqInfo.RootDiskSize},
+	})
+}
+
+func admitDisk(connectionName string, reqInfo cres.DiskInfo) error {
+	return evaluateAdmission(connectionName, admissionTargetInfo{
+		rsType:       DISK,
+		nameId:       reqInfo.IId.NameId,
+		diskSizeList: []string{reqInfo.DiskSize},
+		checkTag:     true,
+		tagList:      reqInfo.TagList,
+	})
+}
+
+func admitDiskSize(connectionName string, diskName string, size string) error {
+	return evaluateAdmission(connectionName, admissionTargetInfo{
+		rsType:       DISK,
+		nameId:       diskName,
+		diskSizeList: []string{size},
+	})
+}
+
+// evaluateAdmission evaluates the global and the connection rules,
+// and returns all denial reasons in an error.
+func evaluateAdmission(connectionName string, target admissionTargetInfo) error {
+	var globalRuleList, connRuleList []*AdmissionRuleInfo
+	err := infostore.ListByCondition(&globalRuleList, CONNECTION_NAME_COLUMN, GLOBAL_ADMISSION_RULE)
+	if err != nil {
+		return err
+	}
+	err = infostore.ListByCondition(&connRuleList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		return err
+	}
+
+	reasonList := []string{}
+	for _, rule := range append(globalRuleList, connRuleList...) {
+		reason, err := evaluateAdmissionRule(connectionName, rule, target)
+		if err != nil {
+			return err
+		}
+		if reason != "" {
+			reasonList = append(reasonList, fmt.Sprintf("[%s] %s", rule.RuleName, reason))
+		}
+	}
+
+	if len(reasonList) > 0 {
+		return fmt.Errorf("The %s '%s' request is denied by the admission policy: %s",
+			target.rsType, target.nameId, strings.Join(reasonList, "; "))
+	}
+	return nil
+}
+
+// returns the denial reason, "" if admitted
+func evaluateAdmissionRule(connectionName string, rule *AdmissionRuleInfo, target admissionTargetInfo) (string, error) {
+	switch rule.RuleType {
+	case ADMISSION_ALLOWED_VMSPEC:
+		for _, specName := range target.vmSpecNameList {
+			if !matchAnyPattern(rule.ValueList, specName) {
+				return fmt.Sprintf("VMSpec '%s' is not allowed (allowed: %s)", specName, strings.Join(rule.ValueList, ", ")), nil
+			}
+		}
+	case ADMISSION_DENIED_CIDR_PORT:
+		for _, secRule := range target.securityRuleList {
+			if !strings.EqualFold(secRule.Direction, "inbound") || secRule.PeerSecurityGroupIID != nil {
+				continue
+			}
+			for _, value := range rule.ValueList {
+				denied, err := isDeniedCIDRPort(value, secRule)
+				if err != nil {
+					return "", err
+				}
+				if denied {
+					return fmt.Sprintf("opening %s to '%s' is denied by '%s'",
+						describeRulePorts(secRule), secRule.CIDR, value), nil
+				}
+			}
+		}
+	case ADMISSION_MAX_DISK_SIZE_GB:
+		maxSize, _ := strconv.Atoi(rule.ValueList[0])
+		for _, size := range target.diskSizeList {
+			size = strings.TrimSpace(size)
+			if size == "" || strings.EqualFold(size, "default") {
+				continue
+			}
+			if diskSize, err := strconv.Atoi(size); err == nil && diskSize > maxSize {
+				return fmt.Sprintf("disk size %sGB exceeds the max size %dGB", size, maxSize), nil
+			}
+		}
+	case ADMISSION_ALLOWED_REGION:
+		regionName, _, err := ccm.GetRegionNameByConnectionName(connectionName)
+		if err != nil {
+			return "", err
+		}
+		if !matchAnyPattern(rule.ValueList, regionName) {
+			return fmt.Sprintf("region '%s' is not allowed (allowed: %s)", regionName, strings.Join(rule.ValueList, ", ")), nil
+		}
+	case ADMISSION_REQUIRED_TAG_KEYS:
+		if !target.checkTag {
+			return "", nil
+		}
+		missingKeyList := []string{}
+		for _, key := range rule.ValueList {
+			if !hasTagKey(target.tagList, key) {
+				missingKeyList = append(missingKeyList, key)
+			}
+		}
+		if len(missingKeyList) > 0 {
+			return fmt.Sprintf("required tag key(s) [%s] are missing", strings.Join(missingKeyList, ", ")), nil
+		}
+	}
+	return "", nil
+}
+
+func matchAnyPattern(patternList []string, value string) bool {
+	for _, pattern := range patternList {
+		if matched, _ := path.Match(pattern, value); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func hasTagKey(tagList []cres.KeyValue, key string) bool {
+	for _, tag := range tagList {
+		if tag.Key == key && strings.TrimSpace(tag.Value) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// "0.0.0.0/0:22", "::/0:1000-2000", "0.0.0.0/0:*"
+func parseDeniedCIDRPort(value string) (*net.IPNet, int, int, error) {
+	idx := strings.LastIndex(value, ":")
+	if idx < 0 {
+		return nil, 0, 0, fmt.Errorf("The DeniedCIDRPort '%s' is not '<CIDR>:<Port>' format!", value)
+	}
+	_, ipNet, err := net.ParseCIDR(strings.TrimSpace(value[:idx]))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("The DeniedCIDRPort '%s' has an invalid CIDR: %v", value, err)
+	}
+	portRange := strings.TrimSpace(value[idx+1:])
+	from, to, err := parsePortRange(portRange, portRange)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("The DeniedCIDRPort '%s' has an invalid port: %v", value, err)
+	}
+	return ipNet, from, to, nil
+}
+
+// "", "-1", "*": all ports, "22", "1000-2000"
+func parsePortRange(fromPort string, toPort string) (int, int, error) {
+	isAll := func(port string) bool { return port == "" || port == "-1" || port == "*" }
+	if isAll(fromPort) || isAll(toPort) {
+		return 1, 65535, nil
+	}
+	if strings.Contains(fromPort, "-") {
+		ports := strings.SplitN(fromPort, "-", 2)
+		fromPort, toPort = ports[0], ports[1]
+	}
+	from, err := strconv.Atoi(strings.TrimSpace(fromPort))
+	if err != nil {
+		return 0, 0, err
+	}
+	to, err := strconv.Atoi(strings.TrimSpace(toPort))
+	if err != nil {
+		return 0, 0, err
+	}
+	return from, to, nil
+}
+
+// ex) "TCP port 22", "TCP port 1000-2000", "ALL ports"
+func describeRulePorts(secRule cres.SecurityRuleInfo) string {
+	from, to, err := parsePortRange(strings.TrimSpace(secRule.FromPort), strings.TrimSpace(secRule.ToPort))
+	if strings.EqualFold(secRule.IPProtocol, "ALL") || err != nil || (from == 1 && to == 65535) {
+		return secRule.IPProtocol + " ports"
+	}
+	if from == to {
+		return fmt.Sprintf("%s port %d", secRule.IPProtocol, from)
+	}
+	return fmt.Sprintf("%s port %d-%d", secRule.IPProtocol, from, to)
+}
+
+// A rule is denied when its CIDR overlaps the denied CIDR and its ports overlap the denied ports.
+func isDeniedCIDRPort(value string, secRule cres.SecurityRuleInfo) (bool, error) {
+	deniedNet, deniedFrom, deniedTo, err := parseDeniedCIDRPort(value)
+	if err != nil {
+		return false, err
+	}
+	cidr := strings.TrimSpace(secRule.CIDR)
+	if cidr == "" {
+		cidr = "0.0.0.0/0" // no CIDR: "0.0.0.0/0"
+	}
+	_, reqNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		// invalid CIDR is checked by the driver
+		return false, nil
+	}
+
+	// CIDRs are overlapped when one contains the network address of the other
+	_, reqBits := reqNet.Mask.Size()
+	_, deniedBits := deniedNet.Mask.Size()
+	if reqBits != deniedBits || !(reqNet.Contains(deniedNet.IP) || deniedNet.Contains(reqNet.IP)) {
+		return false, nil
+	}
+
+	protocol := strings.ToUpper(secRule.IPProtocol)
+	if protocol == "ICMP" || protocol == "ICMPV6" {
+		return false, nil
+	}
+	from, to := 1, 65535
+	if protocol != "ALL" {
+		from, to, err = parsePortRange(strings.TrimSpace(secRule.FromPort), strings.TrimSpace(secRule.ToPort))
+		if err != nil {
+			return false, nil
+		}
+	}
+	return from <= deniedTo && deniedFrom <= to, nil
+}
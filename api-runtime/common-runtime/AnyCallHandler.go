@@ -20,6 +20,8 @@ import (
 func AnyCall(connectionName string, reqInfo cres.AnyCallInfo) (*cres.AnyCallInfo, error) {
 	cblog.Info("call AnyCall()")
 
+	defer startSpan("AnyCall", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -0,0 +1,350 @@
+// Audit Trail Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+//================ Audit Trail
+// The mutating operations of the REST and gRPC APIs are recorded in a table(audit_infos)
+// with the principal, the source IP, the target resource, the masked request and the outcome.
+//
+// env)
+//	SPIDER_AUDIT           : ON | OFF (default: ON)
+//	SPIDER_AUDIT_RETENTION : retention of the records (default: 2160h, 90 days)
+
+// ====================================================================
+// type for GORM
+
+const (
+	AUDIT_TIME_COLUMN = "audit_time"
+
+	DEFAULT_AUDIT_RETENTION   = 90 * 24 * time.Hour
+	DEFAULT_AUDIT_QUERY_LIMIT = 100
+	MAX_AUDIT_QUERY_LIMIT     = 1000
+
+	AUDIT_MASK            = "****"
+	maxAuditSummaryLength = 4096
+	auditPurgeInterval    = 1 * time.Hour
+)
+
+// API of an audit record
+const (
+	AUDIT_API_REST = "REST"
+	AUDIT_API_GRPC = "GRPC"
+)
+
+// outcome of an audit record
+const (
+	AUDIT_SUCCESS = "success"
+	AUDIT_FAILURE = "failure"
+)
+
+// AuditInfo is an audit record of a mutating operation.
+type AuditInfo struct {
+	ID             uint64    `gorm:"primaryKey;autoIncrement"`
+	AuditTime      time.Time `gorm:"index"`
+	RequestID      string    `gorm:"index"` // ex) cs3m8rqkn7pc73d0q0ag
+	Principal      string    `gorm:"index"` // ex) admin, anonymous
+	SourceIP       string    // ex) 10.0.0.10
+	API            string    // REST | GRPC
+	Operation      string    // ex) POST /spider/vm, /ccm.CCMService/StartVM
+	ConnectionName string    `gorm:"index"`     // ex) aws-config01
+	ResourceType   string    `gorm:"index"`     // ex) vm
+	NameId         string    `gorm:"index"`     // ex) vm-01
+	RequestSummary string    `gorm:"type:text"` // the request with the secrets masked
+	Outcome        string    `gorm:"index"`     // success | failure
+	StatusCode     int       // HTTP status code or gRPC code
+	ErrorMSG       string    `gorm:"type:text"`
+	ElapsedTime    float64   // sec
+}
+
+func (AuditInfo) TableName() string {
+	return "audit_infos"
+}
+
+//====================================================================
+
+var auditEnabled = true
+
+func init() {
+	if strings.EqualFold(os.Getenv("SPIDER_AUDIT"), "OFF") {
+		auditEnabled = false
+		return
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&AuditInfo{})
+	infostore.Close(db)
+
+	go runAuditPurger(getAuditRetention())
+}
+
+// RecordAudit stores an audit record. The record is written synchronously not to be lost.
+func RecordAudit(info AuditInfo) error {
+	if !auditEnabled {
+		return nil
+	}
+
+	if info.AuditTime.IsZero() {
+		info.AuditTime = time.Now()
+	}
+	if info.Principal == "" {
+		info.Principal = ANONYMOUS_PRINCIPAL
+	}
+
+	err := infostore.Insert(&info)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+	return nil
+}
+
+func runAuditPurger(retention time.Duration) {
+	ticker := time.NewTicker(auditPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		purgeAudit(retention)
+		<-ticker.C
+	}
+}
+
+func purgeAudit(retention time.Duration) {
+	count, err := infostore.DeleteBefore(&AuditInfo{}, AUDIT_TIME_COLUMN, time.Now().Add(-retention))
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	if count > 0 {
+		cblog.Infof("%d audit records older than %v are purged", count, retention)
+	}
+}
+
+func getAuditRetention() time.Duration {
+	retention := os.Getenv("SPIDER_AUDIT_RETENTION")
+	if retention == "" {
+		return DEFAULT_AUDIT_RETENTION
+	}
+	duration, err := time.ParseDuration(retention)
+	if err != nil || duration <= 0 {
+		cblog.Errorf("invalid SPIDER_AUDIT_RETENTION(%s), use the default %v", retention, DEFAULT_AUDIT_RETENTION)
+		return DEFAULT_AUDIT_RETENTION
+	}
+	return duration
+}
+
+//================ Audit Request Summary
+
+// ex) Password, Passphrase, ClientSecret, AccessKey, PrivateKey, ApiKey, ApiToken, not KeyPairName
+var secretKeyRegexp = regexp.MustCompile(`(?i)(passw|passphrase|secret|token|privatekey|accesskey|apikey)`)
+
+// name keys of the target resource in a request, the first found is the NameId
+var auditNameKeyList = []string{"Name", "ConfigName", "CredentialName", "RegionName", "DriverName", "ResourceName"}
+
+// AuditRequestInfo is the target resource and the masked summary of a request
+type AuditRequestInfo struct {
+	ConnectionName string
+	NameId         string
+	RequestSummary string
+}
+
+// GetAuditRequestInfo returns the connection, the resource NameId and the masked summary
+// of a JSON request body. The values of the secret keys are masked, and all the values
+// of KeyValue pairs are masked for the credential.
+func GetAuditRequestInfo(body []byte, resourceType string) AuditRequestInfo {
+	info := AuditRequestInfo{}
+	if len(strings.TrimSpace(string(body))) == 0 {
+		return info
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		info.RequestSummary = fmt.Sprintf("(not JSON, %d bytes)", len(body))
+		return info
+	}
+
+	if bodyMap, ok := data.(map[string]interface{}); ok {
+		info.ConnectionName = findAuditValue(bodyMap, []string{"ConnectionName"})
+		info.NameId = findAuditValue(bodyMap, auditNameKeyList)
+	}
+
+	data = maskAuditValue(data, strings.EqualFold(resourceType, "credential"))
+	summary, err := json.Marshal(data)
+	if err != nil {
+		cblog.Error(err)
+		return info
+	}
+	info.RequestSummary = string(summary)
+	if len(info.RequestSummary) > maxAuditSummaryLength {
+		info.RequestSummary = info.RequestSummary[:maxAuditSummaryLength] + "...(truncated)"
+	}
+	return info
+}
+
+// findAuditValue finds the string value of the keys in the body and its ReqInfo/Item
+func findAuditValue(bodyMap map[string]interface{}, keyList []string) string {
+	mapList := []map[string]interface{}{bodyMap}
+	for _, nestedKey := range []string{"ReqInfo", "Item"} {
+		if nested, ok := bodyMap[nestedKey].(map[string]interface{}); ok {
+			mapList = append(mapList, nested)
+		}
+	}
+
+	for _, key := range keyList {
+		for _, m := range mapList {
+			if value, ok := m[key].(string); ok && value != "" {
+				return value
+			}
+		}
+	}
+	return ""
+}
+
+func maskAuditValue(data interface{}, maskAllKeyValue bool) interface{} {
+	switch value := data.(type) {
+	case map[string]interface{}:
+		// KeyValue pair, ex) {"Key": "ClientSecret", "Value": "xxx"}
+		if key, ok := value["Key"].(string); ok {
+			if _, hasValue := value["Value"]; hasValue && (maskAllKeyValue || secretKeyRegexp.MatchString(key)) {
+				value["Value"] = AUDIT_MASK
+			}
+		}
+		for k, v := range value {
+			if k == "Key" || k == "Value" {
+				continue
+			}
+			if secretKeyRegexp.MatchString(k) && !isAuditContainer(v) {
+				value[k] = AUDIT_MASK
+				continue
+			}
+			value[k] = maskAuditValue(v, maskAllKeyValue)
+		}
+		return value
+	case []interface{}:
+		for i, v := range value {
+			value[i] = maskAuditValue(v, maskAllKeyValue)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+func isAuditContainer(data interface{}) bool {
+	switch data.(type) {
+	case map[string]interface{}, []interface{}:
+		return true
+	}
+	return false
+}
+
+//================ Audit Query
+
+// AuditQueryInfo is the conditions of an audit query. The empty conditions are ignored.
+type AuditQueryInfo struct {
+	Principal      string    // ex) admin
+	ConnectionName string    // ex) aws-config01
+	ResourceType   string    // ex) vm
+	NameId         string    // ex) vm-01
+	Outcome        string    // success | failure
+	RequestID      string    // ex) cs3m8rqkn7pc73d0q0ag
+	StartTime      time.Time // inclusive
+	EndTime        time.Time // exclusive
+	Limit          int       // default: 100, max: 1000
+}
+
+// ListAudit returns the audit records matched with the conditions, the latest first.
+func ListAudit(query AuditQueryInfo) ([]*AuditInfo, error) {
+	cblog.Info("call ListAudit()")
+
+	if err := checkAuditQuery(&query); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	defer infostore.Close(db)
+
+	infoList := []*AuditInfo{}
+	err = whereAuditQuery(db.Model(&AuditInfo{}), query).
+		Order(AUDIT_TIME_COLUMN + " desc").Limit(query.Limit).Find(&infoList).Error
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return infoList, nil
+}
+
+func checkAuditQuery(query *AuditQueryInfo) error {
+	switch strings.ToLower(query.Outcome) {
+	case "", AUDIT_SUCCESS, AUDIT_FAILURE:
+		query.Outcome = strings.ToLower(query.Outcome)
+	default:
+		return fmt.Errorf("invalid Outcome '%s' of the audit query, use one of %s, %s", query.Outcome, AUDIT_SUCCESS, AUDIT_FAILURE)
+	}
+	if !query.StartTime.IsZero() && !query.EndTime.IsZero() && !query.StartTime.Before(query.EndTime) {
+		return fmt.Errorf("StartTime(%v) of the audit query should be before EndTime(%v)", query.StartTime, query.EndTime)
+	}
+	if query.Limit <= 0 {
+		query.Limit = DEFAULT_AUDIT_QUERY_LIMIT
+	}
+	if query.Limit > MAX_AUDIT_QUERY_LIMIT {
+		query.Limit = MAX_AUDIT_QUERY_LIMIT
+	}
+	return nil
+}
+
+func whereAuditQuery(db *gorm.DB, query AuditQueryInfo) *gorm.DB {
+	if query.Principal != "" {
+		db = db.Where("principal = ?", query.Principal)
+	}
+	if query.ConnectionName != "" {
+		db = db.Where("connection_name = ?", query.ConnectionName)
+	}
+	if query.ResourceType != "" {
+		db = db.Where("resource_type = ?", strings.ToLower(query.ResourceType))
+	}
+	if query.NameId != "" {
+		db = db.Where("name_id = ?", query.NameId)
+	}
+	if query.Outcome != "" {
+		db = db.Where("outcome = ?", query.Outcome)
+	}
+	if query.RequestID != "" {
+		db = db.Where("request_id = ?", query.RequestID)
+	}
+	if !query.StartTime.IsZero() {
+		db = db.Where(AUDIT_TIME_COLUMN+" >= ?", query.StartTime)
+	}
+	if !query.EndTime.IsZero() {
+		db = db.Where(AUDIT_TIME_COLUMN+" < ?", query.EndTime)
+	}
+	return db
+}
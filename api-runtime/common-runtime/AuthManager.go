@@ -0,0 +1,621 @@
+// User, API Token and Role Binding Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/rs/xid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+//================ Users, API Tokens and Role Bindings
+// The users are authenticated with their password(basic auth) or API tokens(bearer),
+// and authorized by the role bindings scoped with connection name patterns, ex)
+//
+//	{UserName: "dev-team", Role: "operator", ConnectionPattern: "aws-dev-*"}
+//
+// roles)
+//	admin    : all operations, including the cloud info(driver, credential, ...) and the users
+//	operator : create, delete and control the resources of the permitted connections
+//	readonly : get and list the resources of the permitted connections
+
+// ====================================================================
+// type for GORM
+
+const (
+	USER_NAME_COLUMN          = "user_name"
+	ROLE_COLUMN               = "role"
+	CONNECTION_PATTERN_COLUMN = "connection_pattern"
+	TOKEN_ID_COLUMN           = "token_id"
+	TOKEN_HASH_COLUMN         = "token_hash"
+
+	API_TOKEN_PREFIX       = "spider_"
+	MIN_USER_PASSWORD_SIZE = 8
+)
+
+// Roles
+const (
+	ROLE_ADMIN    = "admin"
+	ROLE_OPERATOR = "operator"
+	ROLE_READONLY = "readonly"
+)
+
+// Actions to authorize
+const (
+	AUTH_ACTION_READ    = "read"       // get, list
+	AUTH_ACTION_OPERATE = "operate"    // create, delete, control the resources
+	AUTH_ACTION_ADMIN   = "administer" // manage the cloud info, the policies and the users
+)
+
+// a role is permitted the actions of the same or lower level
+var roleLevelMap = map[string]int{ROLE_READONLY: 1, ROLE_OPERATOR: 2, ROLE_ADMIN: 3}
+var actionLevelMap = map[string]int{AUTH_ACTION_READ: 1, AUTH_ACTION_OPERATE: 2, AUTH_ACTION_ADMIN: 3}
+
+// resources managed by the admin, ex) REST /spider/driver, gRPC CreateCloudDriver,
+// and the calls not scoped by a connection, ex) sshrun to any server
+var adminResourceMap = map[string]bool{
+	"driver": true, "clouddriver": true, "credential": true, "region": true, "connectionconfig": true,
+	"admissionrule": true, "tagpolicy": true, "encryptionkey": true, "sshrun": true,
+}
+
+// resources read by the admin only,
+// and the info of all the connections, ex) the call-logs, the metrics and the call guards
+var adminReadResourceMap = map[string]bool{
+	"credential": true, "auth": true, "audit": true, "encryptionkey": true,
+	"calllog": true, "metrics": true, "circuitbreaker": true, "calllimit": true, "splockinfo": true,
+}
+
+type UserInfo struct {
+	UserName     string `gorm:"primaryKey"`
+	PasswordHash string `json:"-"` // bcrypt
+	CreatedTime  time.Time
+}
+
+func (UserInfo) TableName() string {
+	return "user_infos"
+}
+
+type RoleBindingInfo struct {
+	UserName          string `gorm:"primaryKey"`
+	Role              string `gorm:"primaryKey"` // admin | operator | readonly
+	ConnectionPattern string `gorm:"primaryKey"` // ex) aws-dev-*, *: all connections
+}
+
+func (RoleBindingInfo) TableName() string {
+	return "role_binding_infos"
+}
+
+type ApiTokenInfo struct {
+	TokenId     string `gorm:"primaryKey"`
+	UserName    string `gorm:"index"`
+	TokenHash   string `gorm:"uniqueIndex" json:"-"` // sha256, the token itself is not stored
+	Description string
+	CreatedTime time.Time
+	ExpireTime  time.Time // zero: no expiration
+}
+
+func (ApiTokenInfo) TableName() string {
+	return "api_token_infos"
+}
+
+//====================================================================
+
+// AuthUserInfo is an authenticated user with the role bindings.
+type AuthUserInfo struct {
+	UserName        string
+	RoleBindingList []RoleBindingInfo
+}
+
+// true if any user is registered, then the user authentication is required
+var userAuthEnabled atomic.Bool
+
+type authUserKey struct{}
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&UserInfo{}, &RoleBindingInfo{}, &ApiTokenInfo{})
+	infostore.Close(db)
+
+	refreshUserAuthEnabled()
+}
+
+func refreshUserAuthEnabled() {
+	var userList []*UserInfo
+	if err := infostore.List(&userList); err != nil {
+		cblog.Error(err)
+		return
+	}
+	userAuthEnabled.Store(len(userList) > 0)
+}
+
+// IsUserAuthEnabled returns true if any user is registered.
+func IsUserAuthEnabled() bool {
+	return userAuthEnabled.Load()
+}
+
+// NewAdminAuthUser returns the admin of all connections, ex) the API_USERNAME user.
+func NewAdminAuthUser(userName string) *AuthUserInfo {
+	return &AuthUserInfo{
+		UserName:        userName,
+		RoleBindingList: []RoleBindingInfo{{UserName: userName, Role: ROLE_ADMIN, ConnectionPattern: "*"}},
+	}
+}
+
+// WithAuthUser returns a copy of the context with the authenticated user.
+func WithAuthUser(ctx context.Context, authUser *AuthUserInfo) context.Context {
+	return context.WithValue(ctx, authUserKey{}, authUser)
+}
+
+// AuthUserFromContext returns the authenticated user of the context, or nil.
+func AuthUserFromContext(ctx context.Context) *AuthUserInfo {
+	authUser, _ := ctx.Value(authUserKey{}).(*AuthUserInfo)
+	return authUser
+}
+
+//================ User Handler
+
+// CreateUser creates a user.
+// The first user is bound to the admin role of all connections with the user in a transaction,
+// because the registered users turn on the user authentication.
+func CreateUser(userName string, password string) (*UserInfo, error) {
+	cblog.Info("call CreateUser()")
+
+	userName, err := EmptyCheckAndTrim("UserName", userName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if len(password) < MIN_USER_PASSWORD_SIZE {
+		err := fmt.Errorf("The Password of the User '%s' should be at least %d characters!", userName, MIN_USER_PASSWORD_SIZE)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	bool_ret, err := infostore.Has(&UserInfo{}, USER_NAME_COLUMN, userName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if bool_ret {
+		err := fmt.Errorf("The User '%s' already exists!", userName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	userInfo := UserInfo{UserName: userName, PasswordHash: string(hash), CreatedTime: time.Now()}
+	err = insertUser(&userInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	refreshUserAuthEnabled()
+
+	return &userInfo, nil
+}
+
+// insertUser inserts the user, and binds the first user to the admin role of all connections.
+func insertUser(userInfo *UserInfo) error {
+	db, err := infostore.Open()
+	if err != nil {
+		return err
+	}
+	defer infostore.Close(db)
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var userCount int64
+		if err := tx.Model(&UserInfo{}).Count(&userCount).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(userInfo).Error; err != nil {
+			return err
+		}
+		if userCount > 0 {
+			return nil
+		}
+		cblog.Infof("The first User '%s' is bound to the '%s' role of all connections.", userInfo.UserName, ROLE_ADMIN)
+		return tx.Create(&RoleBindingInfo{UserName: userInfo.UserName, Role: ROLE_ADMIN, ConnectionPattern: "*"}).Error
+	})
+}
+
+func ListUser() ([]*UserInfo, error) {
+	cblog.Info("call ListUser()")
+
+	var userList []*UserInfo
+	err := infostore.List(&userList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return userList, nil
+}
+
+func GetUser(userName string) (*UserInfo, error) {
+	cblog.Info("call GetUser()")
+
+	userName, err := EmptyCheckAndTrim("userName", userName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var userInfo UserInfo
+	err = infostore.Get(&userInfo, USER_NAME_COLUMN, userName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &userInfo, nil
+}
+
+// DeleteUser deletes the user with the role bindings and the API tokens of the user.
+func DeleteUser(userName string) (bool, error) {
+	cblog.Info("call DeleteUser()")
+
+	if _, err := GetUser(userName); err != nil {
+		return false, err
+	}
+	userName = strings.TrimSpace(userName)
+
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	defer infostore.Close(db)
+
+	for _, info := range []interface{}{&RoleBindingInfo{}, &ApiTokenInfo{}, &UserInfo{}} {
+		if err := db.Where(USER_NAME_COLUMN+" = ?", userName).Delete(info).Error; err != nil {
+			cblog.Error(err)
+			return false, err
+		}
+	}
+	refreshUserAuthEnabled()
+
+	return true, nil
+}
+
+//================ Role Binding Handler
+
+func AddRoleBinding(reqInfo RoleBindingInfo) (*RoleBindingInfo, error) {
+	cblog.Info("call AddRoleBinding()")
+
+	if _, err := GetUser(reqInfo.UserName); err != nil {
+		return nil, err
+	}
+	reqInfo.UserName = strings.TrimSpace(reqInfo.UserName)
+
+	reqInfo.Role = strings.ToLower(strings.TrimSpace(reqInfo.Role))
+	switch reqInfo.Role {
+	case ROLE_ADMIN, ROLE_OPERATOR, ROLE_READONLY:
+	default:
+		err := fmt.Errorf("The Role '%s' is invalid, use one of %s, %s, %s!", reqInfo.Role, ROLE_ADMIN, ROLE_OPERATOR, ROLE_READONLY)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	reqInfo.ConnectionPattern = strings.TrimSpace(reqInfo.ConnectionPattern)
+	if reqInfo.ConnectionPattern == "" {
+		reqInfo.ConnectionPattern = "*"
+	}
+	if _, err := path.Match(reqInfo.ConnectionPattern, ""); err != nil {
+		err = fmt.Errorf("The ConnectionPattern '%s' is invalid: %v", reqInfo.ConnectionPattern, err)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	err := infostore.Insert(&reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &reqInfo, nil
+}
+
+func ListRoleBinding(userName string) ([]*RoleBindingInfo, error) {
+	cblog.Info("call ListRoleBinding()")
+
+	var bindingList []*RoleBindingInfo
+	err := infostore.ListByCondition(&bindingList, USER_NAME_COLUMN, strings.TrimSpace(userName))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return bindingList, nil
+}
+
+func RemoveRoleBinding(userName string, role string, connectionPattern string) (bool, error) {
+	cblog.Info("call RemoveRoleBinding()")
+
+	userName = strings.TrimSpace(userName)
+	role = strings.ToLower(strings.TrimSpace(role))
+	connectionPattern = strings.TrimSpace(connectionPattern)
+	if connectionPattern == "" {
+		connectionPattern = "*"
+	}
+
+	bool_ret, err := infostore.HasBy3Conditions(&RoleBindingInfo{}, USER_NAME_COLUMN, userName, ROLE_COLUMN, role,
+		CONNECTION_PATTERN_COLUMN, connectionPattern)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if !bool_ret {
+		err := fmt.Errorf("The Role Binding '%s:%s' of the User '%s' does not exist!", role, connectionPattern, userName)
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := infostore.DeleteBy3Conditions(&RoleBindingInfo{}, USER_NAME_COLUMN, userName, ROLE_COLUMN, role,
+		CONNECTION_PATTERN_COLUMN, connectionPattern)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+//================ API Token Handler
+
+// CreateApiToken issues an API token of the user. The token is returned only once,
+// and only its hash is stored. The zero validity means no expiration.
+func CreateApiToken(userName string, description string, validity time.Duration) (*ApiTokenInfo, string, error) {
+	cblog.Info("call CreateApiToken()")
+
+	if _, err := GetUser(userName); err != nil {
+		return nil, "", err
+	}
+	if validity < 0 {
+		err := fmt.Errorf("The validity(%v) of the API token should not be negative!", validity)
+		cblog.Error(err)
+		return nil, "", err
+	}
+
+	randBytes := make([]byte, 32)
+	if _, err := rand.Read(randBytes); err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+	token := API_TOKEN_PREFIX + base64.RawURLEncoding.EncodeToString(randBytes)
+
+	tokenInfo := ApiTokenInfo{
+		TokenId:     xid.New().String(),
+		UserName:    strings.TrimSpace(userName),
+		TokenHash:   hashApiToken(token),
+		Description: description,
+		CreatedTime: time.Now(),
+	}
+	if validity > 0 {
+		tokenInfo.ExpireTime = tokenInfo.CreatedTime.Add(validity)
+	}
+
+	err := infostore.Insert(&tokenInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, "", err
+	}
+
+	return &tokenInfo, token, nil
+}
+
+func ListApiToken(userName string) ([]*ApiTokenInfo, error) {
+	cblog.Info("call ListApiToken()")
+
+	var tokenList []*ApiTokenInfo
+	err := infostore.ListByCondition(&tokenList, USER_NAME_COLUMN, strings.TrimSpace(userName))
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return tokenList, nil
+}
+
+func DeleteApiToken(userName string, tokenId string) (bool, error) {
+	cblog.Info("call DeleteApiToken()")
+
+	userName = strings.TrimSpace(userName)
+	tokenId = strings.TrimSpace(tokenId)
+
+	bool_ret, err := infostore.HasByConditions(&ApiTokenInfo{}, USER_NAME_COLUMN, userName, TOKEN_ID_COLUMN, tokenId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if !bool_ret {
+		err := fmt.Errorf("The API Token '%s' of the User '%s' does not exist!", tokenId, userName)
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := infostore.DeleteByConditions(&ApiTokenInfo{}, USER_NAME_COLUMN, userName, TOKEN_ID_COLUMN, tokenId)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+func hashApiToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+//================ Authentication and Authorization
+
+// AuthenticateUser authenticates a user with the password.
+func AuthenticateUser(userName string, password string) (*AuthUserInfo, error) {
+	var userInfo UserInfo
+	if err := infostore.Get(&userInfo, USER_NAME_COLUMN, userName); err != nil {
+		return nil, fmt.Errorf("invalid user name or password")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(userInfo.PasswordHash), []byte(password)); err != nil {
+		return nil, fmt.Errorf("invalid user name or password")
+	}
+
+	return GetAuthUser(userInfo.UserName)
+}
+
+// AuthenticateApiToken authenticates a user with the API token.
+func AuthenticateApiToken(token string) (*AuthUserInfo, error) {
+	if !strings.HasPrefix(token, API_TOKEN_PREFIX) {
+		return nil, fmt.Errorf("invalid API token")
+	}
+
+	var tokenInfo ApiTokenInfo
+	if err := infostore.Get(&tokenInfo, TOKEN_HASH_COLUMN, hashApiToken(token)); err != nil {
+		return nil, fmt.Errorf("invalid API token")
+	}
+	if !tokenInfo.ExpireTime.IsZero() && time.Now().After(tokenInfo.ExpireTime) {
+		return nil, fmt.Errorf("the API token '%s' is expired", tokenInfo.TokenId)
+	}
+
+	return GetAuthUser(tokenInfo.UserName)
+}
+
+// GetAuthUser returns the user with the role bindings, ex) the subject of a verified JWT.
+func GetAuthUser(userName string) (*AuthUserInfo, error) {
+	bindingList, err := ListRoleBinding(userName)
+	if err != nil {
+		return nil, err
+	}
+
+	authUser := AuthUserInfo{UserName: userName}
+	for _, binding := range bindingList {
+		authUser.RoleBindingList = append(authUser.RoleBindingList, *binding)
+	}
+	return &authUser, nil
+}
+
+// GetAuthAction returns the action of a call on the resource, ex) ("vm", true) => operate
+func GetAuthAction(resource string, isMutating bool) string {
+	resource = strings.ToLower(resource)
+
+	if adminReadResourceMap[resource] {
+		return AUTH_ACTION_ADMIN
+	}
+	if !isMutating {
+		return AUTH_ACTION_READ
+	}
+	if adminResourceMap[resource] {
+		return AUTH_ACTION_ADMIN
+	}
+	return AUTH_ACTION_OPERATE
+}
+
+// GetRequestConnectionNames returns the connections of a request body(JSON, or YAML of an infra spec):
+// ConnectionName, ConnectionNames, Selector.ConnectionNames and ConnectionList[].ConnectionName.
+// The body not parsed has no connection.
+func GetRequestConnectionNames(body []byte) []string {
+	nameList := []string{}
+	var req struct {
+		ConnectionName  string
+		ConnectionNames []string
+		Selector        struct {
+			ConnectionNames []string
+		}
+		ConnectionList []struct {
+			ConnectionName string
+		}
+	}
+	if err := json.Unmarshal(body, &req); err != nil {
+		// YAML of an infra spec
+		spec, err := ParseInfraSpec(body)
+		if err != nil {
+			return nil
+		}
+		for _, connSpec := range spec.ConnectionList {
+			if connSpec != nil {
+				nameList = append(nameList, connSpec.ConnectionName)
+			}
+		}
+		return nameList
+	}
+
+	if req.ConnectionName != "" {
+		nameList = append(nameList, req.ConnectionName)
+	}
+	nameList = append(nameList, req.ConnectionNames...)
+	nameList = append(nameList, req.Selector.ConnectionNames...)
+	for _, conn := range req.ConnectionList {
+		nameList = append(nameList, conn.ConnectionName)
+	}
+	return nameList
+}
+
+// Authorize checks the action of the user on each connection of the call.
+func (authUser *AuthUserInfo) Authorize(action string, connectionNameList []string) error {
+	if len(connectionNameList) == 0 {
+		return authUser.CheckPermission(action, "")
+	}
+	for _, connectionName := range connectionNameList {
+		if err := authUser.CheckPermission(action, connectionName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckPermission checks the action of the user on the connection.
+// The admin action requires the admin role of all connections("*").
+// The read action without a connection requires the role of any connection,
+// and the other actions without a connection require the role of all connections("*").
+func (authUser *AuthUserInfo) CheckPermission(action string, connectionName string) error {
+	for _, binding := range authUser.RoleBindingList {
+		if roleLevelMap[binding.Role] < actionLevelMap[action] {
+			continue
+		}
+		if action == AUTH_ACTION_ADMIN || (connectionName == "" && action != AUTH_ACTION_READ) {
+			if binding.ConnectionPattern == "*" {
+				return nil
+			}
+			continue
+		}
+		if connectionName == "" {
+			return nil
+		}
+		if matched, _ := path.Match(binding.ConnectionPattern, connectionName); matched {
+			return nil
+		}
+	}
+
+	if connectionName == "" || action == AUTH_ACTION_ADMIN {
+		return fmt.Errorf("The User '%s' is not permitted to %s!", authUser.UserName, action)
+	}
+	return fmt.Errorf("The User '%s' is not permitted to %s the connection '%s'!", authUser.UserName, action, connectionName)
+}
@@ -0,0 +1,403 @@
+// Call Guard(Rate Limit, Circuit Breaker) Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+)
+
+//================ Rate Limit and Circuit Breaker of CSP calls
+// The driver calls of a connection pass the guard of the connection and the guard of its provider:
+// the read-only calls through retryCall() and the mutating calls through guardCall().
+//	(1) circuit breaker of the connection: fail fast while the CSP is failing
+//	(2) token-bucket rate limit: connection and provider
+//	(3) max concurrent calls: connection and provider
+//
+// env) 0: unlimited
+//	SPIDER_CONNECTION_RATE_LIMIT      : calls per second of a connection (default: 20)
+//	SPIDER_CONNECTION_RATE_BURST      : burst of a connection (default: 20)
+//	SPIDER_CONNECTION_MAX_CONCURRENT  : concurrent calls of a connection (default: 10)
+//	SPIDER_PROVIDER_RATE_LIMIT        : calls per second of a provider (default: 0)
+//	SPIDER_PROVIDER_RATE_BURST        : burst of a provider (default: 0)
+//	SPIDER_PROVIDER_MAX_CONCURRENT    : concurrent calls of a provider (default: 0)
+//	SPIDER_BREAKER_FAILURE_THRESHOLD  : consecutive transient failures to open the breaker (default: 5)
+//	SPIDER_BREAKER_OPEN_TIMEOUT       : open duration before a trial call (default: 30s)
+
+const (
+	CALL_LIMIT_CONNECTION = "connection"
+	CALL_LIMIT_PROVIDER   = "provider"
+)
+
+// state of the circuit breaker
+const (
+	BREAKER_CLOSED    = "CLOSED"
+	BREAKER_OPEN      = "OPEN"
+	BREAKER_HALF_OPEN = "HALF_OPEN"
+)
+
+var ErrCircuitBreakerOpen = errors.New("circuit breaker is open")
+
+type CallLimitInfo struct {
+	Scope         string  // connection | provider
+	Name          string  // connection name or provider name
+	RateLimit     float64 // calls per second, 0: unlimited
+	RateBurst     int
+	MaxConcurrent int // 0: unlimited
+	RunningCalls  int
+}
+
+type CircuitBreakerInfo struct {
+	ConnectionName      string
+	State               string // CLOSED | OPEN | HALF_OPEN
+	FailureThreshold    int
+	OpenTimeout         string
+	ConsecutiveFailures int
+	OpenedTime          time.Time
+	LastError           string
+}
+
+// limits and running calls of a connection or a provider
+type callLimiter struct {
+	info    CallLimitInfo
+	limiter *rate.Limiter // nil: unlimited
+	sem     chan struct{} // nil: unlimited
+}
+
+type circuitBreaker struct {
+	mutex         sync.Mutex
+	info          CircuitBreakerInfo
+	openTimeout   time.Duration
+	trialInFlight bool
+}
+
+type callGuard struct {
+	connLimiter *callLimiter
+	provLimiter *callLimiter
+	breaker     *circuitBreaker
+}
+
+var (
+	callGuardLock      sync.Mutex
+	callGuardMap       = map[string]*callGuard{}   // key: connection name
+	providerLimiterMap = map[string]*callLimiter{} // key: provider name
+)
+
+func init() {
+	// the guard of a connection is created again with the changed connection config
+	ccim.AddChangeHandler(resetCallGuard)
+}
+
+func resetCallGuard(connectionName string) {
+	callGuardLock.Lock()
+	defer callGuardLock.Unlock()
+	delete(callGuardMap, connectionName)
+}
+
+func newCallLimiter(scope string, name string, rateEnv string, burstEnv string, concurrentEnv string,
+	defaultRate float64, defaultBurst int, defaultConcurrent int) *callLimiter {
+
+	info := CallLimitInfo{
+		Scope:         scope,
+		Name:          name,
+		RateLimit:     getFloatEnv(rateEnv, defaultRate),
+		RateBurst:     getIntEnv(burstEnv, defaultBurst),
+		MaxConcurrent: getIntEnv(concurrentEnv, defaultConcurrent),
+	}
+	cl := &callLimiter{info: info}
+	if info.RateLimit > 0 {
+		if cl.info.RateBurst < 1 {
+			cl.info.RateBurst = 1
+		}
+		cl.limiter = rate.NewLimiter(rate.Limit(info.RateLimit), cl.info.RateBurst)
+	}
+	if info.MaxConcurrent > 0 {
+		cl.sem = make(chan struct{}, info.MaxConcurrent)
+	}
+	return cl
+}
+
+func (cl *callLimiter) acquire() {
+	if cl.limiter != nil {
+		cl.limiter.Wait(context.Background())
+	}
+	if cl.sem != nil {
+		cl.sem <- struct{}{}
+	}
+}
+
+func (cl *callLimiter) release() {
+	if cl.sem != nil {
+		<-cl.sem
+	}
+}
+
+func (cl *callLimiter) getInfo() CallLimitInfo {
+	info := cl.info
+	info.RunningCalls = len(cl.sem)
+	return info
+}
+
+func getCallGuard(connectionName string) (*callGuard, error) {
+	callGuardLock.Lock()
+	defer callGuardLock.Unlock()
+
+	if guard, ok := callGuardMap[connectionName]; ok {
+		return guard, nil
+	}
+
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	provLimiter, ok := providerLimiterMap[providerName]
+	if !ok {
+		provLimiter = newCallLimiter(CALL_LIMIT_PROVIDER, providerName,
+			"SPIDER_PROVIDER_RATE_LIMIT", "SPIDER_PROVIDER_RATE_BURST", "SPIDER_PROVIDER_MAX_CONCURRENT", 0, 0, 0)
+		providerLimiterMap[providerName] = provLimiter
+	}
+
+	openTimeout := getDurationEnv("SPIDER_BREAKER_OPEN_TIMEOUT", 30*time.Second)
+	guard := &callGuard{
+		connLimiter: newCallLimiter(CALL_LIMIT_CONNECTION, connectionName,
+			"SPIDER_CONNECTION_RATE_LIMIT", "SPIDER_CONNECTION_RATE_BURST", "SPIDER_CONNECTION_MAX_CONCURRENT", 20, 20, 10),
+		provLimiter: provLimiter,
+		breaker: &circuitBreaker{
+			info: CircuitBreakerInfo{
+				ConnectionName:   connectionName,
+				State:            BREAKER_CLOSED,
+				FailureThreshold: getIntEnv("SPIDER_BREAKER_FAILURE_THRESHOLD", 5),
+				OpenTimeout:      openTimeout.String(),
+			},
+			openTimeout: openTimeout,
+		},
+	}
+	callGuardMap[connectionName] = guard
+	return guard, nil
+}
+
+// guardCall calls a driver API through the circuit breaker, the rate limits and the concurrency limits.
+func guardCall[T any](connectionName string, fn func() (T, error)) (T, error) {
+	var zero T
+
+	guard, err := getCallGuard(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return zero, err
+	}
+
+	err = guard.breaker.allow()
+	if err != nil {
+		cblog.Error(err)
+		return zero, err
+	}
+
+	completed := false
+	defer func() {
+		if !completed {
+			// panic in fn: the trial call ends without the result
+			guard.breaker.abort()
+		}
+	}()
+
+	result, err := func() (T, error) {
+		// do not hold the provider's slot while waiting for the connection's slot
+		guard.connLimiter.acquire()
+		defer guard.connLimiter.release()
+		guard.provLimiter.acquire()
+		defer guard.provLimiter.release()
+		return fn()
+	}()
+	completed = true
+
+	guard.breaker.record(err)
+	return result, err
+}
+
+// allow returns an error while the breaker is open.
+// After the open timeout, only one trial call is allowed(HALF_OPEN).
+func (cb *circuitBreaker) allow() error {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	switch cb.info.State {
+	case BREAKER_OPEN:
+		if time.Since(cb.info.OpenedTime) < cb.openTimeout {
+			return cb.openError()
+		}
+		cb.info.State = BREAKER_HALF_OPEN
+		cb.trialInFlight = true
+		return nil
+	case BREAKER_HALF_OPEN:
+		if cb.trialInFlight {
+			return cb.openError()
+		}
+		cb.trialInFlight = true
+	}
+	return nil
+}
+
+func (cb *circuitBreaker) openError() error {
+	return fmt.Errorf("%w: the connection '%s' failed %d times in a row, retry after %v (last error: %s)",
+		ErrCircuitBreakerOpen, cb.info.ConnectionName, cb.info.ConsecutiveFailures,
+		cb.info.OpenedTime.Add(cb.openTimeout).Format(time.RFC3339), cb.info.LastError)
+}
+
+// record counts the transient failures(throttling, server, timeout, network) only.
+// The other errors(ex. not found) mean that the CSP is responding.
+func (cb *circuitBreaker) record(err error) {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+
+	cb.trialInFlight = false
+	if err == nil || !isTransientError(err) {
+		if cb.info.State != BREAKER_CLOSED {
+			cblog.Infof("circuit breaker of the connection '%s' is closed", cb.info.ConnectionName)
+		}
+		cb.info.State = BREAKER_CLOSED
+		cb.info.ConsecutiveFailures = 0
+		return
+	}
+
+	cb.info.ConsecutiveFailures++
+	cb.info.LastError = err.Error()
+	if cb.info.State == BREAKER_HALF_OPEN || cb.info.ConsecutiveFailures >= cb.info.FailureThreshold {
+		cb.info.State = BREAKER_OPEN
+		cb.info.OpenedTime = time.Now()
+		cblog.Errorf("circuit breaker of the connection '%s' is open for %v: %v", cb.info.ConnectionName, cb.openTimeout, err)
+	}
+}
+
+// abort ends the trial call without the result, and allows the next trial call.
+func (cb *circuitBreaker) abort() {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	cb.trialInFlight = false
+}
+
+func (cb *circuitBreaker) getInfo() CircuitBreakerInfo {
+	cb.mutex.Lock()
+	defer cb.mutex.Unlock()
+	return cb.info
+}
+
+func isTransientError(err error) bool {
+	policy := RetryPolicyInfo{ErrorKindList: []string{RETRY_THROTTLING, RETRY_SERVER, RETRY_TIMEOUT, RETRY_NETWORK}}
+	return policy.getRetryErrorKind(err) != ""
+}
+
+//================ Call Guard Info
+
+// ListCallLimit returns the limits and the running calls of the connections and the providers in use.
+func ListCallLimit() []CallLimitInfo {
+	cblog.Info("call ListCallLimit()")
+
+	callGuardLock.Lock()
+	defer callGuardLock.Unlock()
+
+	infoList := []CallLimitInfo{}
+	for _, provLimiter := range providerLimiterMap {
+		infoList = append(infoList, provLimiter.getInfo())
+	}
+	for _, guard := range callGuardMap {
+		infoList = append(infoList, guard.connLimiter.getInfo())
+	}
+	sort.Slice(infoList, func(i, j int) bool {
+		if infoList[i].Scope != infoList[j].Scope {
+			return infoList[i].Scope > infoList[j].Scope // provider first
+		}
+		return infoList[i].Name < infoList[j].Name
+	})
+	return infoList
+}
+
+func ListCircuitBreaker() []CircuitBreakerInfo {
+	cblog.Info("call ListCircuitBreaker()")
+
+	callGuardLock.Lock()
+	defer callGuardLock.Unlock()
+
+	infoList := []CircuitBreakerInfo{}
+	for _, guard := range callGuardMap {
+		infoList = append(infoList, guard.breaker.getInfo())
+	}
+	sort.Slice(infoList, func(i, j int) bool {
+		return infoList[i].ConnectionName < infoList[j].ConnectionName
+	})
+	return infoList
+}
+
+func GetCircuitBreaker(connectionName string) (*CircuitBreakerInfo, error) {
+	cblog.Info("call GetCircuitBreaker()")
+
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	guard, err := getCallGuard(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info := guard.breaker.getInfo()
+	return &info, nil
+}
+
+func getIntEnv(name string, defaultValue int) int {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	num, err := strconv.Atoi(value)
+	if err != nil || num < 0 {
+		cblog.Errorf("invalid %s(%s), use the default %d", name, value, defaultValue)
+		return defaultValue
+	}
+	return num
+}
+
+func getFloatEnv(name string, defaultValue float64) float64 {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	num, err := strconv.ParseFloat(value, 64)
+	if err != nil || num < 0 {
+		cblog.Errorf("invalid %s(%s), use the default %v", name, value, defaultValue)
+		return defaultValue
+	}
+	return num
+}
+
+func getDurationEnv(name string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		cblog.Errorf("invalid %s(%s), use the default %v", name, value, defaultValue)
+		return defaultValue
+	}
+	return duration
+}
@@ -0,0 +1,446 @@
+// Call-Log Store Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+)
+
+//================ Call-Log Store
+// The call-logs of the drivers are also stored in a table(call_log_infos) of a separate DB file(meta_db/cb-spider-calllog.db)
+// to be queried by conditions, not to lock the meta DB with the writes of the call-logs.
+// The records are written by a background writer in batches, and the expired ones are purged.
+// The store is started by StartCallLogStore() at the server start.
+// The call-logs of CB-Spider itself(ex. "CB-Spider:StartVM()") are not stored.
+//
+// env)
+//	SPIDER_CALLLOG_STORE     : ON | OFF (default: ON)
+//	SPIDER_CALLLOG_RETENTION : retention of the records (default: 168h)
+
+// ====================================================================
+// type for GORM
+
+const (
+	CALLLOG_DB_FILE     = "cb-spider-calllog.db"
+	CALLLOG_TIME_COLUMN = "call_time"
+
+	DEFAULT_CALLLOG_RETENTION   = 7 * 24 * time.Hour
+	DEFAULT_CALLLOG_QUERY_LIMIT = 100
+	MAX_CALLLOG_QUERY_LIMIT     = 1000
+
+	callLogBufferSize    = 10000
+	callLogBatchSize     = 100
+	callLogFlushInterval = 1 * time.Second
+	callLogPurgeInterval = 1 * time.Hour
+)
+
+// status of a call
+const (
+	CALLLOG_SUCCESS = "success"
+	CALLLOG_ERROR   = "error"
+)
+
+// CallLogInfo is a call-log record of the drivers.
+type CallLogInfo struct {
+	ID           uint64    `gorm:"primaryKey;autoIncrement"`
+	CallTime     time.Time `gorm:"index"`
+	CloudOS      string    `gorm:"index"` // ex) AWS
+	RegionZone   string    // ex) us-east-1/us-east-1a
+	ResourceType string    `gorm:"index"` // ex) VM
+	ResourceName string    // ex) vm-01
+	CloudOSAPI   string    `gorm:"index"` // ex) RunInstances()
+	ElapsedTime  float64   // sec, -1: unknown
+	IsError      bool      `gorm:"index"`
+	ErrorMSG     string    `gorm:"type:text"`
+	RequestID    string    `gorm:"index"` // ex) cs3m8rqkn7pc73d0q0ag
+}
+
+func (CallLogInfo) TableName() string {
+	return "call_log_infos"
+}
+
+//====================================================================
+
+// CallLogSink receives the call-log records in batches, ex) forwarding to an external store.
+// The local table is always the source of the query API.
+type CallLogSink interface {
+	Store(infoList []CallLogInfo) error
+}
+
+type localCallLogSink struct{}
+
+func (localCallLogSink) Store(infoList []CallLogInfo) error {
+	return callLogDB.CreateInBatches(infoList, callLogBatchSize).Error
+}
+
+var (
+	callLogDB           *gorm.DB // nil: the store is not started
+	callLogStartOnce    sync.Once
+	callLogStartErr     error
+	callLogSinkList     = []CallLogSink{localCallLogSink{}}
+	callLogChan         = make(chan CallLogInfo, callLogBufferSize)
+	callLogDroppedCount int64
+)
+
+// AddCallLogSink adds a sink of the call-log records. It should be called at the init time.
+func AddCallLogSink(sink CallLogSink) {
+	callLogSinkList = append(callLogSinkList, sink)
+}
+
+// StartCallLogStore opens the call-log DB and starts the writer.
+// It should be called once before serving the APIs.
+func StartCallLogStore() error {
+	callLogStartOnce.Do(func() {
+		if strings.EqualFold(os.Getenv("SPIDER_CALLLOG_STORE"), "OFF") {
+			cblog.Info("call-log store is off")
+			return
+		}
+
+		db, err := openCallLogDB()
+		if err != nil {
+			cblog.Error(err)
+			callLogStartErr = err
+			return
+		}
+		callLogDB = db
+		dropMetaCallLogTable()
+
+		call.AddCallObserver(storeCallLog)
+		go runCallLogWriter(getCallLogRetention())
+	})
+	return callLogStartErr
+}
+
+func openCallLogDB() (*gorm.DB, error) {
+	db, err := infostore.OpenFile(CALLLOG_DB_FILE)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	// one connection: the batch writes and the queries do not conflict with SQLITE_BUSY
+	sqlDB.SetMaxOpenConns(1)
+	if err := db.Exec("PRAGMA journal_mode=WAL").Error; err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	if err := db.AutoMigrate(&CallLogInfo{}); err != nil {
+		sqlDB.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// dropMetaCallLogTable drops the call-log table of the previous versions in the meta DB
+func dropMetaCallLogTable() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	defer infostore.Close(db)
+
+	if db.Migrator().HasTable(&CallLogInfo{}) {
+		cblog.Info("drop the call-log table in the meta DB, the call-logs are stored in " + CALLLOG_DB_FILE)
+		if err := db.Migrator().DropTable(&CallLogInfo{}); err != nil {
+			cblog.Error(err)
+		}
+	}
+}
+
+// storeCallLog queues a call-log record without blocking the driver call.
+func storeCallLog(callInfo call.CLOUDLOGSCHEMA) {
+	if isSpiderCallLog(callInfo) {
+		return
+	}
+
+	elapsed, ok := call.ElapsedSeconds(callInfo)
+	if !ok {
+		elapsed = -1
+	}
+
+	info := CallLogInfo{
+		CallTime:     time.Now(),
+		CloudOS:      string(callInfo.CloudOS),
+		RegionZone:   callInfo.RegionZone,
+		ResourceType: string(callInfo.ResourceType),
+		ResourceName: callInfo.ResourceName,
+		CloudOSAPI:   callInfo.CloudOSAPI,
+		ElapsedTime:  elapsed,
+		IsError:      callInfo.ErrorMSG != "",
+		ErrorMSG:     callInfo.ErrorMSG,
+		RequestID:    callInfo.RequestID,
+	}
+
+	select {
+	case callLogChan <- info:
+	default:
+		// the writer is behind, the text call-log still has the record
+		if atomic.AddInt64(&callLogDroppedCount, 1)%1000 == 1 {
+			cblog.Errorf("call-log store is full, %d records are dropped", atomic.LoadInt64(&callLogDroppedCount))
+		}
+	}
+}
+
+func runCallLogWriter(retention time.Duration) {
+	flushTicker := time.NewTicker(callLogFlushInterval)
+	purgeTicker := time.NewTicker(callLogPurgeInterval)
+	defer flushTicker.Stop()
+	defer purgeTicker.Stop()
+
+	batch := make([]CallLogInfo, 0, callLogBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		for _, sink := range callLogSinkList {
+			if err := sink.Store(batch); err != nil {
+				cblog.Error(err)
+			}
+		}
+		batch = make([]CallLogInfo, 0, callLogBatchSize)
+	}
+
+	purgeCallLog(retention)
+	for {
+		select {
+		case info := <-callLogChan:
+			batch = append(batch, info)
+			if len(batch) >= callLogBatchSize {
+				flush()
+			}
+		case <-flushTicker.C:
+			flush()
+		case <-purgeTicker.C:
+			purgeCallLog(retention)
+		}
+	}
+}
+
+func purgeCallLog(retention time.Duration) {
+	result := callLogDB.Where(CALLLOG_TIME_COLUMN+" < ?", time.Now().Add(-retention)).Delete(&CallLogInfo{})
+	if result.Error != nil {
+		cblog.Error(result.Error)
+		return
+	}
+	if count := result.RowsAffected; count > 0 {
+		cblog.Infof("%d call-log records older than %v are purged", count, retention)
+	}
+}
+
+func getCallLogRetention() time.Duration {
+	retention := os.Getenv("SPIDER_CALLLOG_RETENTION")
+	if retention == "" {
+		return DEFAULT_CALLLOG_RETENTION
+	}
+	duration, err := time.ParseDuration(retention)
+	if err != nil || duration <= 0 {
+		cblog.Errorf("invalid SPIDER_CALLLOG_RETENTION(%s), use the default %v", retention, DEFAULT_CALLLOG_RETENTION)
+		return DEFAULT_CALLLOG_RETENTION
+	}
+	return duration
+}
+
+//================ Call-Log Query
+
+// CallLogQueryInfo is the conditions of a call-log query. The empty conditions are ignored.
+type CallLogQueryInfo struct {
+	CloudOS      string    // ex) AWS
+	Region       string    // ex) us-east-1, matched with the region of RegionZone
+	ResourceType string    // ex) VM
+	ResourceName string    // ex) vm-01
+	CloudOSAPI   string    // ex) RunInstances()
+	Status       string    // success | error
+	RequestID    string    // ex) cs3m8rqkn7pc73d0q0ag
+	StartTime    time.Time // inclusive
+	EndTime      time.Time // exclusive
+	Limit        int       // default: 100, max: 1000
+}
+
+// CallLogStatInfo is the statistics of the calls of a CSP API.
+type CallLogStatInfo struct {
+	CloudOS    string
+	CloudOSAPI string
+	Count      int
+	ErrorCount int
+	ErrorRate  float64 // 0.0 ~ 1.0
+	P50Latency float64 // sec
+	P95Latency float64 // sec
+}
+
+// ListCallLog returns the call-log records matched with the conditions, the latest first.
+func ListCallLog(query CallLogQueryInfo) ([]*CallLogInfo, error) {
+	cblog.Info("call ListCallLog()")
+
+	if err := checkCallLogQuery(&query); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	db, err := getCallLogDB()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList := []*CallLogInfo{}
+	err = whereCallLogQuery(db.Model(&CallLogInfo{}), query).
+		Order(CALLLOG_TIME_COLUMN + " desc").Limit(query.Limit).Find(&infoList).Error
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return infoList, nil
+}
+
+// GetCallLogStat returns the count, the error rate and the p50/p95 latency of each CSP API
+// of the call-log records matched with the conditions, aggregated in the DB.
+func GetCallLogStat(query CallLogQueryInfo) ([]*CallLogStatInfo, error) {
+	cblog.Info("call GetCallLogStat()")
+
+	if err := checkCallLogQuery(&query); err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	db, err := getCallLogDB()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	statList := []*CallLogStatInfo{}
+	err = whereCallLogQuery(db.Model(&CallLogInfo{}), query).
+		Select("cloud_os, cloud_os_api, COUNT(*) AS count, SUM(CASE WHEN is_error THEN 1 ELSE 0 END) AS error_count").
+		Group("cloud_os, cloud_os_api").Order("cloud_os, cloud_os_api").Scan(&statList).Error
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// nearest-rank percentiles of the known latencies, ranked by the window functions
+	rankQuery := whereCallLogQuery(db.Model(&CallLogInfo{}), query).Where("elapsed_time >= 0").
+		Select("cloud_os, cloud_os_api, elapsed_time, " +
+			"ROW_NUMBER() OVER (PARTITION BY cloud_os, cloud_os_api ORDER BY elapsed_time) AS row_rank, " +
+			"COUNT(*) OVER (PARTITION BY cloud_os, cloud_os_api) AS total")
+	latencyList := []struct {
+		CloudOS     string
+		CloudOSAPI  string
+		ElapsedTime float64
+		RowRank     int
+		Total       int
+	}{}
+	err = db.Table("(?) AS ranked", rankQuery).
+		Where("row_rank = (total * 50 + 99) / 100 OR row_rank = (total * 95 + 99) / 100").Scan(&latencyList).Error
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	statMap := map[string]*CallLogStatInfo{}
+	for _, stat := range statList {
+		stat.ErrorRate = float64(stat.ErrorCount) / float64(stat.Count)
+		statMap[stat.CloudOS+":"+stat.CloudOSAPI] = stat
+	}
+	for _, latency := range latencyList {
+		stat, ok := statMap[latency.CloudOS+":"+latency.CloudOSAPI]
+		if !ok {
+			continue
+		}
+		// both ranks are the same with a few records
+		if latency.RowRank == percentileRank(latency.Total, 50) {
+			stat.P50Latency = latency.ElapsedTime
+		}
+		if latency.RowRank == percentileRank(latency.Total, 95) {
+			stat.P95Latency = latency.ElapsedTime
+		}
+	}
+
+	return statList, nil
+}
+
+func getCallLogDB() (*gorm.DB, error) {
+	if callLogDB == nil {
+		return nil, fmt.Errorf("call-log store is not started, check SPIDER_CALLLOG_STORE")
+	}
+	return callLogDB, nil
+}
+
+func checkCallLogQuery(query *CallLogQueryInfo) error {
+	switch strings.ToLower(query.Status) {
+	case "", CALLLOG_SUCCESS, CALLLOG_ERROR:
+		query.Status = strings.ToLower(query.Status)
+	default:
+		return fmt.Errorf("invalid Status '%s' of the call-log query, use one of %s, %s", query.Status, CALLLOG_SUCCESS, CALLLOG_ERROR)
+	}
+	if !query.StartTime.IsZero() && !query.EndTime.IsZero() && !query.StartTime.Before(query.EndTime) {
+		return fmt.Errorf("StartTime(%v) of the call-log query should be before EndTime(%v)", query.StartTime, query.EndTime)
+	}
+	if query.Limit <= 0 {
+		query.Limit = DEFAULT_CALLLOG_QUERY_LIMIT
+	}
+	if query.Limit > MAX_CALLLOG_QUERY_LIMIT {
+		query.Limit = MAX_CALLLOG_QUERY_LIMIT
+	}
+	return nil
+}
+
+func whereCallLogQuery(db *gorm.DB, query CallLogQueryInfo) *gorm.DB {
+	if query.CloudOS != "" {
+		db = db.Where("cloud_os = ?", strings.ToUpper(query.CloudOS))
+	}
+	if query.Region != "" {
+		db = db.Where("(region_zone = ? OR region_zone LIKE ?)", query.Region, query.Region+"/%")
+	}
+	if query.ResourceType != "" {
+		db = db.Where("resource_type = ?", strings.ToUpper(query.ResourceType))
+	}
+	if query.ResourceName != "" {
+		db = db.Where("resource_name = ?", query.ResourceName)
+	}
+	if query.CloudOSAPI != "" {
+		db = db.Where("cloud_os_api = ?", query.CloudOSAPI)
+	}
+	if query.RequestID != "" {
+		db = db.Where("request_id = ?", query.RequestID)
+	}
+	switch query.Status {
+	case CALLLOG_SUCCESS:
+		db = db.Where("is_error = ?", false)
+	case CALLLOG_ERROR:
+		db = db.Where("is_error = ?", true)
+	}
+	if !query.StartTime.IsZero() {
+		db = db.Where(CALLLOG_TIME_COLUMN+" >= ?", query.StartTime)
+	}
+	if !query.EndTime.IsZero() {
+		db = db.Where(CALLLOG_TIME_COLUMN+" < ?", query.EndTime)
+	}
+	return db
+}
+
+// percentileRank returns the nearest-rank of the p-th percentile of the count values,
+// the same as the rank of the stat query: ceil(count * p / 100)
+func percentileRank(count int, p int) int {
+	return (count*p + 99) / 100
+}
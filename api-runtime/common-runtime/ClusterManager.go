@@ -15,6 +15,7 @@ import (
 	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
@@ -53,6 +54,8 @@ func init() {
 func GetClusterOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err error) {
 	cblog.Info("call GetClusterOwnerVPC()")
 
+	defer startSpan("GetClusterOwnerVPC", connectionName, cspID).end()
+
 	// check empty and trim user inputs
 	connectionName, err = EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -113,7 +116,9 @@ func GetClusterOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID,
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetCluster(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	getInfo, err := retryCall(connectionName, call.CLUSTER, "GetCluster()", func() (cres.ClusterInfo, error) {
+		return handler.GetCluster(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	})
 	if err != nil {
 		//vpcSPLock.RUnlock()
 		//clusterSPLock.RUnlock()
@@ -161,6 +166,8 @@ func GetClusterOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID,
 func RegisterCluster(connectionName string, vpcUserID string, userIID cres.IID) (*cres.ClusterInfo, error) {
 	cblog.Info("call RegisterCluster()")
 
+	defer startSpan("RegisterCluster", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -237,7 +244,9 @@ func RegisterCluster(connectionName string, vpcUserID string, userIID cres.IID)
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetCluster(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.CLUSTER, "GetCluster()", func() (cres.ClusterInfo, error) {
+		return handler.GetCluster(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -304,6 +313,8 @@ func registerNodeGroupList(connectionName string, info *cres.ClusterInfo) error
 func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInfo, IDTransformMode string) (*cres.ClusterInfo, error) {
 	cblog.Info("call CreateCluster()")
 
+	defer startSpan("CreateCluster", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -326,6 +337,20 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 	   }
 	*/
 
+	// apply the default tag policy of the connection
+	reqInfo.TagList, err = applyTagPolicy(connectionName, rsType, reqInfo.IId.NameId, reqInfo.TagList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check the admission policy before calling the driver
+	err = admitCluster(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	//+++++++++++++++++++++ Set NetworkInfo's SystemId
 	netReqInfo := &reqInfo.Network
 	vpcSPLock.RLock(connectionName, netReqInfo.VpcIID.NameId)
@@ -481,12 +506,33 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 	reqInfo.NodeGroupList = ngInfoList
 
 	// (3) create Resource
-	info, err := handler.CreateCluster(reqInfo)
+	info, err := guardCall(connectionName, func() (cres.ClusterInfo, error) {
+		return handler.CreateCluster(reqInfo)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
+	// record the created resources to undo them when a later step fails
+	sg := newSaga("CreateCluster", reqIId.NameId)
+	clusterDriverIId := info.IId
+	sg.done("CLUSTER-CSP", clusterDriverIId.SystemId, func() error {
+		_, err := guardCall(connectionName, func() (bool, error) {
+			return handler.DeleteCluster(clusterDriverIId)
+		})
+		return err
+	})
+	for _, ngInfo := range info.NodeGroupList {
+		ngDriverIId := ngInfo.IId
+		sg.done("NODEGROUP-CSP", ngDriverIId.SystemId, func() error {
+			_, err := guardCall(connectionName, func() (bool, error) {
+				return handler.RemoveNodeGroup(clusterDriverIId, ngDriverIId)
+			})
+			return err
+		})
+	}
+
 	// (4) create spiderIID: {reqNameID, "driverNameID:driverSystemID"}
 	//     ex) spiderIID {"seoul-service", "vm-01-9m4e2mr0ui3e8a215n4g:i-0bc7123b7e5cbf79d"}
 	spiderIId := cres.IID{NameId: reqIId.NameId, SystemId: spUUID + ":" + info.IId.SystemId}
@@ -497,15 +543,12 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 	err = infostore.Insert(&iidInfo)
 	if err != nil {
 		cblog.Error(err)
-		// rollback
-		_, err2 := handler.DeleteCluster(info.IId)
-		if err2 != nil {
-			cblog.Error(err2)
-			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-		}
-		cblog.Error(err)
-		return nil, err
+		return nil, sg.rollback(err)
 	}
+	sg.done("CLUSTER-IID", iidInfo.NameId, func() error {
+		_, err := infostore.DeleteByConditions(&ClusterIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.NameId)
+		return err
+	})
 
 	// insert spiderIID for NodeGroup list
 	for _, ngInfo := range info.NodeGroupList {
@@ -521,33 +564,13 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 			OwnerClusterName: reqIId.NameId})
 		if err != nil {
 			cblog.Error(err)
-			// rollback
-			// (1) for resource
-			cblog.Info("<<ROLLBACK:TRY:CLUSTER-CSP>> " + info.IId.SystemId)
-			_, err2 := handler.DeleteCluster(info.IId)
-			if err2 != nil {
-				cblog.Error(err2)
-				return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-			}
-			// (2) for Cluster IID
-			cblog.Info("<<ROLLBACK:TRY:CLUSTER-IID>> " + info.IId.NameId)
-			_, err3 := infostore.DeleteByConditions(&ClusterIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.NameId)
-			if err3 != nil {
-				cblog.Error(err3)
-				return nil, fmt.Errorf(err.Error() + ", " + err3.Error())
-			}
-			// (3) for NodeGroup IID List
-			// delete all nodegroups of target Cluster
-			_, err := infostore.DeleteByConditions(&NodeGroupIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName,
-				OWNER_CLUSTER_NAME_COLUMN, iidInfo.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-
-			cblog.Error(err)
-			return nil, err
+			return nil, sg.rollback(err)
 		}
+		sg.done("NODEGROUP-IID", ngSpiderIId.NameId, func() error {
+			_, err := infostore.DeleteBy3Conditions(&NodeGroupIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName,
+				NAME_ID_COLUMN, ngSpiderIId.NameId, OWNER_CLUSTER_NAME_COLUMN, reqIId.NameId)
+			return err
+		})
 	}
 
 	// (6) create userIID: {reqNameID, driverSystemID}
@@ -558,7 +581,7 @@ func CreateCluster(connectionName string, rsType string, reqInfo cres.ClusterInf
 	err = setResourcesNameId(connectionName, &info)
 	if err != nil {
 		cblog.Error(err)
-		return nil, err
+		return nil, sg.rollback(err)
 	}
 
 	return &info, nil
@@ -677,6 +700,8 @@ func setResourcesNameId(connectionName string, info *cres.ClusterInfo) error {
 func ListCluster(connectionName string, nameSpace string, rsType string) ([]*cres.ClusterInfo, error) {
 	cblog.Info("call ListCluster()")
 
+	defer startSpan("ListCluster", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -726,7 +751,9 @@ func ListCluster(connectionName string, nameSpace string, rsType string) ([]*cre
 		clusterSPLock.RLock(connectionName, iidInfo.NameId)
 
 		// get resource(SystemId)
-		info, err := handler.GetCluster(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		info, err := retryCall(connectionName, call.CLUSTER, "GetCluster()", func() (cres.ClusterInfo, error) {
+			return handler.GetCluster(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		})
 		if err != nil {
 			clusterSPLock.RUnlock(connectionName, iidInfo.NameId)
 			if checkNotFoundError(err) {
@@ -761,6 +788,8 @@ func ListCluster(connectionName string, nameSpace string, rsType string) ([]*cre
 func GetCluster(connectionName string, rsType string, clusterName string) (*cres.ClusterInfo, error) {
 	cblog.Info("call GetCluster()")
 
+	defer startSpan("GetCluster", connectionName, clusterName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -812,7 +841,9 @@ func GetCluster(connectionName string, rsType string, clusterName string) (*cres
 	}
 
 	// (2) get resource(SystemId)
-	info, err := handler.GetCluster(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.CLUSTER, "GetCluster()", func() (cres.ClusterInfo, error) {
+		return handler.GetCluster(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -839,6 +870,8 @@ func GetCluster(connectionName string, rsType string, clusterName string) (*cres
 func AddNodeGroup(connectionName string, rsType string, clusterName string, reqInfo cres.NodeGroupInfo) (*cres.ClusterInfo, error) {
 	cblog.Info("call AddNodeGroup()")
 
+	defer startSpan("AddNodeGroup", connectionName, clusterName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -858,6 +891,13 @@ func AddNodeGroup(connectionName string, rsType string, clusterName string, reqI
 		return nil, err
 	}
 
+	// check the admission policy before calling the driver
+	err = admitNodeGroup(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	//+++++++++++++++++++++ Set NodeGroupInfo's SystemId
 	// (1) ImageIID
 	reqInfo.ImageIID.SystemId = reqInfo.ImageIID.NameId
@@ -970,7 +1010,9 @@ func AddNodeGroup(connectionName string, rsType string, clusterName string, reqI
 	}
 
 	// (3) Get ClusterInfo
-	info, err := handler.GetCluster(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.CLUSTER, "GetCluster()", func() (cres.ClusterInfo, error) {
+		return handler.GetCluster(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1038,6 +1080,8 @@ func translateRootDiskInfo(providerName string, reqInfo *cres.NodeGroupInfo) err
 func SetNodeGroupAutoScaling(connectionName string, clusterName string, nodeGroupName string, on bool) (bool, error) {
 	cblog.Info("call SetNodeGroupAutoScaling()")
 
+	defer startSpan("SetNodeGroupAutoScaling", connectionName, clusterName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1155,6 +1199,8 @@ func ChangeNodeGroupScaling(connectionName string, clusterName string, nodeGroup
 	DesiredNodeSize int, MinNodeSize int, MaxNodeSize int) (cres.NodeGroupInfo, error) {
 	cblog.Info("call ChangeNodeGroupScaling()")
 
+	defer startSpan("ChangeNodeGroupScaling", connectionName, clusterName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1231,6 +1277,8 @@ func ChangeNodeGroupScaling(connectionName string, clusterName string, nodeGroup
 func RemoveNodeGroup(connectionName string, clusterName string, nodeGroupName string, force string) (bool, error) {
 	cblog.Info("call RemoveNodeGroup()")
 
+	defer startSpan("RemoveNodeGroup", connectionName, clusterName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1303,6 +1351,8 @@ func RemoveNodeGroup(connectionName string, clusterName string, nodeGroupName st
 func RemoveCSPNodeGroup(connectionName string, clusterName string, systemID string) (bool, error) {
 	cblog.Info("call RemoveNodeGroup()")
 
+	defer startSpan("RemoveCSPNodeGroup", connectionName, clusterName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1357,6 +1407,8 @@ func RemoveCSPNodeGroup(connectionName string, clusterName string, systemID stri
 func UpgradeCluster(connectionName string, clusterName string, newVersion string) (cres.ClusterInfo, error) {
 	cblog.Info("call UpgradeCluster()")
 
+	defer startSpan("UpgradeCluster", connectionName, clusterName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1422,6 +1474,8 @@ func UpgradeCluster(connectionName string, clusterName string, newVersion string
 func DeleteCluster(connectionName string, rsType string, nameID string, force string) (bool, error) {
 	cblog.Info("call DeleteCluster()")
 
+	defer startSpan("DeleteCluster", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
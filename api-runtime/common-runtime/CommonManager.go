@@ -11,6 +11,7 @@ package commonruntime
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -175,6 +176,13 @@ func getMSShortID(inID string) string {
 	return shortID
 }
 
+// prefix of the call-logs of CB-Spider itself, ex) "CB-Spider:StartVM()", which are not CSP API calls
+const SPIDER_CALL_LOG_PREFIX = "CB-Spider:"
+
+func isSpiderCallLog(callInfo call.CLOUDLOGSCHEMA) bool {
+	return strings.HasPrefix(callInfo.CloudOSAPI, SPIDER_CALL_LOG_PREFIX)
+}
+
 func checkNotFoundError(err error) bool {
 	msg := err.Error()
 	msg = strings.ReplaceAll(msg, " ", "")
@@ -249,6 +257,8 @@ func makeUserIID(NameId string, SystemId string) cres.IID {
 func UnregisterResource(connectionName string, rsType string, nameId string) (bool, error) {
 	cblog.Info("call UnregisterResource()")
 
+	defer startSpan("UnregisterResource", connectionName, nameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -472,6 +482,8 @@ func UnregisterResource(connectionName string, rsType string, nameId string) (bo
 func ListAllResource(connectionName string, rsType string) (AllResourceList, error) {
 	cblog.Info("call ListAllResource()")
 
+	defer startSpan("ListAllResource", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -619,7 +631,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 	iidCSPList := []*cres.IID{}
 	switch rsType {
 	case VPC:
-		infoList, err := handler.(cres.VPCHandler).ListVPC()
+		infoList, err := retryCall(connectionName, call.VPCSUBNET, "ListVPC()", func() ([]*cres.VPCInfo, error) {
+			return handler.(cres.VPCHandler).ListVPC()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -630,7 +644,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			}
 		}
 	case SG:
-		infoList, err := handler.(cres.SecurityHandler).ListSecurity()
+		infoList, err := retryCall(connectionName, call.SECURITYGROUP, "ListSecurity()", func() ([]*cres.SecurityInfo, error) {
+			return handler.(cres.SecurityHandler).ListSecurity()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -641,7 +657,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			}
 		}
 	case KEY:
-		infoList, err := handler.(cres.KeyPairHandler).ListKey()
+		infoList, err := retryCall(connectionName, call.VMKEYPAIR, "ListKey()", func() ([]*cres.KeyPairInfo, error) {
+			return handler.(cres.KeyPairHandler).ListKey()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -652,7 +670,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			}
 		}
 	case VM:
-		infoList, err := handler.(cres.VMHandler).ListVM()
+		infoList, err := retryCall(connectionName, call.VM, "ListVM()", func() ([]*cres.VMInfo, error) {
+			return handler.(cres.VMHandler).ListVM()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -663,7 +683,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			}
 		}
 	case NLB:
-		infoList, err := handler.(cres.NLBHandler).ListNLB()
+		infoList, err := retryCall(connectionName, call.NLB, "ListNLB()", func() ([]*cres.NLBInfo, error) {
+			return handler.(cres.NLBHandler).ListNLB()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -674,7 +696,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			}
 		}
 	case DISK:
-		infoList, err := handler.(cres.DiskHandler).ListDisk()
+		infoList, err := retryCall(connectionName, call.DISK, "ListDisk()", func() ([]*cres.DiskInfo, error) {
+			return handler.(cres.DiskHandler).ListDisk()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -685,7 +709,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			}
 		}
 	case MYIMAGE:
-		infoList, err := handler.(cres.MyImageHandler).ListMyImage()
+		infoList, err := retryCall(connectionName, call.MYIMAGE, "ListMyImage()", func() ([]*cres.MyImageInfo, error) {
+			return handler.(cres.MyImageHandler).ListMyImage()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -696,7 +722,9 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 			}
 		}
 	case CLUSTER:
-		infoList, err := handler.(cres.ClusterHandler).ListCluster()
+		infoList, err := retryCall(connectionName, call.CLUSTER, "ListCluster()", func() ([]*cres.ClusterInfo, error) {
+			return handler.(cres.ClusterHandler).ListCluster()
+		})
 		if err != nil {
 			cblog.Error(err)
 			return AllResourceList{}, err
@@ -786,6 +814,8 @@ func ListAllResource(connectionName string, rsType string) (AllResourceList, err
 func DeleteCSPResource(connectionName string, rsType string, systemID string) (bool, cres.VMStatus, error) {
 	cblog.Info("call DeleteCSPResource()")
 
+	defer startSpan("DeleteCSPResource", connectionName, systemID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -975,6 +1005,8 @@ func findDiskOwnerZoneId(connectionName string, systemID string) (string, error)
 func GetCSPResourceInfo(connectionName string, rsType string, systemID string) ([]byte, error) {
 	cblog.Info("call GetCSPResourceInfo()")
 
+	defer startSpan("GetCSPResourceInfo", connectionName, systemID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1055,56 +1087,72 @@ func GetCSPResourceInfo(connectionName string, rsType string, systemID string) (
 	jsonResult := []byte{}
 	switch rsType {
 	case VPC:
-		result, err := handler.(cres.VPCHandler).GetVPC(iid)
+		result, err := retryCall(connectionName, call.VPCSUBNET, "GetVPC()", func() (cres.VPCInfo, error) {
+			return handler.(cres.VPCHandler).GetVPC(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
 	case SG:
-		result, err := handler.(cres.SecurityHandler).GetSecurity(iid)
+		result, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+			return handler.(cres.SecurityHandler).GetSecurity(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
 	case KEY:
-		result, err := handler.(cres.KeyPairHandler).GetKey(iid)
+		result, err := retryCall(connectionName, call.VMKEYPAIR, "GetKey()", func() (cres.KeyPairInfo, error) {
+			return handler.(cres.KeyPairHandler).GetKey(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
 	case VM:
-		result, err := handler.(cres.VMHandler).GetVM(iid)
+		result, err := retryCall(connectionName, call.VM, "GetVM()", func() (cres.VMInfo, error) {
+			return handler.(cres.VMHandler).GetVM(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
 	case NLB:
-		result, err := handler.(cres.NLBHandler).GetNLB(iid)
+		result, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+			return handler.(cres.NLBHandler).GetNLB(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
 	case DISK:
-		result, err := handler.(cres.DiskHandler).GetDisk(iid)
+		result, err := retryCall(connectionName, call.DISK, "GetDisk()", func() (cres.DiskInfo, error) {
+			return handler.(cres.DiskHandler).GetDisk(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
 	case MYIMAGE:
-		result, err := handler.(cres.MyImageHandler).GetMyImage(iid)
+		result, err := retryCall(connectionName, call.MYIMAGE, "GetMyImage()", func() (cres.MyImageInfo, error) {
+			return handler.(cres.MyImageHandler).GetMyImage(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
 		}
 		jsonResult, _ = json.Marshal(result)
 	case CLUSTER:
-		result, err := handler.(cres.ClusterHandler).GetCluster(iid)
+		result, err := retryCall(connectionName, call.CLUSTER, "GetCluster()", func() (cres.ClusterInfo, error) {
+			return handler.(cres.ClusterHandler).GetCluster(iid)
+		})
 		if err != nil {
 			cblog.Error(err)
 			return nil, err
@@ -1123,6 +1171,8 @@ func GetCSPResourceInfo(connectionName string, rsType string, systemID string) (
 func GetCSPResourceName(connectionName string, rsType string, nameID string) (string, error) {
 	cblog.Info("call GetCSPResourceName()")
 
+	defer startSpan("GetCSPResourceName", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1283,8 +1333,34 @@ type RemainedErrorInfo struct {
 	ErrorMsg string `json:"ErrorMsg"`
 }
 
+// The delete attempts of Destroy wait for the dependent resources to be released,
+// ex) an SG used by a terminating VM, so they are not driven by the retry policy of the driver calls.
+//
+// env)
+//	SPIDER_DESTROY_MAX_ATTEMPTS : max delete attempts of each resource type in Destroy (default: 10)
+
+const (
+	DEFAULT_DESTROY_MAX_ATTEMPTS = 10
+	DESTROY_RETRY_DELAY          = 3 * time.Second
+)
+
+func getDestroyMaxAttempts() int {
+	value := os.Getenv("SPIDER_DESTROY_MAX_ATTEMPTS")
+	if value == "" {
+		return DEFAULT_DESTROY_MAX_ATTEMPTS
+	}
+	attempts, err := strconv.Atoi(value)
+	if err != nil || attempts < 1 {
+		cblog.Errorf("invalid SPIDER_DESTROY_MAX_ATTEMPTS(%s), use the default %d", value, DEFAULT_DESTROY_MAX_ATTEMPTS)
+		return DEFAULT_DESTROY_MAX_ATTEMPTS
+	}
+	return attempts
+}
+
 // Destroy all Resources in a Connection
 func Destroy(connectionName string) (DestroyedInfo, error) {
+	defer startSpan("Destroy", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1294,6 +1370,7 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 
 	var destroyedInfo DestroyedInfo
 	destroyedInfo.IsAllDestroyed = true
+	maxAttempts := getDestroyMaxAttempts()
 
 	// Define resource type groups
 	resourceTypeGroups := [][]string{
@@ -1306,6 +1383,7 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 
 	for _, resourceTypes := range resourceTypeGroups {
 		var wg sync.WaitGroup
+		reqCtx := getRequestContext()
 		var mu sync.Mutex
 		var groupErr error
 
@@ -1313,11 +1391,12 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 			wg.Add(1)
 			go func(resourceType string) {
 				defer wg.Done()
+				defer bindRequestContext(reqCtx)()
 
 				var finalDeletedResourceInfoList DeletedResourceInfoList
 				finalDeletedResourceInfoList.ResourceType = resourceType
 
-				for retry := 0; retry < 10; retry++ {
+				for attempt := 1; attempt <= maxAttempts; attempt++ {
 					deletedResourceInfoList, err := deleteAllResourcesInResType(connectionName, resourceType)
 					mu.Lock()
 					if err != nil {
@@ -1344,7 +1423,10 @@ func Destroy(connectionName string) (DestroyedInfo, error) {
 						return
 					}
 					mu.Unlock()
-					time.Sleep(3 * time.Second)
+					if attempt < maxAttempts {
+						// wait for the dependent resources to be released
+						time.Sleep(DESTROY_RETRY_DELAY)
+					}
 				}
 
 				mu.Lock()
@@ -1383,12 +1465,14 @@ func deleteAllResourcesInResType(connectionName string, rsType string) (*Deleted
 	}
 
 	var wg sync.WaitGroup
+	reqCtx := getRequestContext()
 	var mu sync.Mutex
 
 	for _, nameId := range nameList {
 		wg.Add(1)
 		go func(nameId string) {
 			defer wg.Done()
+			defer bindRequestContext(reqCtx)()
 			var err error
 
 			switch rsType {
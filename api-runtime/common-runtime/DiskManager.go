@@ -13,6 +13,7 @@ import (
 	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
@@ -49,6 +50,8 @@ func init() {
 func RegisterDisk(connectionName string, zoneId string, userIID cres.IID) (*cres.DiskInfo, error) {
 	cblog.Info("call RegisterDisk()")
 
+	defer startSpan("RegisterDisk", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -96,7 +99,9 @@ func RegisterDisk(connectionName string, zoneId string, userIID cres.IID) (*cres
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetDisk(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.DISK, "GetDisk()", func() (cres.DiskInfo, error) {
+		return handler.GetDisk(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -131,6 +136,8 @@ func RegisterDisk(connectionName string, zoneId string, userIID cres.IID) (*cres
 func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDTransformMode string) (*cres.DiskInfo, error) {
 	cblog.Info("call CreateDisk()")
 
+	defer startSpan("CreateDisk", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -161,6 +168,20 @@ func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDT
 		return nil, err
 	}
 
+	// apply the default tag policy of the connection
+	reqInfo.TagList, err = applyTagPolicy(connectionName, rsType, reqInfo.IId.NameId, reqInfo.TagList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check the admission policy before calling the driver
+	err = admitDisk(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	diskSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer diskSPLock.Unlock(connectionName, reqInfo.IId.NameId)
 
@@ -243,6 +264,8 @@ func CreateDisk(connectionName string, rsType string, reqInfo cres.DiskInfo, IDT
 func ListDisk(connectionName string, rsType string) ([]*cres.DiskInfo, error) {
 	cblog.Info("call ListDisk()")
 
+	defer startSpan("ListDisk", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -285,7 +308,9 @@ func ListDisk(connectionName string, rsType string) ([]*cres.DiskInfo, error) {
 		}
 
 		// get resource(SystemId)
-		info, err := handler.GetDisk(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		info, err := retryCall(connectionName, call.DISK, "GetDisk()", func() (cres.DiskInfo, error) {
+			return handler.GetDisk(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		})
 		if err != nil {
 			diskSPLock.RUnlock(connectionName, iidInfo.NameId)
 			if checkNotFoundError(err) {
@@ -322,6 +347,8 @@ func ListDisk(connectionName string, rsType string) ([]*cres.DiskInfo, error) {
 func GetDisk(connectionName string, rsType string, nameID string) (*cres.DiskInfo, error) {
 	cblog.Info("call GetDisk()")
 
+	defer startSpan("GetDisk", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -359,7 +386,9 @@ func GetDisk(connectionName string, rsType string, nameID string) (*cres.DiskInf
 	}
 
 	// (2) get resource(SystemId)
-	info, err := handler.GetDisk(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.DISK, "GetDisk()", func() (cres.DiskInfo, error) {
+		return handler.GetDisk(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -386,6 +415,8 @@ func GetDisk(connectionName string, rsType string, nameID string) (*cres.DiskInf
 func ChangeDiskSize(connectionName string, diskName string, size string) (bool, error) {
 	cblog.Info("call ChangeDiskSize()")
 
+	defer startSpan("ChangeDiskSize", connectionName, diskName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -404,6 +435,13 @@ func ChangeDiskSize(connectionName string, diskName string, size string) (bool,
 		return false, err
 	}
 
+	// check the admission policy before calling the driver
+	err = admitDiskSize(connectionName, diskName, size)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
 	diskSPLock.Lock(connectionName, diskName)
 	defer diskSPLock.Unlock(connectionName, diskName)
 
@@ -451,6 +489,8 @@ func ChangeDiskSize(connectionName string, diskName string, size string) (bool,
 func AttachDisk(connectionName string, diskName string, ownerVMName string) (*cres.DiskInfo, error) {
 	cblog.Info("call AttachDisk()")
 
+	defer startSpan("AttachDisk", connectionName, diskName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -531,6 +571,8 @@ func AttachDisk(connectionName string, diskName string, ownerVMName string) (*cr
 func DetachDisk(connectionName string, diskName string, ownerVMName string) (bool, error) {
 	cblog.Info("call DetachDisk()")
 
+	defer startSpan("DetachDisk", connectionName, diskName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -597,6 +639,8 @@ func DetachDisk(connectionName string, diskName string, ownerVMName string) (boo
 func DeleteDisk(connectionName string, rsType string, nameID string, force string) (bool, error) {
 	cblog.Info("call DeleteDisk()")
 
+	defer startSpan("DeleteDisk", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -0,0 +1,420 @@
+// Dry-Run Validation Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+//================ Dry-Run Validation of Create Requests
+// The dry-run runs all validations of Spider(and of the driver if supported) without creating anything,
+// and returns every problem found instead of the first error.
+
+type DryRunResultInfo struct {
+	ConnectionName string
+	ResType        cres.RSType
+	Name           string
+	Valid          bool
+	ProblemList    []string
+}
+
+func newDryRunResult(connectionName string, rsType string, name string) *DryRunResultInfo {
+	return &DryRunResultInfo{ConnectionName: connectionName, ResType: cres.RSType(rsType), Name: name, Valid: true, ProblemList: []string{}}
+}
+
+// returns true if no problem
+func (result *DryRunResultInfo) check(err error) bool {
+	if err == nil {
+		return true
+	}
+	result.Valid = false
+	result.ProblemList = append(result.ProblemList, err.Error())
+	return false
+}
+
+func (result *DryRunResultInfo) checkUnique(info interface{}, rsType string, name string) bool {
+	bool_ret, err := infostore.HasByConditions(info, CONNECTION_NAME_COLUMN, result.ConnectionName, NAME_ID_COLUMN, name)
+	if err != nil {
+		return result.check(err)
+	}
+	if bool_ret {
+		return result.check(fmt.Errorf("The %s '%s' already exists!", RSTypeString(rsType), name))
+	}
+	return true
+}
+
+func (result *DryRunResultInfo) checkExists(info interface{}, rsType string, name string) bool {
+	bool_ret, err := infostore.HasByConditions(info, CONNECTION_NAME_COLUMN, result.ConnectionName, NAME_ID_COLUMN, name)
+	if err != nil {
+		return result.check(err)
+	}
+	if !bool_ret {
+		return result.check(fmt.Errorf("The %s '%s' does not exist!", RSTypeString(rsType), name))
+	}
+	return true
+}
+
+func (result *DryRunResultInfo) checkSubnetExists(vpcName string, subnetName string) bool {
+	bool_ret, err := infostore.HasBy3Conditions(&SubnetIIDInfo{}, CONNECTION_NAME_COLUMN, result.ConnectionName,
+		NAME_ID_COLUMN, subnetName, OWNER_VPC_NAME_COLUMN, vpcName)
+	if err != nil {
+		return result.check(err)
+	}
+	if !bool_ret {
+		return result.check(fmt.Errorf("The %s '%s' does not exist in the %s '%s'!",
+			RSTypeString(SUBNET), subnetName, RSTypeString(VPC), vpcName))
+	}
+	return true
+}
+
+// checks the tag policy and sets the merged tag list
+func (result *DryRunResultInfo) checkTagPolicy(rsType string, name string, tagList *[]cres.KeyValue) {
+	mergedList, err := applyTagPolicy(result.ConnectionName, rsType, name, *tagList)
+	if result.check(err) {
+		*tagList = mergedList
+	}
+}
+
+func getDryRunProviderName(connectionName string) (string, string, error) {
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		return "", "", err
+	}
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		return "", "", err
+	}
+	return connectionName, providerName, nil
+}
+
+func DryRunVM(connectionName string, rsType string, reqInfo cres.VMReqInfo) (*DryRunResultInfo, error) {
+	cblog.Info("call DryRunVM()")
+
+	connectionName, providerName, err := getDryRunProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	result := newDryRunResult(connectionName, rsType, reqInfo.IId.NameId)
+
+	result.check(ValidateStruct(reqInfo, vmReqEmptyPermissionList))
+	result.check(checkImageType(&reqInfo))
+	result.checkTagPolicy(rsType, reqInfo.IId.NameId, &reqInfo.TagList)
+	result.check(admitVM(connectionName, reqInfo))
+	result.checkUnique(&VMIIDInfo{}, VM, reqInfo.IId.NameId)
+
+	// references
+	refOK := result.checkExists(&VPCIIDInfo{}, VPC, reqInfo.VpcIID.NameId)
+	refOK = result.checkSubnetExists(reqInfo.VpcIID.NameId, reqInfo.SubnetIID.NameId) && refOK
+	for _, sgIID := range reqInfo.SecurityGroupIIDs {
+		refOK = result.checkExists(&SGIIDInfo{}, SG, sgIID.NameId) && refOK
+	}
+	if reqInfo.KeyPairIID.NameId != "" {
+		refOK = result.checkExists(&KeyIIDInfo{}, KEY, reqInfo.KeyPairIID.NameId) && refOK
+	}
+	for _, diskIID := range reqInfo.DataDiskIIDs {
+		refOK = result.checkExists(&DiskIIDInfo{}, DISK, diskIID.NameId) && refOK
+	}
+	if reqInfo.ImageType == cres.MyImage {
+		refOK = result.checkExists(&MyImageIIDInfo{}, MYIMAGE, reqInfo.ImageIID.NameId) && refOK
+	} else {
+		_, err := GetImage(connectionName, IMAGE, reqInfo.ImageIID.NameId)
+		refOK = result.check(wrapDryRunError("Image", reqInfo.ImageIID.NameId, err)) && refOK
+	}
+
+	// spec and root disk
+	_, err = GetVMSpec(connectionName, reqInfo.VMSpecName)
+	refOK = result.check(wrapDryRunError("VMSpec", reqInfo.VMSpecName, err)) && refOK
+	refOK = result.check(translateRootDiskSetupInfo(providerName, &reqInfo)) && refOK
+
+	// the driver's validation with the driver IIDs
+	if refOK {
+		result.check(validateVMByDriver(connectionName, reqInfo))
+	}
+
+	return result, nil
+}
+
+func wrapDryRunError(target string, name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s '%s': %v", target, name, err)
+}
+
+func validateVMByDriver(connectionName string, reqInfo cres.VMReqInfo) error {
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		return err
+	}
+	handler, err := cldConn.CreateVMHandler()
+	if err != nil {
+		return err
+	}
+	validator, ok := handler.(cres.VMReqValidator)
+	if !ok {
+		// the driver does not support the dry-run
+		return nil
+	}
+
+	reqInfoForDriver, err := cloneReqInfoWithDriverIID(connectionName, reqInfo)
+	if err != nil {
+		return err
+	}
+	reqInfoForDriver.IId = cres.IID{NameId: reqInfo.IId.NameId}
+
+	err = validator.ValidateVM(reqInfoForDriver)
+	if err != nil {
+		return fmt.Errorf("driver validation: %v", err)
+	}
+	return nil
+}
+
+func DryRunVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo) (*DryRunResultInfo, error) {
+	cblog.Info("call DryRunVPC()")
+
+	connectionName, _, err := getDryRunProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	result := newDryRunResult(connectionName, rsType, reqInfo.IId.NameId)
+
+	result.check(ValidateStruct(reqInfo, vpcReqEmptyPermissionList))
+	result.check(validateVPCIPv6Args(connectionName, reqInfo))
+	result.checkTagPolicy(rsType, reqInfo.IId.NameId, &reqInfo.TagList)
+	result.checkUnique(&VPCIIDInfo{}, VPC, reqInfo.IId.NameId)
+
+	var vpcNet *net.IPNet
+	if reqInfo.IPv4_CIDR != "" {
+		_, vpcNet, err = net.ParseCIDR(reqInfo.IPv4_CIDR)
+		result.check(wrapDryRunError("VPC IPv4_CIDR", reqInfo.IPv4_CIDR, err))
+	}
+
+	subnetNameMap := map[string]bool{}
+	for _, subnet := range reqInfo.SubnetInfoList {
+		if subnetNameMap[subnet.IId.NameId] {
+			result.check(fmt.Errorf("The %s '%s' is duplicated in the request!", RSTypeString(SUBNET), subnet.IId.NameId))
+		}
+		subnetNameMap[subnet.IId.NameId] = true
+
+		ip, _, err := net.ParseCIDR(subnet.IPv4_CIDR)
+		if !result.check(wrapDryRunError("Subnet IPv4_CIDR", subnet.IPv4_CIDR, err)) {
+			continue
+		}
+		if vpcNet != nil && !vpcNet.Contains(ip) {
+			result.check(fmt.Errorf("The Subnet '%s' CIDR %s is out of the VPC CIDR %s!", subnet.IId.NameId, subnet.IPv4_CIDR, reqInfo.IPv4_CIDR))
+		}
+	}
+
+	return result, nil
+}
+
+func DryRunSecurity(connectionName string, rsType string, reqInfo cres.SecurityReqInfo) (*DryRunResultInfo, error) {
+	cblog.Info("call DryRunSecurity()")
+
+	connectionName, _, err := getDryRunProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	result := newDryRunResult(connectionName, rsType, reqInfo.IId.NameId)
+
+	if _, err := EmptyCheckAndTrim("Name", reqInfo.IId.NameId); err != nil {
+		result.check(err)
+	}
+	result.checkUnique(&SGIIDInfo{}, SG, reqInfo.IId.NameId)
+	result.checkExists(&VPCIIDInfo{}, VPC, reqInfo.VpcIID.NameId)
+
+	if reqInfo.SecurityRules != nil {
+		ruleList := append([]cres.SecurityRuleInfo{}, *reqInfo.SecurityRules...)
+		transformArgs(&ruleList)
+		result.check(validateRuleArgs(connectionName, &ruleList))
+		result.check(checkPeerSGRules(connectionName, ruleList))
+		for _, rule := range ruleList {
+			if rule.PeerSecurityGroupIID != nil {
+				result.checkExists(&SGIIDInfo{}, SG, rule.PeerSecurityGroupIID.NameId)
+			}
+		}
+		reqInfo.SecurityRules = &ruleList
+	}
+
+	result.checkTagPolicy(rsType, reqInfo.IId.NameId, &reqInfo.TagList)
+	result.check(admitSecurity(connectionName, reqInfo))
+
+	return result, nil
+}
+
+func DryRunNLB(connectionName string, rsType string, reqInfo cres.NLBInfo) (*DryRunResultInfo, error) {
+	cblog.Info("call DryRunNLB()")
+
+	connectionName, _, err := getDryRunProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	result := newDryRunResult(connectionName, rsType, reqInfo.IId.NameId)
+
+	transformArgsToUpper(&reqInfo)
+	result.checkUnique(&NLBIIDInfo{}, NLB, reqInfo.IId.NameId)
+	result.checkExists(&VPCIIDInfo{}, VPC, reqInfo.VpcIID.NameId)
+	if reqInfo.VMGroup.VMs != nil {
+		for _, vmIID := range *reqInfo.VMGroup.VMs {
+			result.checkExists(&VMIIDInfo{}, VM, vmIID.NameId)
+		}
+	}
+
+	result.check(checkDryRunPort("Listener.Port", reqInfo.Listener.Port))
+	result.check(checkDryRunPort("VMGroup.Port", reqInfo.VMGroup.Port))
+
+	result.checkTagPolicy(rsType, reqInfo.IId.NameId, &reqInfo.TagList)
+	result.check(admitNLB(connectionName, reqInfo))
+
+	return result, nil
+}
+
+func checkDryRunPort(target string, port string) error {
+	portNum, err := strconv.Atoi(strings.TrimSpace(port))
+	if err != nil || portNum < 1 || portNum > 65535 {
+		return fmt.Errorf("The %s '%s' is not a valid port(1-65535)!", target, port)
+	}
+	return nil
+}
+
+func DryRunDisk(connectionName string, rsType string, reqInfo cres.DiskInfo) (*DryRunResultInfo, error) {
+	cblog.Info("call DryRunDisk()")
+
+	connectionName, providerName, err := getDryRunProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	result := newDryRunResult(connectionName, rsType, reqInfo.IId.NameId)
+
+	result.checkUnique(&DiskIIDInfo{}, DISK, reqInfo.IId.NameId)
+	for _, err := range checkDiskArgs(providerName, reqInfo.DiskType, reqInfo.DiskSize) {
+		result.check(err)
+	}
+	result.checkTagPolicy(rsType, reqInfo.IId.NameId, &reqInfo.TagList)
+	result.check(admitDisk(connectionName, reqInfo))
+
+	return result, nil
+}
+
+// checks the disk type and the size range with cloudos_meta.yaml
+// ex) disksize: standard|1|1024|GB / gp2|1|16384|GB
+func checkDiskArgs(providerName string, diskType string, diskSize string) []error {
+	cloudOSMetaInfo, err := cim.GetCloudOSMetaInfo(providerName)
+	if err != nil {
+		return []error{err}
+	}
+
+	errList := []error{}
+	if diskType == "" || strings.EqualFold(diskType, "default") {
+		if len(cloudOSMetaInfo.DiskType) == 0 {
+			return errList
+		}
+		diskType = cloudOSMetaInfo.DiskType[0]
+	} else if len(cloudOSMetaInfo.DiskType) > 0 && !validateRootDiskType(diskType, cloudOSMetaInfo.DiskType) {
+		errList = append(errList, fmt.Errorf("%s is not a valid Disk Type of %s!", diskType, providerName))
+		return errList
+	}
+
+	if diskSize == "" || strings.EqualFold(diskSize, "default") {
+		return errList
+	}
+	size, err := strconv.Atoi(diskSize)
+	if err != nil {
+		errList = append(errList, fmt.Errorf("%s is not a valid Disk Size: %v!", diskSize, err))
+		return errList
+	}
+
+	for _, sizeInfo := range cloudOSMetaInfo.DiskSize {
+		fields := strings.Split(sizeInfo, "|")
+		if len(fields) < 3 || fields[0] != diskType {
+			continue
+		}
+		min, err1 := strconv.Atoi(fields[1])
+		max, err2 := strconv.Atoi(fields[2])
+		if err1 == nil && err2 == nil && (size < min || size > max) {
+			errList = append(errList, fmt.Errorf("The Disk Size %dGB is out of the range(%d-%dGB) of %s!", size, min, max, diskType))
+		}
+	}
+	return errList
+}
+
+func DryRunCluster(connectionName string, rsType string, reqInfo cres.ClusterInfo) (*DryRunResultInfo, error) {
+	cblog.Info("call DryRunCluster()")
+
+	connectionName, _, err := getDryRunProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	result := newDryRunResult(connectionName, rsType, reqInfo.IId.NameId)
+
+	result.checkUnique(&ClusterIIDInfo{}, CLUSTER, reqInfo.IId.NameId)
+
+	vpcName := reqInfo.Network.VpcIID.NameId
+	if result.checkExists(&VPCIIDInfo{}, VPC, vpcName) {
+		for _, subnetIID := range reqInfo.Network.SubnetIIDs {
+			result.checkSubnetExists(vpcName, subnetIID.NameId)
+		}
+	}
+	for _, sgIID := range reqInfo.Network.SecurityGroupIIDs {
+		result.checkExists(&SGIIDInfo{}, SG, sgIID.NameId)
+	}
+
+	for _, nodeGroup := range reqInfo.NodeGroupList {
+		if nodeGroup.KeyPairIID.NameId != "" {
+			result.checkExists(&KeyIIDInfo{}, KEY, nodeGroup.KeyPairIID.NameId)
+		}
+		if nodeGroup.VMSpecName != "" {
+			_, err := GetVMSpec(connectionName, nodeGroup.VMSpecName)
+			result.check(wrapDryRunError("VMSpec", nodeGroup.VMSpecName, err))
+		}
+		switch strings.ToUpper(nodeGroup.RootDiskSize) {
+		case "", "DEFAULT":
+		default:
+			result.check(wrapDryRunError("RootDiskSize", nodeGroup.RootDiskSize, validateRootDiskSize(nodeGroup.RootDiskSize)))
+		}
+	}
+
+	result.checkTagPolicy(rsType, reqInfo.IId.NameId, &reqInfo.TagList)
+	result.check(admitCluster(connectionName, reqInfo))
+
+	return result, nil
+}
+
+func DryRunMyImage(connectionName string, rsType string, reqInfo cres.MyImageInfo) (*DryRunResultInfo, error) {
+	cblog.Info("call DryRunMyImage()")
+
+	connectionName, _, err := getDryRunProviderName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	result := newDryRunResult(connectionName, rsType, reqInfo.IId.NameId)
+
+	if _, err := EmptyCheckAndTrim("Name", reqInfo.IId.NameId); err != nil {
+		result.check(err)
+	}
+	result.checkUnique(&MyImageIIDInfo{}, MYIMAGE, reqInfo.IId.NameId)
+	result.checkExists(&VMIIDInfo{}, VM, reqInfo.SourceVM.NameId)
+
+	return result, nil
+}
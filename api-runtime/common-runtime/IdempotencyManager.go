@@ -0,0 +1,279 @@
+// Idempotency Key Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	enc "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+const (
+	IDEMPOTENCY_KEY_COLUMN       = "idempotency_key"
+	IDEMPOTENCY_PRINCIPAL_COLUMN = "principal"
+	IDEMPOTENCY_EXPIRED_COLUMN   = "expired_time"
+
+	IDEMPOTENCY_KEY_MAX_LENGTH  = 255
+	DEFAULT_IDEMPOTENCY_KEY_TTL = 24 * time.Hour
+
+	// a RUNNING call over the timeout is regarded as aborted, ex) the server is restarted during the call
+	DEFAULT_IDEMPOTENCY_RUNNING_TIMEOUT = 1 * time.Hour
+)
+
+// status of the call with an idempotency key
+const (
+	IDEMPOTENCY_RUNNING = "RUNNING"
+	IDEMPOTENCY_DONE    = "DONE"
+)
+
+// IdempotencyKeyInfo is the stored outcome of a mutating call with an idempotency key.
+// A replay of the same key by the same principal returns this outcome instead of calling again.
+type IdempotencyKeyInfo struct {
+	IdempotencyKey string `gorm:"primaryKey"`
+	Principal      string `gorm:"primaryKey"` // the keys of the principals are separated
+	Signature      string // request signature, ex) REST:POST /spider/vm:<sha256 of body>
+	Status         string // RUNNING | DONE
+	ResultCode     int    // HTTP status code(REST) or gRPC status code(gRPC)
+	ResultType     string // message type of the gRPC response, empty for REST
+	ResultBody     string `gorm:"type:text"` // encrypted with the encryption key, ex) the private key of a created KeyPair
+	CreatedTime    time.Time
+	ExpiredTime    time.Time `gorm:"index"`
+}
+
+func (IdempotencyKeyInfo) TableName() string {
+	return "idempotency_key_infos"
+}
+
+//====================================================================
+
+// serializes the check-and-insert of the keys
+var idempotencyLock sync.Mutex
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&IdempotencyKeyInfo{})
+	infostore.Close(db)
+
+	enc.AddReEncryptor("idempotency", reEncryptIdempotentResults, listEncryptedIdempotentResults)
+}
+
+// BeginIdempotentCall registers the key of the principal as a RUNNING call.
+// If the key is already registered, it returns the stored info and false(do not call again).
+// The same key with another request signature is an error.
+func BeginIdempotentCall(principal string, key string, signature string) (*IdempotencyKeyInfo, bool, error) {
+	cblog.Info("call BeginIdempotentCall()")
+
+	key, err := EmptyCheckAndTrim("Idempotency-Key", key)
+	if err != nil {
+		cblog.Error(err)
+		return nil, false, err
+	}
+	if len(key) > IDEMPOTENCY_KEY_MAX_LENGTH {
+		err := fmt.Errorf("The Idempotency-Key is too long(max: %d)!", IDEMPOTENCY_KEY_MAX_LENGTH)
+		cblog.Error(err)
+		return nil, false, err
+	}
+
+	idempotencyLock.Lock()
+	defer idempotencyLock.Unlock()
+
+	now := time.Now()
+	_, err = infostore.DeleteBefore(&IdempotencyKeyInfo{}, IDEMPOTENCY_EXPIRED_COLUMN, now)
+	if err != nil {
+		cblog.Error(err)
+		return nil, false, err
+	}
+
+	bool_ret, err := infostore.HasByConditions(&IdempotencyKeyInfo{}, IDEMPOTENCY_KEY_COLUMN, key, IDEMPOTENCY_PRINCIPAL_COLUMN, principal)
+	if err != nil {
+		cblog.Error(err)
+		return nil, false, err
+	}
+	if bool_ret {
+		var info IdempotencyKeyInfo
+		err := infostore.GetByConditions(&info, IDEMPOTENCY_KEY_COLUMN, key, IDEMPOTENCY_PRINCIPAL_COLUMN, principal)
+		if err != nil {
+			cblog.Error(err)
+			return nil, false, err
+		}
+		if info.Signature != signature {
+			err := fmt.Errorf("The Idempotency-Key '%s' is already used for another request!", key)
+			cblog.Error(err)
+			return nil, false, err
+		}
+		if info.Status == IDEMPOTENCY_DONE {
+			info.ResultBody, err = enc.DecryptString(info.ResultBody)
+			if err != nil {
+				cblog.Error(err)
+				return nil, false, err
+			}
+			return &info, false, nil
+		}
+		if now.Sub(info.CreatedTime) <= getIdempotencyRunningTimeout() {
+			return &info, false, nil
+		}
+		// the RUNNING call is aborted without an outcome, call again
+		cblog.Infof("The RUNNING call of the Idempotency-Key '%s' since %v is timed out, call again.", key, info.CreatedTime)
+	}
+
+	info := IdempotencyKeyInfo{
+		IdempotencyKey: key,
+		Principal:      principal,
+		Signature:      signature,
+		Status:         IDEMPOTENCY_RUNNING,
+		CreatedTime:    now,
+		ExpiredTime:    now.Add(getIdempotencyKeyTTL()),
+	}
+	err = infostore.Insert(&info)
+	if err != nil {
+		cblog.Error(err)
+		return nil, false, err
+	}
+
+	return &info, true, nil
+}
+
+// EndIdempotentCall stores the outcome of the call to be returned to the replays.
+func EndIdempotentCall(principal string, key string, resultCode int, resultType string, resultBody string) error {
+	cblog.Info("call EndIdempotentCall()")
+
+	encResultBody, err := enc.EncryptString(resultBody)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	idempotencyLock.Lock()
+	defer idempotencyLock.Unlock()
+
+	var info IdempotencyKeyInfo
+	err = infostore.GetByConditions(&info, IDEMPOTENCY_KEY_COLUMN, key, IDEMPOTENCY_PRINCIPAL_COLUMN, principal)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	info.Status = IDEMPOTENCY_DONE
+	info.ResultCode = resultCode
+	info.ResultType = resultType
+	info.ResultBody = encResultBody
+	err = infostore.Insert(&info)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// CancelIdempotentCall removes the key of a call aborted without an outcome, ex) panic,
+// so that the client can retry with the same key.
+func CancelIdempotentCall(principal string, key string) error {
+	cblog.Info("call CancelIdempotentCall()")
+
+	idempotencyLock.Lock()
+	defer idempotencyLock.Unlock()
+
+	_, err := infostore.DeleteByConditions(&IdempotencyKeyInfo{}, IDEMPOTENCY_KEY_COLUMN, key, IDEMPOTENCY_PRINCIPAL_COLUMN, principal)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func getIdempotencyKeyTTL() time.Duration {
+	ttl := os.Getenv("SPIDER_IDEMPOTENCY_KEY_TTL")
+	if ttl == "" {
+		return DEFAULT_IDEMPOTENCY_KEY_TTL
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil || duration <= 0 {
+		cblog.Errorf("invalid SPIDER_IDEMPOTENCY_KEY_TTL(%s), use the default %v", ttl, DEFAULT_IDEMPOTENCY_KEY_TTL)
+		return DEFAULT_IDEMPOTENCY_KEY_TTL
+	}
+	return duration
+}
+
+func getIdempotencyRunningTimeout() time.Duration {
+	timeout := os.Getenv("SPIDER_IDEMPOTENCY_RUNNING_TIMEOUT")
+	if timeout == "" {
+		return DEFAULT_IDEMPOTENCY_RUNNING_TIMEOUT
+	}
+	duration, err := time.ParseDuration(timeout)
+	if err != nil || duration <= 0 {
+		cblog.Errorf("invalid SPIDER_IDEMPOTENCY_RUNNING_TIMEOUT(%s), use the default %v", timeout, DEFAULT_IDEMPOTENCY_RUNNING_TIMEOUT)
+		return DEFAULT_IDEMPOTENCY_RUNNING_TIMEOUT
+	}
+	return duration
+}
+
+// listEncryptedIdempotentResults returns the encrypted outcomes of the DONE calls
+func listEncryptedIdempotentResults() ([]string, error) {
+	var infoList []*IdempotencyKeyInfo
+	err := infostore.ListByCondition(&infoList, "status", IDEMPOTENCY_DONE)
+	if err != nil {
+		return nil, err
+	}
+
+	resultList := []string{}
+	for _, info := range infoList {
+		resultList = append(resultList, info.ResultBody)
+	}
+	return resultList, nil
+}
+
+// reEncryptIdempotentResults re-encrypts the stored outcomes with the active encryption key.
+// An outcome is updated only when it is not changed during the re-encryption.
+func reEncryptIdempotentResults() (int, error) {
+	var infoList []*IdempotencyKeyInfo
+	err := infostore.ListByCondition(&infoList, "status", IDEMPOTENCY_DONE)
+	if err != nil {
+		return 0, err
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer infostore.Close(db)
+
+	count := 0
+	for _, info := range infoList {
+		encResultBody, reEncrypted, err := enc.ReEncryptString(info.ResultBody)
+		if err != nil {
+			return count, fmt.Errorf("failed to re-encrypt the outcome of the Idempotency-Key '%s': %v", info.IdempotencyKey, err)
+		}
+		if !reEncrypted {
+			continue
+		}
+
+		ret := db.Model(&IdempotencyKeyInfo{}).
+			Where(IDEMPOTENCY_KEY_COLUMN+" = ? AND "+IDEMPOTENCY_PRINCIPAL_COLUMN+" = ? AND result_body = ?",
+				info.IdempotencyKey, info.Principal, info.ResultBody).
+			Update("result_body", encResultBody)
+		if ret.Error != nil {
+			return count, ret.Error
+		}
+		count += int(ret.RowsAffected)
+	}
+	return count, nil
+}
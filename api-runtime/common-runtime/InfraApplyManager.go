@@ -0,0 +1,866 @@
+// Infra Spec Plan and Apply Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+	"github.com/rs/xid"
+	"gopkg.in/yaml.v3"
+)
+
+//================ Declarative Infrastructure Spec
+// Resources are described by NameId, and the references between resources also use NameId.
+// ex)
+//  connectionlist:
+//    - connectionname: aws-seoul-config
+//      vpclist:
+//        - name: vpc-01
+//          ipv4_cidr: 10.0.0.0/16
+//          subnetlist:
+//            - { name: subnet-01, ipv4_cidr: 10.0.1.0/24 }
+//      ...
+
+type InfraSpecInfo struct {
+	IDTransformMode string // ON | OFF, default is ON
+	ConnectionList  []*ConnectionInfraSpecInfo
+}
+
+type ConnectionInfraSpecInfo struct {
+	ConnectionName    string
+	VPCList           []InfraVPCSpec
+	SecurityGroupList []InfraSGSpec
+	KeyPairList       []InfraKeyPairSpec
+	DiskList          []InfraDiskSpec
+	VMList            []InfraVMSpec
+	NLBList           []InfraNLBSpec
+}
+
+type InfraVPCSpec struct {
+	Name       string
+	IPv4_CIDR  string
+	IPv6_CIDR  string
+	SubnetList []InfraSubnetSpec
+	TagList    []cres.KeyValue
+}
+
+type InfraSubnetSpec struct {
+	Name      string
+	Zone      string
+	IPv4_CIDR string
+	IPv6_CIDR string
+	TagList   []cres.KeyValue
+}
+
+type InfraSGSpec struct {
+	Name          string
+	VPCName       string
+	SecurityRules []cres.SecurityRuleInfo
+	TagList       []cres.KeyValue
+}
+
+type InfraKeyPairSpec struct {
+	Name    string
+	TagList []cres.KeyValue
+}
+
+type InfraDiskSpec struct {
+	Name     string
+	Zone     string
+	DiskType string
+	DiskSize string
+	TagList  []cres.KeyValue
+}
+
+type InfraVMSpec struct {
+	Name               string
+	ImageType          string
+	ImageName          string
+	VPCName            string
+	SubnetName         string
+	SecurityGroupNames []string
+	VMSpecName         string
+	KeyPairName        string
+	RootDiskType       string
+	RootDiskSize       string
+	DataDiskNames      []string
+	VMUserId           string
+	VMUserPasswd       string
+	TagList            []cres.KeyValue
+}
+
+type InfraNLBSpec struct {
+	Name          string
+	VPCName       string
+	Type          string // PUBLIC(V) | INTERNAL
+	Scope         string // REGION(V) | GLOBAL
+	Listener      cres.ListenerInfo
+	VMGroup       InfraVMGroupSpec
+	HealthChecker cres.HealthCheckerInfo // 0: default
+	TagList       []cres.KeyValue
+}
+
+type InfraVMGroupSpec struct {
+	Protocol string
+	Port     string
+	VMs      []string
+}
+
+// ParseInfraSpec parses the spec of YAML or JSON format.
+// YAML is converted into JSON, so the keys are matched with the field names case-insensitively.
+func ParseInfraSpec(data []byte) (*InfraSpecInfo, error) {
+	var yamlData interface{}
+	err := yaml.Unmarshal(data, &yamlData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the spec: %v", err)
+	}
+	jsonData, err := json.Marshal(yamlData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the spec: %v", err)
+	}
+
+	var spec InfraSpecInfo
+	err = json.Unmarshal(jsonData, &spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the spec: %v", err)
+	}
+	return &spec, nil
+}
+
+//================ Plan
+
+// Plan Actions
+const (
+	INFRA_CREATE           = "CREATE"
+	INFRA_ADD_SUBNET       = "ADD_SUBNET"
+	INFRA_ADD_RULES        = "ADD_RULES"
+	INFRA_CHANGE_DISK_SIZE = "CHANGE_DISK_SIZE"
+	INFRA_ADD_NLB_VMS      = "ADD_NLB_VMS"
+	INFRA_NOOP             = "NOOP"
+	INFRA_CONFLICT         = "CONFLICT" // in-place update is not supported, not applied
+	INFRA_UNKNOWN          = "UNKNOWN"  // failed to get the current state, not applied
+)
+
+// Step Status
+const (
+	INFRA_STEP_PENDING   = "PENDING"
+	INFRA_STEP_RUNNING   = "RUNNING"
+	INFRA_STEP_SUCCEEDED = "SUCCEEDED"
+	INFRA_STEP_FAILED    = "FAILED"
+	INFRA_STEP_SKIPPED   = "SKIPPED"
+)
+
+// InfraPlanStepInfo is a change of a resource. The steps of a connection are ordered by the dependency.
+type InfraPlanStepInfo struct {
+	Order          int
+	ConnectionName string
+	ResType        cres.RSType
+	Name           string
+	Action         string
+	DiffList       []string `json:",omitempty"`
+
+	Status   string `json:",omitempty"`
+	ErrorMsg string `json:",omitempty"`
+
+	run func() error
+}
+
+func (step *InfraPlanStepInfo) isApplicable() bool {
+	switch step.Action {
+	case INFRA_NOOP, INFRA_CONFLICT, INFRA_UNKNOWN:
+		return false
+	}
+	return true
+}
+
+// The resource of a CONFLICT or UNKNOWN step is not in the desired state,
+// so the dependent steps can not be applied.
+func (step *InfraPlanStepInfo) isBlocking() bool {
+	return step.Action == INFRA_CONFLICT || step.Action == INFRA_UNKNOWN
+}
+
+// PlanInfra diffs the spec against the current state, the connections are planned in parallel.
+func PlanInfra(spec InfraSpecInfo) ([]*InfraPlanStepInfo, error) {
+	cblog.Info("call PlanInfra()")
+
+	err := checkInfraSpec(&spec)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	stepLists := make([][]*InfraPlanStepInfo, len(spec.ConnectionList))
+	var wg sync.WaitGroup
+	reqCtx := getRequestContext()
+	for idx, connSpec := range spec.ConnectionList {
+		wg.Add(1)
+		go func(idx int, connSpec *ConnectionInfraSpecInfo) {
+			defer wg.Done()
+			defer bindRequestContext(reqCtx)()
+			stepLists[idx] = planInfraByConnection(connSpec, spec.IDTransformMode)
+		}(idx, connSpec)
+	}
+	wg.Wait()
+
+	stepList := []*InfraPlanStepInfo{}
+	for _, list := range stepLists {
+		stepList = append(stepList, list...)
+	}
+	for idx, step := range stepList {
+		step.Order = idx + 1
+	}
+	return stepList, nil
+}
+
+func checkInfraSpec(spec *InfraSpecInfo) error {
+	if len(spec.ConnectionList) == 0 {
+		return fmt.Errorf("ConnectionList of the spec is empty!")
+	}
+	connNameMap := map[string]bool{}
+	for _, connSpec := range spec.ConnectionList {
+		connectionName, err := EmptyCheckAndTrim("ConnectionName", connSpec.ConnectionName)
+		if err != nil {
+			return err
+		}
+		if connNameMap[connectionName] {
+			return fmt.Errorf("The connection '%s' is duplicated in the spec!", connectionName)
+		}
+		connNameMap[connectionName] = true
+		connSpec.ConnectionName = connectionName
+	}
+	return nil
+}
+
+// dependency order: VPC => SG, KeyPair => Disk => VM => NLB
+func planInfraByConnection(connSpec *ConnectionInfraSpecInfo, idTransformMode string) []*InfraPlanStepInfo {
+	connectionName := connSpec.ConnectionName
+	stepList := []*InfraPlanStepInfo{}
+	addStep := func(step *InfraPlanStepInfo) {
+		step.ConnectionName = connectionName
+		stepList = append(stepList, step)
+	}
+
+	for _, vpcSpec := range connSpec.VPCList {
+		for _, step := range planVPC(connectionName, vpcSpec, idTransformMode) {
+			addStep(step)
+		}
+	}
+	for _, sgSpec := range connSpec.SecurityGroupList {
+		addStep(planSecurity(connectionName, sgSpec, idTransformMode))
+	}
+	for _, keySpec := range connSpec.KeyPairList {
+		addStep(planKey(connectionName, keySpec, idTransformMode))
+	}
+	for _, diskSpec := range connSpec.DiskList {
+		addStep(planDisk(connectionName, diskSpec, idTransformMode))
+	}
+	for _, vmSpec := range connSpec.VMList {
+		addStep(planVM(connectionName, vmSpec, idTransformMode))
+	}
+	for _, nlbSpec := range connSpec.NLBList {
+		addStep(planNLB(connectionName, nlbSpec, idTransformMode))
+	}
+	return stepList
+}
+
+func hasInfraResource(info interface{}, connectionName string, name string) (bool, error) {
+	return infostore.HasByConditions(info, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, name)
+}
+
+func newUnknownStep(resType cres.RSType, name string, err error) *InfraPlanStepInfo {
+	return &InfraPlanStepInfo{ResType: resType, Name: name, Action: INFRA_UNKNOWN, ErrorMsg: err.Error()}
+}
+
+func planVPC(connectionName string, spec InfraVPCSpec, idTransformMode string) []*InfraPlanStepInfo {
+	exists, err := hasInfraResource(&VPCIIDInfo{}, connectionName, spec.Name)
+	if err != nil {
+		return []*InfraPlanStepInfo{newUnknownStep(cres.VPC, spec.Name, err)}
+	}
+
+	if !exists {
+		reqInfo := cres.VPCReqInfo{
+			IId:       cres.IID{NameId: spec.Name},
+			IPv4_CIDR: spec.IPv4_CIDR,
+			IPv6_CIDR: spec.IPv6_CIDR,
+			TagList:   spec.TagList,
+		}
+		for _, subnet := range spec.SubnetList {
+			reqInfo.SubnetInfoList = append(reqInfo.SubnetInfoList, toSubnetInfo(subnet))
+		}
+		return []*InfraPlanStepInfo{{ResType: cres.VPC, Name: spec.Name, Action: INFRA_CREATE,
+			run: func() error {
+				_, err := CreateVPC(connectionName, VPC, reqInfo, idTransformMode)
+				return err
+			}}}
+	}
+
+	vpcInfo, err := GetVPC(connectionName, VPC, spec.Name)
+	if err != nil {
+		return []*InfraPlanStepInfo{newUnknownStep(cres.VPC, spec.Name, err)}
+	}
+
+	vpcStep := &InfraPlanStepInfo{ResType: cres.VPC, Name: spec.Name, Action: INFRA_NOOP}
+	if spec.IPv4_CIDR != "" && spec.IPv4_CIDR != vpcInfo.IPv4_CIDR {
+		vpcStep.Action = INFRA_CONFLICT
+		vpcStep.DiffList = append(vpcStep.DiffList, fmt.Sprintf("IPv4_CIDR: %s => %s", vpcInfo.IPv4_CIDR, spec.IPv4_CIDR))
+	}
+
+	subnetMap := map[string]cres.SubnetInfo{}
+	for _, subnet := range vpcInfo.SubnetInfoList {
+		subnetMap[subnet.IId.NameId] = subnet
+	}
+
+	stepList := []*InfraPlanStepInfo{vpcStep}
+	for _, subnetSpec := range spec.SubnetList {
+		current, ok := subnetMap[subnetSpec.Name]
+		if ok {
+			if subnetSpec.IPv4_CIDR != "" && subnetSpec.IPv4_CIDR != current.IPv4_CIDR {
+				vpcStep.Action = INFRA_CONFLICT
+				vpcStep.DiffList = append(vpcStep.DiffList, fmt.Sprintf("Subnet '%s' IPv4_CIDR: %s => %s",
+					subnetSpec.Name, current.IPv4_CIDR, subnetSpec.IPv4_CIDR))
+			}
+			continue
+		}
+		subnetInfo := toSubnetInfo(subnetSpec)
+		stepList = append(stepList, &InfraPlanStepInfo{ResType: cres.SUBNET, Name: subnetSpec.Name, Action: INFRA_ADD_SUBNET,
+			DiffList: []string{fmt.Sprintf("add Subnet '%s' to VPC '%s'", subnetSpec.Name, spec.Name)},
+			run: func() error {
+				_, err := AddSubnet(connectionName, SUBNET, spec.Name, subnetInfo, idTransformMode)
+				return err
+			}})
+	}
+	return stepList
+}
+
+func toSubnetInfo(spec InfraSubnetSpec) cres.SubnetInfo {
+	return cres.SubnetInfo{
+		IId:       cres.IID{NameId: spec.Name},
+		Zone:      spec.Zone,
+		IPv4_CIDR: spec.IPv4_CIDR,
+		IPv6_CIDR: spec.IPv6_CIDR,
+		TagList:   spec.TagList,
+	}
+}
+
+func planSecurity(connectionName string, spec InfraSGSpec, idTransformMode string) *InfraPlanStepInfo {
+	step := &InfraPlanStepInfo{ResType: cres.SG, Name: spec.Name}
+
+	exists, err := hasInfraResource(&SGIIDInfo{}, connectionName, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.SG, spec.Name, err)
+	}
+
+	if !exists {
+		ruleList := append([]cres.SecurityRuleInfo{}, spec.SecurityRules...)
+		reqInfo := cres.SecurityReqInfo{
+			IId:           cres.IID{NameId: spec.Name, SystemId: spec.Name},
+			VpcIID:        cres.IID{NameId: spec.VPCName},
+			SecurityRules: &ruleList,
+			TagList:       spec.TagList,
+		}
+		step.Action = INFRA_CREATE
+		step.run = func() error {
+			_, err := CreateSecurity(connectionName, SG, reqInfo, idTransformMode)
+			return err
+		}
+		return step
+	}
+
+	sgInfo, err := GetSecurity(connectionName, SG, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.SG, spec.Name, err)
+	}
+
+	if spec.VPCName != "" && spec.VPCName != sgInfo.VpcIID.NameId {
+		step.Action = INFRA_CONFLICT
+		step.DiffList = append(step.DiffList, fmt.Sprintf("VPCName: %s => %s", sgInfo.VpcIID.NameId, spec.VPCName))
+		return step
+	}
+
+	// rules not in the spec are kept
+	currentRuleMap := map[string]bool{}
+	if sgInfo.SecurityRules != nil {
+		for _, rule := range *sgInfo.SecurityRules {
+			currentRuleMap[ruleKey(rule)] = true
+		}
+	}
+	addRuleList := []cres.SecurityRuleInfo{}
+	for _, rule := range spec.SecurityRules {
+		if !currentRuleMap[ruleKey(rule)] {
+			addRuleList = append(addRuleList, rule)
+			step.DiffList = append(step.DiffList, "add Rule "+ruleKey(rule))
+		}
+	}
+	if len(addRuleList) == 0 {
+		step.Action = INFRA_NOOP
+		return step
+	}
+
+	step.Action = INFRA_ADD_RULES
+	step.run = func() error {
+		_, err := AddRules(connectionName, spec.Name, addRuleList)
+		return err
+	}
+	return step
+}
+
+// ex) "inbound/TCP/22-22/0.0.0.0/0", "inbound/ALL/-1--1/0.0.0.0/0"
+func ruleKey(rule cres.SecurityRuleInfo) string {
+	cidr := rule.CIDR
+	if rule.PeerSecurityGroupIID != nil {
+		cidr = "sg:" + rule.PeerSecurityGroupIID.NameId
+	} else if cidr == "" {
+		cidr = "0.0.0.0/0"
+	}
+	return fmt.Sprintf("%s/%s/%s-%s/%s", strings.ToLower(rule.Direction), strings.ToUpper(rule.IPProtocol),
+		normalizeRulePort(rule.FromPort), normalizeRulePort(rule.ToPort), cidr)
+}
+
+// "" and "-1" mean all ports
+func normalizeRulePort(port string) string {
+	port = strings.TrimSpace(port)
+	if port == "" {
+		return "-1"
+	}
+	return port
+}
+
+func planKey(connectionName string, spec InfraKeyPairSpec, idTransformMode string) *InfraPlanStepInfo {
+	step := &InfraPlanStepInfo{ResType: cres.KEY, Name: spec.Name, Action: INFRA_NOOP}
+
+	exists, err := hasInfraResource(&KeyIIDInfo{}, connectionName, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.KEY, spec.Name, err)
+	}
+	if exists {
+		return step
+	}
+
+	reqInfo := cres.KeyPairReqInfo{IId: cres.IID{NameId: spec.Name}, TagList: spec.TagList}
+	step.Action = INFRA_CREATE
+	step.run = func() error {
+		_, err := CreateKey(connectionName, KEY, reqInfo, idTransformMode)
+		return err
+	}
+	return step
+}
+
+func planDisk(connectionName string, spec InfraDiskSpec, idTransformMode string) *InfraPlanStepInfo {
+	step := &InfraPlanStepInfo{ResType: cres.DISK, Name: spec.Name, Action: INFRA_NOOP}
+
+	exists, err := hasInfraResource(&DiskIIDInfo{}, connectionName, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.DISK, spec.Name, err)
+	}
+
+	if !exists {
+		reqInfo := cres.DiskInfo{
+			IId:      cres.IID{NameId: spec.Name, SystemId: spec.Name},
+			Zone:     spec.Zone,
+			DiskType: spec.DiskType,
+			DiskSize: spec.DiskSize,
+			TagList:  spec.TagList,
+		}
+		step.Action = INFRA_CREATE
+		step.run = func() error {
+			_, err := CreateDisk(connectionName, DISK, reqInfo, idTransformMode)
+			return err
+		}
+		return step
+	}
+
+	diskInfo, err := GetDisk(connectionName, DISK, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.DISK, spec.Name, err)
+	}
+
+	if spec.DiskSize == "" || strings.EqualFold(spec.DiskSize, "default") || spec.DiskSize == diskInfo.DiskSize {
+		return step
+	}
+	specSize, err1 := strconv.Atoi(spec.DiskSize)
+	currentSize, err2 := strconv.Atoi(diskInfo.DiskSize)
+	diff := fmt.Sprintf("DiskSize: %s => %s", diskInfo.DiskSize, spec.DiskSize)
+	if err1 != nil || err2 != nil || specSize < currentSize {
+		// disk can not be shrunk
+		step.Action = INFRA_CONFLICT
+		step.DiffList = append(step.DiffList, diff)
+		return step
+	}
+
+	step.Action = INFRA_CHANGE_DISK_SIZE
+	step.DiffList = append(step.DiffList, diff)
+	step.run = func() error {
+		_, err := ChangeDiskSize(connectionName, spec.Name, spec.DiskSize)
+		return err
+	}
+	return step
+}
+
+func planVM(connectionName string, spec InfraVMSpec, idTransformMode string) *InfraPlanStepInfo {
+	step := &InfraPlanStepInfo{ResType: cres.VM, Name: spec.Name, Action: INFRA_NOOP}
+
+	exists, err := hasInfraResource(&VMIIDInfo{}, connectionName, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.VM, spec.Name, err)
+	}
+
+	if !exists {
+		reqInfo := cres.VMReqInfo{
+			IId:          cres.IID{NameId: spec.Name},
+			ImageType:    cres.ImageType(spec.ImageType),
+			ImageIID:     cres.IID{NameId: spec.ImageName, SystemId: spec.ImageName},
+			VpcIID:       cres.IID{NameId: spec.VPCName},
+			SubnetIID:    cres.IID{NameId: spec.SubnetName},
+			VMSpecName:   spec.VMSpecName,
+			KeyPairIID:   cres.IID{NameId: spec.KeyPairName},
+			RootDiskType: spec.RootDiskType,
+			RootDiskSize: spec.RootDiskSize,
+			VMUserId:     spec.VMUserId,
+			VMUserPasswd: spec.VMUserPasswd,
+			TagList:      spec.TagList,
+		}
+		for _, sgName := range spec.SecurityGroupNames {
+			reqInfo.SecurityGroupIIDs = append(reqInfo.SecurityGroupIIDs, cres.IID{NameId: sgName})
+		}
+		for _, diskName := range spec.DataDiskNames {
+			reqInfo.DataDiskIIDs = append(reqInfo.DataDiskIIDs, cres.IID{NameId: diskName})
+		}
+		step.Action = INFRA_CREATE
+		step.run = func() error {
+			_, err := StartVM(connectionName, VM, reqInfo, idTransformMode)
+			return err
+		}
+		return step
+	}
+
+	vmInfo, err := GetVM(connectionName, VM, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.VM, spec.Name, err)
+	}
+
+	addDiff := func(name string, current string, desired string) {
+		if desired != "" && desired != current {
+			step.DiffList = append(step.DiffList, fmt.Sprintf("%s: %s => %s", name, current, desired))
+		}
+	}
+	addDiff("VMSpecName", vmInfo.VMSpecName, spec.VMSpecName)
+	addDiff("VPCName", vmInfo.VpcIID.NameId, spec.VPCName)
+	addDiff("SubnetName", vmInfo.SubnetIID.NameId, spec.SubnetName)
+	addDiff("KeyPairName", vmInfo.KeyPairIId.NameId, spec.KeyPairName)
+	if len(step.DiffList) > 0 {
+		step.Action = INFRA_CONFLICT
+	}
+	return step
+}
+
+func planNLB(connectionName string, spec InfraNLBSpec, idTransformMode string) *InfraPlanStepInfo {
+	step := &InfraPlanStepInfo{ResType: cres.NLB, Name: spec.Name, Action: INFRA_NOOP}
+
+	exists, err := hasInfraResource(&NLBIIDInfo{}, connectionName, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.NLB, spec.Name, err)
+	}
+
+	if !exists {
+		vmIIDList := []cres.IID{}
+		for _, vmName := range spec.VMGroup.VMs {
+			vmIIDList = append(vmIIDList, cres.IID{NameId: vmName})
+		}
+		reqInfo := cres.NLBInfo{
+			IId:      cres.IID{NameId: spec.Name, SystemId: spec.Name},
+			VpcIID:   cres.IID{NameId: spec.VPCName},
+			Type:     spec.Type,
+			Scope:    spec.Scope,
+			Listener: spec.Listener,
+			VMGroup: cres.VMGroupInfo{
+				Protocol: spec.VMGroup.Protocol,
+				Port:     spec.VMGroup.Port,
+				VMs:      &vmIIDList,
+			},
+			HealthChecker: spec.HealthChecker,
+			TagList:       spec.TagList,
+		}
+		// 0: default(-1)
+		for _, value := range []*int{&reqInfo.HealthChecker.Interval, &reqInfo.HealthChecker.Timeout, &reqInfo.HealthChecker.Threshold} {
+			if *value == 0 {
+				*value = -1
+			}
+		}
+		step.Action = INFRA_CREATE
+		step.run = func() error {
+			_, err := CreateNLB(connectionName, NLB, reqInfo, idTransformMode)
+			return err
+		}
+		return step
+	}
+
+	nlbInfo, err := GetNLB(connectionName, NLB, spec.Name)
+	if err != nil {
+		return newUnknownStep(cres.NLB, spec.Name, err)
+	}
+
+	if spec.Listener.Port != "" && spec.Listener.Port != nlbInfo.Listener.Port {
+		step.Action = INFRA_CONFLICT
+		step.DiffList = append(step.DiffList, fmt.Sprintf("Listener.Port: %s => %s", nlbInfo.Listener.Port, spec.Listener.Port))
+		return step
+	}
+
+	// VMs not in the spec are kept
+	currentVMMap := map[string]bool{}
+	if nlbInfo.VMGroup.VMs != nil {
+		for _, vm := range *nlbInfo.VMGroup.VMs {
+			currentVMMap[vm.NameId] = true
+		}
+	}
+	addVMList := []string{}
+	for _, vmName := range spec.VMGroup.VMs {
+		if !currentVMMap[vmName] {
+			addVMList = append(addVMList, vmName)
+			step.DiffList = append(step.DiffList, "add VM "+vmName)
+		}
+	}
+	if len(addVMList) == 0 {
+		return step
+	}
+
+	step.Action = INFRA_ADD_NLB_VMS
+	step.run = func() error {
+		_, err := AddNLBVMs(connectionName, spec.Name, addVMList)
+		return err
+	}
+	return step
+}
+
+//================ Apply
+
+// Job Status
+const (
+	INFRA_JOB_RUNNING   = "RUNNING"
+	INFRA_JOB_SUCCEEDED = "SUCCEEDED" // all steps are applied or NOOP
+	INFRA_JOB_PARTIAL   = "PARTIAL"   // some steps are applied, the others are failed, not applied or skipped
+	INFRA_JOB_FAILED    = "FAILED"    // no step is applied
+)
+
+// finished jobs are kept in memory for the retention time, up to the max count.
+const (
+	INFRA_APPLY_JOB_RETENTION = 24 * time.Hour
+	MAX_INFRA_APPLY_JOBS      = 100
+)
+
+// InfraApplyJobInfo is the progress of an apply request.
+type InfraApplyJobInfo struct {
+	JobId     string
+	Status    string // RUNNING | SUCCEEDED | PARTIAL | FAILED
+	StartTime time.Time
+	EndTime   time.Time `json:",omitempty"`
+	StepList  []*InfraPlanStepInfo
+}
+
+var infraApplyJobMap = map[string]*InfraApplyJobInfo{}
+var infraApplyJobLock = new(sync.RWMutex)
+
+// ApplyInfra plans the spec and starts to apply the changes in the background.
+// The progress can be checked with GetInfraApplyJob().
+func ApplyInfra(spec InfraSpecInfo) (*InfraApplyJobInfo, error) {
+	cblog.Info("call ApplyInfra()")
+
+	stepList, err := PlanInfra(spec)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	job := &InfraApplyJobInfo{
+		JobId:     xid.New().String(),
+		Status:    INFRA_JOB_RUNNING,
+		StartTime: time.Now(),
+		StepList:  stepList,
+	}
+	for _, step := range stepList {
+		step.Status = INFRA_STEP_PENDING
+		if !step.isApplicable() {
+			step.Status = INFRA_STEP_SKIPPED
+		}
+	}
+
+	infraApplyJobLock.Lock()
+	evictInfraApplyJobs(time.Now())
+	infraApplyJobMap[job.JobId] = job
+	jobCopy := copyInfraApplyJob(job)
+	infraApplyJobLock.Unlock()
+
+	go runInfraApplyJob(job)
+
+	return jobCopy, nil
+}
+
+func runInfraApplyJob(job *InfraApplyJobInfo) {
+	connStepMap := map[string][]*InfraPlanStepInfo{}
+	connNameList := []string{}
+	for _, step := range job.StepList {
+		if _, ok := connStepMap[step.ConnectionName]; !ok {
+			connNameList = append(connNameList, step.ConnectionName)
+		}
+		connStepMap[step.ConnectionName] = append(connStepMap[step.ConnectionName], step)
+	}
+
+	var wg sync.WaitGroup
+	reqCtx := getRequestContext()
+	for _, connectionName := range connNameList {
+		wg.Add(1)
+		go func(stepList []*InfraPlanStepInfo) {
+			defer wg.Done()
+			defer bindRequestContext(reqCtx)()
+			runInfraSteps(stepList)
+		}(connStepMap[connectionName])
+	}
+	wg.Wait()
+
+	infraApplyJobLock.Lock()
+	defer infraApplyJobLock.Unlock()
+	job.Status = getInfraApplyJobStatus(job.StepList)
+	job.EndTime = time.Now()
+	cblog.Infof("Infra Apply Job '%s' is finished: %s", job.JobId, job.Status)
+}
+
+// SUCCEEDED: no failed, blocking or skipped step, PARTIAL: some steps succeeded, FAILED: no step succeeded.
+func getInfraApplyJobStatus(stepList []*InfraPlanStepInfo) string {
+	succeeded, notSucceeded := false, false
+	for _, step := range stepList {
+		switch {
+		case step.Status == INFRA_STEP_SUCCEEDED:
+			succeeded = true
+		case step.Status == INFRA_STEP_FAILED, step.isBlocking(), step.isApplicable():
+			notSucceeded = true
+		}
+	}
+	if !notSucceeded {
+		return INFRA_JOB_SUCCEEDED
+	}
+	if succeeded {
+		return INFRA_JOB_PARTIAL
+	}
+	return INFRA_JOB_FAILED
+}
+
+// runs the steps of a connection in the dependency order,
+// the remaining steps are skipped after a failed, CONFLICT or UNKNOWN step.
+func runInfraSteps(stepList []*InfraPlanStepInfo) {
+	var blockingStep *InfraPlanStepInfo
+	for _, step := range stepList {
+		if step.isBlocking() && blockingStep == nil {
+			blockingStep = step
+			continue
+		}
+		if !step.isApplicable() {
+			continue
+		}
+		if blockingStep != nil {
+			setInfraStepStatus(step, INFRA_STEP_SKIPPED, fmt.Sprintf("skipped by the previous step: %s %s '%s'",
+				blockingStep.Action, blockingStep.ResType, blockingStep.Name))
+			continue
+		}
+
+		setInfraStepStatus(step, INFRA_STEP_RUNNING, "")
+		cblog.Infof("Infra Apply: [%s] %s %s '%s'", step.ConnectionName, step.Action, step.ResType, step.Name)
+		err := step.run()
+		if err != nil {
+			cblog.Error(err)
+			blockingStep = step
+			setInfraStepStatus(step, INFRA_STEP_FAILED, err.Error())
+			continue
+		}
+		setInfraStepStatus(step, INFRA_STEP_SUCCEEDED, "")
+	}
+}
+
+// evictInfraApplyJobs removes the finished jobs over the retention time or the max count.
+// The caller holds infraApplyJobLock.
+func evictInfraApplyJobs(now time.Time) {
+	finishedList := []*InfraApplyJobInfo{}
+	for jobId, job := range infraApplyJobMap {
+		if job.Status == INFRA_JOB_RUNNING {
+			continue
+		}
+		if now.Sub(job.EndTime) > INFRA_APPLY_JOB_RETENTION {
+			delete(infraApplyJobMap, jobId)
+			continue
+		}
+		finishedList = append(finishedList, job)
+	}
+
+	// keep room for the new job
+	overCount := len(finishedList) - (MAX_INFRA_APPLY_JOBS - 1)
+	if overCount <= 0 {
+		return
+	}
+	sort.Slice(finishedList, func(i, j int) bool {
+		return finishedList[i].EndTime.Before(finishedList[j].EndTime)
+	})
+	for _, job := range finishedList[:overCount] {
+		delete(infraApplyJobMap, job.JobId)
+	}
+}
+
+func setInfraStepStatus(step *InfraPlanStepInfo, status string, errorMsg string) {
+	infraApplyJobLock.Lock()
+	defer infraApplyJobLock.Unlock()
+	step.Status = status
+	step.ErrorMsg = errorMsg
+}
+
+func GetInfraApplyJob(jobId string) (*InfraApplyJobInfo, error) {
+	cblog.Info("call GetInfraApplyJob()")
+
+	infraApplyJobLock.RLock()
+	defer infraApplyJobLock.RUnlock()
+
+	job, ok := infraApplyJobMap[jobId]
+	if !ok {
+		err := fmt.Errorf("The Infra Apply Job '%s' does not exist!", jobId)
+		cblog.Error(err)
+		return nil, err
+	}
+	return copyInfraApplyJob(job), nil
+}
+
+func ListInfraApplyJob() ([]*InfraApplyJobInfo, error) {
+	cblog.Info("call ListInfraApplyJob()")
+
+	infraApplyJobLock.RLock()
+	defer infraApplyJobLock.RUnlock()
+
+	jobList := []*InfraApplyJobInfo{}
+	for _, job := range infraApplyJobMap {
+		jobList = append(jobList, copyInfraApplyJob(job))
+	}
+	sort.Slice(jobList, func(i, j int) bool {
+		return jobList[i].StartTime.Before(jobList[j].StartTime)
+	})
+	return jobList, nil
+}
+
+// copy for reading while the job is running
+func copyInfraApplyJob(job *InfraApplyJobInfo) *InfraApplyJobInfo {
+	jobCopy := *job
+	jobCopy.StepList = make([]*InfraPlanStepInfo, len(job.StepList))
+	for idx, step := range job.StepList {
+		stepCopy := *step
+		jobCopy.StepList[idx] = &stepCopy
+	}
+	return &jobCopy
+}
@@ -0,0 +1,380 @@
+// JWT Authentication Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+//================ JWT Bearer Authentication
+// The JWT bearer tokens of an SSO are verified with an HMAC key or the JWKS of an OIDC issuer,
+// the tokens without the expiration time(exp) are rejected,
+// and the claims are mapped to the role bindings of Spider, ex) with the default claims
+//
+//	{"sub": "alice", "spider_roles": ["operator"], "spider_connections": ["aws-dev-*"]}
+//	=> alice: {operator, aws-dev-*}
+//
+// env)
+//	SPIDER_JWT_HMAC_KEY         : HMAC key of the HS256/HS384/HS512 tokens
+//	SPIDER_OIDC_ISSUER          : OIDC issuer of the RS/PS/ES tokens, ex) https://sso.example.com/realms/cloud
+//	                              (the issuer(iss) of all tokens, including the HMAC tokens, is checked if set)
+//	SPIDER_OIDC_JWKS_URL        : JWKS of the issuer (default: jwks_uri of <issuer>/.well-known/openid-configuration)
+//	SPIDER_JWT_AUDIENCE         : required audience (default: not checked)
+//	SPIDER_JWT_USER_CLAIM       : claim of the user name (default: sub)
+//	SPIDER_JWT_ROLE_CLAIM       : claim of the roles, a dotted path for a nested claim (default: spider_roles), ex) realm_access.roles
+//	SPIDER_JWT_ROLE_MAP         : claim values mapped to the roles, ex) cloud-admins=admin,cloud-devs=operator
+//	                              (the values same with a role are the role itself)
+//	SPIDER_JWT_CONNECTION_CLAIM : claim of the connection name patterns (default: spider_connections, no connection if absent),
+//	                              ex) ["*"] for all connections
+
+const (
+	DEFAULT_JWT_USER_CLAIM       = "sub"
+	DEFAULT_JWT_ROLE_CLAIM       = "spider_roles"
+	DEFAULT_JWT_CONNECTION_CLAIM = "spider_connections"
+
+	jwksRefreshInterval    = 1 * time.Hour
+	jwksMinRefreshInterval = 10 * time.Second // for the unknown key id
+	oidcHTTPTimeout        = 10 * time.Second
+)
+
+type jwtAuthConfig struct {
+	HMACKey         []byte
+	Issuer          string
+	JWKSURL         string
+	Audience        string
+	UserClaim       string
+	RoleClaim       string
+	RoleMap         map[string]string
+	ConnectionClaim string
+}
+
+var (
+	jwtConfigOnce sync.Once
+	jwtConfig     jwtAuthConfig
+	jwks          = &jwksCache{keyMap: map[string]interface{}{}}
+)
+
+func getJWTAuthConfig() *jwtAuthConfig {
+	jwtConfigOnce.Do(func() {
+		jwtConfig = jwtAuthConfig{
+			HMACKey:         []byte(os.Getenv("SPIDER_JWT_HMAC_KEY")),
+			Issuer:          strings.TrimSuffix(os.Getenv("SPIDER_OIDC_ISSUER"), "/"),
+			JWKSURL:         os.Getenv("SPIDER_OIDC_JWKS_URL"),
+			Audience:        os.Getenv("SPIDER_JWT_AUDIENCE"),
+			UserClaim:       getEnvOrDefault("SPIDER_JWT_USER_CLAIM", DEFAULT_JWT_USER_CLAIM),
+			RoleClaim:       getEnvOrDefault("SPIDER_JWT_ROLE_CLAIM", DEFAULT_JWT_ROLE_CLAIM),
+			RoleMap:         map[string]string{},
+			ConnectionClaim: getEnvOrDefault("SPIDER_JWT_CONNECTION_CLAIM", DEFAULT_JWT_CONNECTION_CLAIM),
+		}
+		for _, pair := range strings.Split(os.Getenv("SPIDER_JWT_ROLE_MAP"), ",") {
+			value, role, ok := strings.Cut(pair, "=")
+			if !ok {
+				continue
+			}
+			jwtConfig.RoleMap[strings.TrimSpace(value)] = strings.ToLower(strings.TrimSpace(role))
+		}
+	})
+	return &jwtConfig
+}
+
+func getEnvOrDefault(key string, defaultValue string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// IsJWTAuthEnabled returns true if the HMAC key or the OIDC issuer is set.
+func IsJWTAuthEnabled() bool {
+	config := getJWTAuthConfig()
+	return len(config.HMACKey) > 0 || config.Issuer != "" || config.JWKSURL != ""
+}
+
+// AuthenticateJWT verifies the JWT bearer token and returns the user with the role bindings of the claims.
+func AuthenticateJWT(tokenString string) (*AuthUserInfo, error) {
+	config := getJWTAuthConfig()
+
+	validMethods := []string{}
+	if len(config.HMACKey) > 0 {
+		validMethods = append(validMethods, "HS256", "HS384", "HS512")
+	}
+	if config.Issuer != "" || config.JWKSURL != "" {
+		validMethods = append(validMethods, "RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512")
+	}
+	if len(validMethods) == 0 {
+		return nil, fmt.Errorf("JWT authentication is not configured")
+	}
+
+	claims := jwt.MapClaims{}
+	parser := jwt.Parser{ValidMethods: validMethods}
+	token, err := parser.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+			return config.HMACKey, nil
+		}
+		kid, _ := token.Header["kid"].(string)
+		return jwks.getKey(config, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWT: %v", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid JWT")
+	}
+
+	// the token without the expiration time is valid forever
+	if _, ok := claims["exp"]; !ok {
+		return nil, fmt.Errorf("invalid JWT: the expiration time(exp) is required")
+	}
+	if config.Issuer != "" && !claims.VerifyIssuer(config.Issuer, true) {
+		return nil, fmt.Errorf("invalid JWT: the issuer is not '%s'", config.Issuer)
+	}
+	if config.Audience != "" && !claims.VerifyAudience(config.Audience, true) {
+		return nil, fmt.Errorf("invalid JWT: the audience is not '%s'", config.Audience)
+	}
+
+	return getJWTAuthUser(config, claims)
+}
+
+// getJWTAuthUser maps the claims to the user and the role bindings
+func getJWTAuthUser(config *jwtAuthConfig, claims jwt.MapClaims) (*AuthUserInfo, error) {
+	userName, _ := getClaim(claims, config.UserClaim).(string)
+	if userName == "" {
+		return nil, fmt.Errorf("invalid JWT: the claim '%s' of the user is empty", config.UserClaim)
+	}
+
+	// the user without the connection claim has no role binding
+	connectionPatternList := getClaimStrList(claims, config.ConnectionClaim)
+
+	authUser := AuthUserInfo{UserName: userName}
+	for _, value := range getClaimStrList(claims, config.RoleClaim) {
+		role, ok := config.RoleMap[value]
+		if !ok {
+			role = strings.ToLower(value)
+		}
+		if _, ok := roleLevelMap[role]; !ok {
+			continue
+		}
+		for _, pattern := range connectionPatternList {
+			authUser.RoleBindingList = append(authUser.RoleBindingList,
+				RoleBindingInfo{UserName: userName, Role: role, ConnectionPattern: pattern})
+		}
+	}
+	return &authUser, nil
+}
+
+// getClaim returns the claim of a dotted path, ex) realm_access.roles
+func getClaim(claims jwt.MapClaims, claimPath string) interface{} {
+	var value interface{} = map[string]interface{}(claims)
+	for _, key := range strings.Split(claimPath, ".") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if value, ok = m[key]; !ok {
+			return nil
+		}
+	}
+	return value
+}
+
+// getClaimStrList returns the claim of an array or a string separated with commas or spaces
+func getClaimStrList(claims jwt.MapClaims, claimPath string) []string {
+	strList := []string{}
+	switch value := getClaim(claims, claimPath).(type) {
+	case string:
+		strList = strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' })
+	case []interface{}:
+		for _, v := range value {
+			if str, ok := v.(string); ok && str != "" {
+				strList = append(strList, str)
+			}
+		}
+	}
+	return strList
+}
+
+//================ JWKS of the OIDC issuer
+
+type jwksCache struct {
+	mutex       sync.Mutex
+	keyMap      map[string]interface{} // kid: *rsa.PublicKey | *ecdsa.PublicKey
+	fetchedTime time.Time
+	fetchGroup  singleflight.Group // the concurrent calls share one fetch
+}
+
+type jwkInfo struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// getKey returns the public key of the key id, the keys are fetched again
+// periodically or for an unknown key id(key rotation of the issuer).
+// The keys are fetched without the lock, not to block the calls with the cached keys.
+func (cache *jwksCache) getKey(config *jwtAuthConfig, kid string) (interface{}, error) {
+	cache.mutex.Lock()
+	key, ok := cache.lookup(kid)
+	elapsed := time.Since(cache.fetchedTime)
+	cache.mutex.Unlock()
+
+	if (ok && elapsed < jwksRefreshInterval) || (!ok && elapsed < jwksMinRefreshInterval) {
+		if !ok {
+			return nil, fmt.Errorf("the key '%s' does not exist in the JWKS", kid)
+		}
+		return key, nil
+	}
+
+	_, err, _ := cache.fetchGroup.Do("jwks", func() (interface{}, error) {
+		return nil, cache.fetch(config)
+	})
+	if err != nil {
+		cblog.Error(err)
+		if ok {
+			// use the cached key while the issuer is not reachable
+			return key, nil
+		}
+		return nil, err
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if key, ok = cache.lookup(kid); !ok {
+		return nil, fmt.Errorf("the key '%s' does not exist in the JWKS", kid)
+	}
+	return key, nil
+}
+
+func (cache *jwksCache) lookup(kid string) (interface{}, bool) {
+	if kid == "" && len(cache.keyMap) == 1 {
+		for _, key := range cache.keyMap {
+			return key, true
+		}
+	}
+	key, ok := cache.keyMap[kid]
+	return key, ok
+}
+
+// fetch gets the keys of the JWKS, and replaces the cached keys
+func (cache *jwksCache) fetch(config *jwtAuthConfig) error {
+	// the failed fetch is not retried within the min refresh interval
+	defer func() {
+		cache.mutex.Lock()
+		cache.fetchedTime = time.Now()
+		cache.mutex.Unlock()
+	}()
+	client := &http.Client{Timeout: oidcHTTPTimeout}
+
+	jwksURL := config.JWKSURL
+	if jwksURL == "" {
+		var discovery struct {
+			JWKSURI string `json:"jwks_uri"`
+		}
+		if err := getJSON(client, config.Issuer+"/.well-known/openid-configuration", &discovery); err != nil {
+			return err
+		}
+		if discovery.JWKSURI == "" {
+			return fmt.Errorf("jwks_uri of the OIDC issuer '%s' is empty", config.Issuer)
+		}
+		jwksURL = discovery.JWKSURI
+	}
+
+	var keySet struct {
+		Keys []jwkInfo `json:"keys"`
+	}
+	if err := getJSON(client, jwksURL, &keySet); err != nil {
+		return err
+	}
+
+	keyMap := map[string]interface{}{}
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			cblog.Errorf("skip the key '%s' of the JWKS: %v", jwk.Kid, err)
+			continue
+		}
+		keyMap[jwk.Kid] = key
+	}
+
+	cache.mutex.Lock()
+	cache.keyMap = keyMap
+	cache.mutex.Unlock()
+	return nil
+}
+
+func getJSON(client *http.Client, url string, result interface{}) error {
+	resp, err := client.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(result)
+}
+
+func (jwk jwkInfo) publicKey() (interface{}, error) {
+	switch jwk.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch jwk.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve '%s'", jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	}
+	return nil, fmt.Errorf("unsupported key type '%s'", jwk.Kty)
+}
@@ -12,6 +12,7 @@ import (
 	"fmt"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
@@ -48,6 +49,8 @@ func init() {
 func RegisterKey(connectionName string, userIID cres.IID) (*cres.KeyPairInfo, error) {
 	cblog.Info("call RegisterKey()")
 
+	defer startSpan("RegisterKey", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -95,7 +98,9 @@ func RegisterKey(connectionName string, userIID cres.IID) (*cres.KeyPairInfo, er
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetKey(cres.IID{NameId: userIID.SystemId, SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.VMKEYPAIR, "GetKey()", func() (cres.KeyPairInfo, error) {
+		return handler.GetKey(cres.IID{NameId: userIID.SystemId, SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -131,6 +136,8 @@ func RegisterKey(connectionName string, userIID cres.IID) (*cres.KeyPairInfo, er
 func CreateKey(connectionName string, rsType string, reqInfo cres.KeyPairReqInfo, IDTransformMode string) (*cres.KeyPairInfo, error) {
 	cblog.Info("call CreateKey()")
 
+	defer startSpan("CreateKey", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -239,6 +246,8 @@ func CreateKey(connectionName string, rsType string, reqInfo cres.KeyPairReqInfo
 func ListKey(connectionName string, rsType string) ([]*cres.KeyPairInfo, error) {
 	cblog.Info("call ListKey()")
 
+	defer startSpan("ListKey", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -279,7 +288,9 @@ func ListKey(connectionName string, rsType string) ([]*cres.KeyPairInfo, error)
 		keySPLock.RLock(connectionName, iidInfo.NameId)
 
 		// (2) get resource(SystemId)
-		info, err := handler.GetKey(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		info, err := retryCall(connectionName, call.VMKEYPAIR, "GetKey()", func() (cres.KeyPairInfo, error) {
+			return handler.GetKey(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		})
 		if err != nil {
 			keySPLock.RUnlock(connectionName, iidInfo.NameId)
 			if checkNotFoundError(err) {
@@ -311,6 +322,8 @@ func hideSecretInfo(info *cres.KeyPairInfo) {
 func GetKey(connectionName string, rsType string, nameID string) (*cres.KeyPairInfo, error) {
 	cblog.Info("call GetKey()")
 
+	defer startSpan("GetKey", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -348,7 +361,9 @@ func GetKey(connectionName string, rsType string, nameID string) (*cres.KeyPairI
 	}
 
 	// (2) get resource(SystemId)
-	info, err := handler.GetKey(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.VMKEYPAIR, "GetKey()", func() (cres.KeyPairInfo, error) {
+		return handler.GetKey(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -367,6 +382,8 @@ func GetKey(connectionName string, rsType string, nameID string) (*cres.KeyPairI
 func DeleteKey(connectionName string, rsType string, nameID string, force string) (bool, error) {
 	cblog.Info("call DeleteKey()")
 
+	defer startSpan("DeleteKey", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
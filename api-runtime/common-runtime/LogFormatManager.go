@@ -0,0 +1,76 @@
+// Log Format Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"os"
+	"strings"
+
+	"github.com/snowzach/rotatefilehook"
+	"gopkg.in/yaml.v3"
+
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	calllogformatter "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log/formatter"
+)
+
+//================ Log Format of the Server Logger
+// The cb-log lib has only the text format,
+// so the JSON format of 'cblog.logformat' in $CBLOG_ROOT/conf/log_conf.yaml is applied here.
+
+func init() {
+	if getServerLogFormat() != call.LOG_FORMAT_JSON {
+		return
+	}
+
+	formatter := &calllogformatter.JSONFormatter{
+		LoggerName: "CLOUD-BARISTA",
+		HostName:   call.HostIPorName,
+	}
+	cblog.SetFormatter(formatter)
+	// the log file hook of cb-log has its own formatter
+	for _, hookList := range cblog.Hooks {
+		for _, hook := range hookList {
+			if fileHook, ok := hook.(*rotatefilehook.RotateFileHook); ok {
+				fileHook.Config.Formatter = formatter
+			}
+		}
+	}
+}
+
+func getServerLogFormat() string {
+	cblogRoot := os.Getenv("CBLOG_ROOT")
+	if cblogRoot == "" {
+		return call.LOG_FORMAT_TEXT
+	}
+	data, err := os.ReadFile(cblogRoot + "/conf/log_conf.yaml")
+	if err != nil {
+		return call.LOG_FORMAT_TEXT
+	}
+
+	var config struct {
+		CBLOG struct {
+			LOGFORMAT string
+		}
+	}
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		cblog.Error(err)
+		return call.LOG_FORMAT_TEXT
+	}
+
+	format := strings.ToLower(config.CBLOG.LOGFORMAT)
+	switch format {
+	case "", call.LOG_FORMAT_TEXT:
+		return call.LOG_FORMAT_TEXT
+	case call.LOG_FORMAT_JSON:
+		return format
+	default:
+		cblog.Errorf("invalid cblog.logformat(%s), use the default %s", format, call.LOG_FORMAT_TEXT)
+		return call.LOG_FORMAT_TEXT
+	}
+}
@@ -0,0 +1,149 @@
+// Prometheus Metrics Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	splock "github.com/cloud-barista/cb-spider/api-runtime/common-runtime/sp-lock"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+//================ Prometheus Metrics
+// The driver calls are observed from the call-log of the drivers,
+// the SPLock wait times from the SPLocks, and the resource counts from the IID infos at each scrape.
+
+var metricsRegistry = prometheus.NewRegistry()
+
+var (
+	driverCallCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "spider_driver_calls_total",
+		Help: "Total number of the CSP API calls of the drivers.",
+	}, []string{"cloud_os", "resource_type", "api"})
+
+	driverCallErrorCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "spider_driver_call_errors_total",
+		Help: "Total number of the failed CSP API calls of the drivers.",
+	}, []string{"cloud_os", "resource_type", "api"})
+
+	driverCallDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "spider_driver_call_duration_seconds",
+		Help:    "Latency of the CSP API calls of the drivers.",
+		Buckets: []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"cloud_os", "resource_type", "api"})
+
+	spLockWaitDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "spider_splock_wait_seconds",
+		Help:    "Wait time to acquire the SPLocks.",
+		Buckets: []float64{0.001, 0.01, 0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	}, []string{"lock", "mode"})
+
+	resourceCountDesc = prometheus.NewDesc("spider_resources",
+		"Number of the resources managed by CB-Spider.",
+		[]string{"connection", "resource_type"}, nil)
+)
+
+// resource types and the IID info models of the resource count metric
+var resourceCountModelList = []struct {
+	resType string
+	model   interface{}
+}{
+	{"vpc", &VPCIIDInfo{}},
+	{"subnet", &SubnetIIDInfo{}},
+	{"securitygroup", &SGIIDInfo{}},
+	{"keypair", &KeyIIDInfo{}},
+	{"vm", &VMIIDInfo{}},
+	{"disk", &DiskIIDInfo{}},
+	{"myimage", &MyImageIIDInfo{}},
+	{"nlb", &NLBIIDInfo{}},
+	{"cluster", &ClusterIIDInfo{}},
+}
+
+type resourceCountCollector struct{}
+
+func (resourceCountCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- resourceCountDesc
+}
+
+// Collect counts the resources of all connections with one grouped query of each resource type.
+func (resourceCountCollector) Collect(ch chan<- prometheus.Metric) {
+	connectionList, err := ccim.ListConnectionConfig()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	for _, one := range resourceCountModelList {
+		countMap, err := infostore.CountNameIDsGroupByConnection(one.model)
+		if err != nil {
+			cblog.Error(err)
+			continue
+		}
+		for _, connection := range connectionList {
+			ch <- prometheus.MustNewConstMetric(resourceCountDesc, prometheus.GaugeValue,
+				float64(countMap[connection.ConfigName]), connection.ConfigName, one.resType)
+		}
+	}
+}
+
+func init() {
+	metricsRegistry.MustRegister(
+		prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}),
+		prometheus.NewGoCollector(),
+		driverCallCounter,
+		driverCallErrorCounter,
+		driverCallDuration,
+		spLockWaitDuration,
+		resourceCountCollector{},
+	)
+
+	call.AddCallObserver(observeDriverCall)
+
+	for lockName, spLock := range map[string]*splock.SPLOCK{
+		"vpc": vpcSPLock, "securitygroup": sgSPLock, "keypair": keySPLock, "vm": vmSPLock,
+		"nlb": nlbSPLock, "disk": diskSPLock, "myimage": myImageSPLock, "cluster": clusterSPLock,
+	} {
+		lockName := lockName
+		spLock.SetWaitObserver(func(mode string, wait time.Duration) {
+			spLockWaitDuration.WithLabelValues(lockName, mode).Observe(wait.Seconds())
+		})
+	}
+}
+
+// observeDriverCall counts a CSP API call with the call-log of the drivers.
+// The call-logs of CB-Spider itself(ex. retry) are not CSP API calls.
+func observeDriverCall(callInfo call.CLOUDLOGSCHEMA) {
+	if isSpiderCallLog(callInfo) {
+		return
+	}
+
+	labels := []string{string(callInfo.CloudOS), string(callInfo.ResourceType), callInfo.CloudOSAPI}
+	driverCallCounter.WithLabelValues(labels...).Inc()
+	if callInfo.ErrorMSG != "" {
+		driverCallErrorCounter.WithLabelValues(labels...).Inc()
+	}
+	if elapsed, ok := call.ElapsedSeconds(callInfo); ok {
+		driverCallDuration.WithLabelValues(labels...).Observe(elapsed)
+	}
+}
+
+// RegisterMetricsCollector registers the collectors of the API runtimes, ex) REST request metrics.
+func RegisterMetricsCollector(collectors ...prometheus.Collector) {
+	metricsRegistry.MustRegister(collectors...)
+}
+
+// MetricsHandler returns the HTTP handler exposing the metrics in the Prometheus format.
+func MetricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{})
+}
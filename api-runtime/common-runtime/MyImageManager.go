@@ -12,6 +12,7 @@ import (
 	"fmt"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
@@ -48,6 +49,8 @@ func init() {
 func RegisterMyImage(connectionName string, userIID cres.IID) (*cres.MyImageInfo, error) {
 	cblog.Info("call RegisterMyImage()")
 
+	defer startSpan("RegisterMyImage", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -95,7 +98,9 @@ func RegisterMyImage(connectionName string, userIID cres.IID) (*cres.MyImageInfo
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetMyImage(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.MYIMAGE, "GetMyImage()", func() (cres.MyImageInfo, error) {
+		return handler.GetMyImage(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -130,6 +135,8 @@ func RegisterMyImage(connectionName string, userIID cres.IID) (*cres.MyImageInfo
 func SnapshotVM(connectionName string, rsType string, reqInfo cres.MyImageInfo, IDTransformMode string) (*cres.MyImageInfo, error) {
 	cblog.Info("call SnapshotVM()")
 
+	defer startSpan("SnapshotVM", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -260,6 +267,8 @@ func SnapshotVM(connectionName string, rsType string, reqInfo cres.MyImageInfo,
 func ListMyImage(connectionName string, rsType string) ([]*cres.MyImageInfo, error) {
 	cblog.Info("call ListMyImage()")
 
+	defer startSpan("ListMyImage", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -300,7 +309,9 @@ func ListMyImage(connectionName string, rsType string) ([]*cres.MyImageInfo, err
 		myImageSPLock.RLock(connectionName, iidInfo.NameId)
 
 		// get resource(SystemId)
-		info, err := handler.GetMyImage(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		info, err := retryCall(connectionName, call.MYIMAGE, "GetMyImage()", func() (cres.MyImageInfo, error) {
+			return handler.GetMyImage(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		})
 		if err != nil {
 			myImageSPLock.RUnlock(connectionName, iidInfo.NameId)
 			if checkNotFoundError(err) {
@@ -336,6 +347,8 @@ func ListMyImage(connectionName string, rsType string) ([]*cres.MyImageInfo, err
 func GetMyImage(connectionName string, rsType string, nameID string) (*cres.MyImageInfo, error) {
 	cblog.Info("call GetMyImage()")
 
+	defer startSpan("GetMyImage", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -373,7 +386,9 @@ func GetMyImage(connectionName string, rsType string, nameID string) (*cres.MyIm
 	}
 
 	// (2) get resource(SystemId)
-	info, err := handler.GetMyImage(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.MYIMAGE, "GetMyImage()", func() (cres.MyImageInfo, error) {
+		return handler.GetMyImage(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -399,6 +414,8 @@ func GetMyImage(connectionName string, rsType string, nameID string) (*cres.MyIm
 func DeleteMyImage(connectionName string, rsType string, nameID string, force string) (bool, error) {
 	cblog.Info("call DeleteMyImage()")
 
+	defer startSpan("DeleteMyImage", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
@@ -45,6 +46,8 @@ func init() {
 func GetNLBOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err error) {
 	cblog.Info("call GetNLBOwnerVPC()")
 
+	defer startSpan("GetNLBOwnerVPC", connectionName, cspID).end()
+
 	// check empty and trim user inputs
 	connectionName, err = EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -105,7 +108,9 @@ func GetNLBOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetNLB(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	getInfo, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+		return handler.GetNLB(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	})
 	if err != nil {
 		//vpcSPLock.RUnlock()
 		//nlbSPLock.RUnlock()
@@ -153,6 +158,8 @@ func GetNLBOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err
 func RegisterNLB(connectionName string, vpcUserID string, userIID cres.IID) (*cres.NLBInfo, error) {
 	cblog.Info("call RegisterNLB()")
 
+	defer startSpan("RegisterNLB", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -229,7 +236,9 @@ func RegisterNLB(connectionName string, vpcUserID string, userIID cres.IID) (*cr
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetNLB(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+		return handler.GetNLB(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -281,6 +290,8 @@ func RegisterNLB(connectionName string, vpcUserID string, userIID cres.IID) (*cr
 func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTransformMode string) (*cres.NLBInfo, error) {
 	cblog.Info("call CreateNLB()")
 
+	defer startSpan("CreateNLB", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -303,6 +314,20 @@ func CreateNLB(connectionName string, rsType string, reqInfo cres.NLBInfo, IDTra
 	   }
 	*/
 
+	// apply the default tag policy of the connection
+	reqInfo.TagList, err = applyTagPolicy(connectionName, rsType, reqInfo.IId.NameId, reqInfo.TagList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check the admission policy before calling the driver
+	err = admitNLB(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	vpcSPLock.RLock(connectionName, reqInfo.VpcIID.NameId)
 	defer vpcSPLock.RUnlock(connectionName, reqInfo.VpcIID.NameId)
 
@@ -499,6 +524,8 @@ func transformArgsToUpper(nlbInfo *cres.NLBInfo) {
 func ListNLB(connectionName string, rsType string) ([]*cres.NLBInfo, error) {
 	cblog.Info("call ListNLB()")
 
+	defer startSpan("ListNLB", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -539,7 +566,9 @@ func ListNLB(connectionName string, rsType string) ([]*cres.NLBInfo, error) {
 		nlbSPLock.RLock(connectionName, iidInfo.NameId)
 
 		// get resource(SystemId)
-		info, err := handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		info, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+			return handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		})
 		if err != nil {
 			nlbSPLock.RUnlock(connectionName, iidInfo.NameId)
 			if checkNotFoundError(err) {
@@ -590,6 +619,8 @@ func ListNLB(connectionName string, rsType string) ([]*cres.NLBInfo, error) {
 func GetNLB(connectionName string, rsType string, nameID string) (*cres.NLBInfo, error) {
 	cblog.Info("call GetNLB()")
 
+	defer startSpan("GetNLB", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -641,7 +672,9 @@ func GetNLB(connectionName string, rsType string, nameID string) (*cres.NLBInfo,
 	}
 
 	// (2) get resource(SystemId)
-	info, err := handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+		return handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -683,6 +716,8 @@ func GetNLB(connectionName string, rsType string, nameID string) (*cres.NLBInfo,
 func AddNLBVMs(connectionName string, nlbName string, vmNames []string) (*cres.NLBInfo, error) {
 	cblog.Info("call AddNLBVMs()")
 
+	defer startSpan("AddNLBVMs", connectionName, nlbName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -766,7 +801,9 @@ func AddNLBVMs(connectionName string, nlbName string, vmNames []string) (*cres.N
 	}
 
 	// (3) Get NLBInfo
-	info, err := handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+		return handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -806,6 +843,8 @@ func AddNLBVMs(connectionName string, nlbName string, vmNames []string) (*cres.N
 func RemoveNLBVMs(connectionName string, nlbName string, vmNames []string) (bool, error) {
 	cblog.Info("call RemoveNLBVMs()")
 
+	defer startSpan("RemoveNLBVMs", connectionName, nlbName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -890,6 +929,8 @@ func RemoveNLBVMs(connectionName string, nlbName string, vmNames []string) (bool
 func ChangeListener(connectionName string, nlbName string, listener cres.ListenerInfo) (*cres.NLBInfo, error) {
 	cblog.Info("call ChangeListener()")
 
+	defer startSpan("ChangeListener", connectionName, nlbName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -960,7 +1001,9 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 	}
 
 	// (3) Get NLBInfo
-	info, err := handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+		return handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1005,6 +1048,8 @@ func ChangeListener(connectionName string, nlbName string, listener cres.Listene
 func ChangeVMGroup(connectionName string, nlbName string, vmGroup cres.VMGroupInfo) (*cres.NLBInfo, error) {
 	cblog.Info("call ChangeVMGroup()")
 
+	defer startSpan("ChangeVMGroup", connectionName, nlbName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1076,7 +1121,9 @@ func ChangeVMGroup(connectionName string, nlbName string, vmGroup cres.VMGroupIn
 	}
 
 	// (3) Get NLBInfo
-	info, err := handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+		return handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1121,6 +1168,8 @@ func ChangeVMGroup(connectionName string, nlbName string, vmGroup cres.VMGroupIn
 func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cres.HealthCheckerInfo) (*cres.NLBInfo, error) {
 	cblog.Info("call ChangeHealthChecker()")
 
+	defer startSpan("ChangeHealthChecker", connectionName, nlbName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1189,7 +1238,9 @@ func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cr
 	}
 
 	// (3) Get NLBInfo
-	info, err := handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.NLB, "GetNLB()", func() (cres.NLBInfo, error) {
+		return handler.GetNLB(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1231,6 +1282,8 @@ func ChangeHealthChecker(connectionName string, nlbName string, healthChecker cr
 func GetVMGroupHealthInfo(connectionName string, nlbName string) (*cres.HealthInfo, error) {
 	cblog.Info("call GetVMGroupHealthInfo()")
 
+	defer startSpan("GetVMGroupHealthInfo", connectionName, nlbName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1295,7 +1348,9 @@ func GetVMGroupHealthInfo(connectionName string, nlbName string) (*cres.HealthIn
 
 	// (2) change VMGroup
 	// driverIID for driver
-	healthInfo, err := handler.GetVMGroupHealthInfo(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	healthInfo, err := retryCall(connectionName, call.NLB, "GetVMGroupHealthInfo()", func() (cres.HealthInfo, error) {
+		return handler.GetVMGroupHealthInfo(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1383,6 +1438,8 @@ func setVMUserIIDwithSystemId(connectionName string, nlbName string, healthInfo
 func DeleteNLB(connectionName string, rsType string, nameID string, force string) (bool, error) {
 	cblog.Info("call DeleteNLB()")
 
+	defer startSpan("DeleteNLB", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
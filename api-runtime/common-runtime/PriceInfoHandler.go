@@ -10,6 +10,7 @@ package commonruntime
 
 import (
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
@@ -17,6 +18,8 @@ import (
 func ListProductFamily(connectionName string, regionName string) ([]string, error) {
 	cblog.Info("call ListProductFamily()")
 
+	defer startSpan("ListProductFamily", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -41,7 +44,9 @@ func ListProductFamily(connectionName string, regionName string) ([]string, erro
 		cblog.Error(err)
 		return nil, err
 	}
-	listProductFamily, err := handler.ListProductFamily(regionName)
+	listProductFamily, err := retryCall(connectionName, call.PRICEINFO, "ListProductFamily()", func() ([]string, error) {
+		return handler.ListProductFamily(regionName)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -58,6 +63,8 @@ func ListProductFamily(connectionName string, regionName string) ([]string, erro
 func GetPriceInfo(connectionName string, productFamily string, regionName string, filterList []cres.KeyValue) (string, error) {
 	cblog.Info("call GetPriceInfo()")
 
+	defer startSpan("GetPriceInfo", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -95,7 +102,9 @@ func GetPriceInfo(connectionName string, productFamily string, regionName string
 	}
 
 	cspProductFamily := getProviderSpecificPFName(providerName, productFamily)
-	priceInfo, err := handler.GetPriceInfo(cspProductFamily, regionName, filterList)
+	priceInfo, err := retryCall(connectionName, call.PRICEINFO, "GetPriceInfo()", func() (string, error) {
+		return handler.GetPriceInfo(cspProductFamily, regionName, filterList)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return "", err
@@ -9,13 +9,47 @@
 package commonruntime
 
 import (
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
+// ====================================================================
+// Image Index for image search
+//   - cached ImageInfo list per connection
+//   - TTL: SPIDER_IMAGE_INDEX_TTL (ex: 30m, 2h), default: 1h
+
+const DEFAULT_IMAGE_INDEX_TTL = 1 * time.Hour
+
+type imageIndex struct {
+	imageInfoList []*cres.ImageInfo
+	createdTime   time.Time
+}
+
+var imageIndexMap = map[string]*imageIndex{}
+var imageIndexLock = new(sync.RWMutex)
+
+// ImageFilterInfo is the condition of SearchImage(), "" means any.
+type ImageFilterInfo struct {
+	OSDistribution string // ex) ubuntu
+	OSVersion      string // ex) 22.04, 22
+	OSArchitecture string // ex) x86_64, amd64, arm64
+	OSPlatform     string // ex) Linux/UNIX, Windows
+}
+
+//====================================================================
+
 func ListImage(connectionName string, rsType string) ([]*cres.ImageInfo, error) {
 	cblog.Info("call ListImage()")
 
+	defer startSpan("ListImage", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -35,7 +69,9 @@ func ListImage(connectionName string, rsType string) ([]*cres.ImageInfo, error)
 		return nil, err
 	}
 
-	infoList, err := handler.ListImage()
+	infoList, err := retryCall(connectionName, call.VMIMAGE, "ListImage()", func() ([]*cres.ImageInfo, error) {
+		return handler.ListImage()
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -45,12 +81,18 @@ func ListImage(connectionName string, rsType string) ([]*cres.ImageInfo, error)
 		infoList = []*cres.ImageInfo{}
 	}
 
+	for _, info := range infoList {
+		setImageAttributes(info)
+	}
+
 	return infoList, nil
 }
 
 func GetImage(connectionName string, rsType string, nameID string) (*cres.ImageInfo, error) {
 	cblog.Info("call GetImage()")
 
+	defer startSpan("GetImage", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -77,11 +119,231 @@ func GetImage(connectionName string, rsType string, nameID string) (*cres.ImageI
 	}
 
 	// now, NameID = SystemID
-	info, err := handler.GetImage(cres.IID{nameID, nameID})
+	info, err := retryCall(connectionName, call.VMIMAGE, "GetImage()", func() (cres.ImageInfo, error) {
+		return handler.GetImage(cres.IID{nameID, nameID})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
+	setImageAttributes(&info)
 
 	return &info, nil
 }
+
+// SearchImage returns the images matched with the filter.
+// The images are searched in the cached image index of the connection.
+// The index is rebuilt when it is expired or refresh is true.
+func SearchImage(connectionName string, rsType string, filter ImageFilterInfo, refresh bool) ([]*cres.ImageInfo, error) {
+	cblog.Info("call SearchImage()")
+
+	defer startSpan("SearchImage", connectionName, "").end()
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	infoList, err := getImageIndex(connectionName, rsType, refresh)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	filter.OSDistribution = strings.ToLower(strings.TrimSpace(filter.OSDistribution))
+	filter.OSVersion = strings.TrimSpace(filter.OSVersion)
+	filter.OSArchitecture = normalizeArchitecture(strings.TrimSpace(filter.OSArchitecture))
+	filter.OSPlatform = strings.TrimSpace(filter.OSPlatform)
+
+	resultList := []*cres.ImageInfo{}
+	for _, info := range infoList {
+		if isMatchedImage(info, filter) {
+			resultList = append(resultList, copyImageInfo(info))
+		}
+	}
+
+	return resultList, nil
+}
+
+// ClearImageIndex removes the cached image index of the connection.
+func ClearImageIndex(connectionName string) {
+	defer startSpan("ClearImageIndex", connectionName, "").end()
+
+	imageIndexLock.Lock()
+	defer imageIndexLock.Unlock()
+	delete(imageIndexMap, connectionName)
+}
+
+func getImageIndex(connectionName string, rsType string, refresh bool) ([]*cres.ImageInfo, error) {
+	if !refresh {
+		imageIndexLock.RLock()
+		index, ok := imageIndexMap[connectionName]
+		imageIndexLock.RUnlock()
+		if ok && time.Since(index.createdTime) < getImageIndexTTL() {
+			return index.imageInfoList, nil
+		}
+	}
+
+	infoList, err := ListImage(connectionName, rsType)
+	if err != nil {
+		return nil, err
+	}
+
+	// keep own copies in the index, the returned list can be changed by the caller
+	indexList := make([]*cres.ImageInfo, 0, len(infoList))
+	for _, info := range infoList {
+		indexList = append(indexList, copyImageInfo(info))
+	}
+	infoList = indexList
+
+	imageIndexLock.Lock()
+	imageIndexMap[connectionName] = &imageIndex{imageInfoList: infoList, createdTime: time.Now()}
+	imageIndexLock.Unlock()
+
+	return infoList, nil
+}
+
+// copyImageInfo returns a deep copy of the ImageInfo to keep the cached index from the caller's changes.
+func copyImageInfo(info *cres.ImageInfo) *cres.ImageInfo {
+	copied := *info
+	if info.TagList != nil {
+		copied.TagList = append([]cres.KeyValue{}, info.TagList...)
+	}
+	if info.KeyValueList != nil {
+		copied.KeyValueList = append([]cres.KeyValue{}, info.KeyValueList...)
+	}
+	return &copied
+}
+
+func getImageIndexTTL() time.Duration {
+	ttl := os.Getenv("SPIDER_IMAGE_INDEX_TTL")
+	if ttl == "" {
+		return DEFAULT_IMAGE_INDEX_TTL
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		cblog.Errorf("invalid SPIDER_IMAGE_INDEX_TTL(%s), use the default %v", ttl, DEFAULT_IMAGE_INDEX_TTL)
+		return DEFAULT_IMAGE_INDEX_TTL
+	}
+	return duration
+}
+
+func isMatchedImage(info *cres.ImageInfo, filter ImageFilterInfo) bool {
+	if filter.OSDistribution != "" && info.OSDistribution != filter.OSDistribution {
+		return false
+	}
+	// "22" is matched with "22.04"
+	if filter.OSVersion != "" && info.OSVersion != filter.OSVersion &&
+		!strings.HasPrefix(info.OSVersion, filter.OSVersion+".") {
+		return false
+	}
+	if filter.OSArchitecture != "" && info.OSArchitecture != filter.OSArchitecture {
+		return false
+	}
+	if filter.OSPlatform != "" && !strings.EqualFold(info.OSPlatform, filter.OSPlatform) {
+		return false
+	}
+	return true
+}
+
+// known distributions: {distribution, keywords in image name or GuestOS}
+var osDistributionList = []struct {
+	distribution string
+	keywords     []string
+}{
+	{"ubuntu", []string{"ubuntu"}},
+	{"debian", []string{"debian"}},
+	{"centos", []string{"centos"}},
+	{"rocky", []string{"rocky"}},
+	{"alma", []string{"almalinux", "alma"}},
+	{"rhel", []string{"rhel", "red hat", "redhat"}},
+	{"sles", []string{"sles", "suse"}},
+	{"amazon", []string{"amzn", "amazon linux", "al2023"}},
+	{"oracle", []string{"oracle", "ol8", "ol9"}},
+	{"fedora", []string{"fedora"}},
+	{"windows", []string{"windows"}},
+}
+
+var versionRegexp = regexp.MustCompile(`\d+(\.\d+)?`)
+var windowsVersionRegexp = regexp.MustCompile(`20\d\d`)
+
+// setImageAttributes fills the empty normalized attributes with the values parsed from GuestOS and the image name.
+func setImageAttributes(info *cres.ImageInfo) {
+	if info == nil {
+		return
+	}
+
+	source := strings.ToLower(info.GuestOS + " " + info.IId.NameId)
+	for _, kv := range info.KeyValueList {
+		if kv.Key == "Name" || kv.Key == "Description" {
+			source += " " + strings.ToLower(kv.Value)
+		}
+	}
+
+	if info.OSDistribution == "" || info.OSVersion == "" {
+		for _, dist := range osDistributionList {
+			for _, keyword := range dist.keywords {
+				idx := strings.Index(source, keyword)
+				if idx < 0 {
+					continue
+				}
+				if info.OSDistribution == "" {
+					info.OSDistribution = dist.distribution
+				}
+				if info.OSVersion == "" && info.OSDistribution == dist.distribution {
+					rest := source[idx+len(keyword):]
+					if dist.distribution == "windows" {
+						info.OSVersion = windowsVersionRegexp.FindString(rest)
+					} else {
+						info.OSVersion = versionRegexp.FindString(rest)
+					}
+				}
+				break
+			}
+			if info.OSDistribution != "" {
+				break
+			}
+		}
+	}
+
+	if info.OSArchitecture == "" {
+		for _, arch := range []string{"x86_64", "amd64", "arm64", "aarch64", "i386"} {
+			if strings.Contains(source, arch) {
+				info.OSArchitecture = arch
+				break
+			}
+		}
+	}
+	info.OSArchitecture = normalizeArchitecture(info.OSArchitecture)
+
+	if info.OSPlatform == "" && info.OSDistribution != "" {
+		if info.OSDistribution == "windows" {
+			info.OSPlatform = "Windows"
+		} else {
+			info.OSPlatform = "Linux/UNIX"
+		}
+	}
+
+	if !info.GPUReady {
+		for _, keyword := range []string{"gpu", "cuda", "nvidia", "deep learning", "deeplearning"} {
+			if strings.Contains(source, keyword) {
+				info.GPUReady = true
+				break
+			}
+		}
+	}
+}
+
+func normalizeArchitecture(arch string) string {
+	switch strings.ToLower(arch) {
+	case "x86_64", "amd64", "x64":
+		return "x86_64"
+	case "arm64", "aarch64", "arm64_mac":
+		return "arm64"
+	case "i386", "x86", "386":
+		return "i386"
+	}
+	return arch
+}
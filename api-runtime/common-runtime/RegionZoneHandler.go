@@ -10,6 +10,7 @@ package commonruntime
 
 import (
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
@@ -17,6 +18,8 @@ import (
 func ListRegionZone(connectionName string) ([]*cres.RegionZoneInfo, error) {
 	cblog.Info("call ListRegionZone()")
 
+	defer startSpan("ListRegionZone", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -36,7 +39,9 @@ func ListRegionZone(connectionName string) ([]*cres.RegionZoneInfo, error) {
 		return nil, err
 	}
 
-	infoList, err := handler.ListRegionZone()
+	infoList, err := retryCall(connectionName, call.REGIONZONE, "ListRegionZone()", func() ([]*cres.RegionZoneInfo, error) {
+		return handler.ListRegionZone()
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -59,6 +64,8 @@ func ListRegionZone(connectionName string) ([]*cres.RegionZoneInfo, error) {
 func GetRegionZone(connectionName string, nameID string) (*cres.RegionZoneInfo, error) {
 	cblog.Info("call GetRegionZone()")
 
+	defer startSpan("GetRegionZone", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -83,7 +90,9 @@ func GetRegionZone(connectionName string, nameID string) (*cres.RegionZoneInfo,
 		cblog.Error(err)
 		return nil, err
 	}
-	info, err := handler.GetRegionZone(nameID)
+	info, err := retryCall(connectionName, call.REGIONZONE, "GetRegionZone()", func() (cres.RegionZoneInfo, error) {
+		return handler.GetRegionZone(nameID)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -100,6 +109,8 @@ func GetRegionZone(connectionName string, nameID string) (*cres.RegionZoneInfo,
 func ListOrgRegion(connectionName string) (string, error) {
 	cblog.Info("call ListOrgRegion()")
 
+	defer startSpan("ListOrgRegion", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -119,7 +130,9 @@ func ListOrgRegion(connectionName string) (string, error) {
 		return "", err
 	}
 
-	infoList, err := handler.ListOrgRegion()
+	infoList, err := retryCall(connectionName, call.REGIONZONE, "ListOrgRegion()", func() (string, error) {
+		return handler.ListOrgRegion()
+	})
 	if err != nil {
 		cblog.Error(err)
 		return "", err
@@ -131,6 +144,8 @@ func ListOrgRegion(connectionName string) (string, error) {
 func ListOrgZone(connectionName string) (string, error) {
 	cblog.Info("call GetOrgRegionZone()")
 
+	defer startSpan("ListOrgZone", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -149,7 +164,9 @@ func ListOrgZone(connectionName string) (string, error) {
 		cblog.Error(err)
 		return "", err
 	}
-	info, err := handler.ListOrgZone()
+	info, err := retryCall(connectionName, call.REGIONZONE, "ListOrgZone()", func() (string, error) {
+		return handler.ListOrgZone()
+	})
 	if err != nil {
 		cblog.Error(err)
 		return "", err
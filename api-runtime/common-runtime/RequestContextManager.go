@@ -0,0 +1,212 @@
+// Request Context(Request ID) Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/rs/xid"
+	"github.com/sirupsen/logrus"
+
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+)
+
+//================ Request Context(Request ID, Principal, Trace Context)
+// The common-runtime and the drivers have no context parameter,
+// so the context of a request is bound to the goroutine serving the request by the API runtimes.
+// The goroutines started for a request should bind the context of the request again, ex)
+//
+//	reqCtx := getRequestContext()
+//	go func() {
+//		defer bindRequestContext(reqCtx)()
+//		...
+//	}()
+
+const (
+	REQUEST_ID_HEADER     = "X-Request-ID" // REST header
+	REQUEST_ID_METADATA   = "x-request-id" // gRPC metadata
+	REQUEST_ID_LOG_FIELD  = "RequestID"
+	REQUEST_ID_MAX_LENGTH = 128
+
+	ANONYMOUS_PRINCIPAL = "anonymous"
+)
+
+type requestIDKey struct{}
+
+type principalKey struct{}
+
+var (
+	requestContextLock  sync.RWMutex
+	requestContextMap   = map[uint64]context.Context{} // goroutine ID => context of the request
+	requestContextCount int64                          // number of the bound contexts, 0: skip the lookup
+)
+
+func init() {
+	// add the Request ID to each log line of the server logger.
+	// The hook should be the first one to be applied to the log file hook.
+	hooks := make(logrus.LevelHooks)
+	hooks.Add(requestIDLogHook{})
+	for level, hookList := range cblog.Hooks {
+		hooks[level] = append(hooks[level], hookList...)
+	}
+	cblog.ReplaceHooks(hooks)
+
+	call.SetRequestIDFunc(GetRequestID)
+}
+
+// NewRequestID returns a new Request ID, ex) cs3m8rqkn7pc73d0q0ag
+func NewRequestID() string {
+	return xid.New().String()
+}
+
+// CheckRequestID checks a Request ID given by a client, ex) X-Request-ID header
+func CheckRequestID(requestID string) error {
+	if len(requestID) > REQUEST_ID_MAX_LENGTH {
+		return fmt.Errorf("The Request ID is too long(max: %d)!", REQUEST_ID_MAX_LENGTH)
+	}
+	for _, ch := range requestID {
+		if ch < 0x21 || ch > 0x7e {
+			return fmt.Errorf("The Request ID '%s' has a not printable ASCII character!", requestID)
+		}
+	}
+	return nil
+}
+
+// WithRequestID returns a copy of the context with the Request ID.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the Request ID of the context, or "".
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// principalHolder keeps the principal of a request. It is shared with the copies of the context,
+// so the principal set by an inner middleware(authentication) is visible to the outer ones(audit).
+type principalHolder struct {
+	principal atomic.Value
+}
+
+// WithPrincipal sets the authenticated principal of the request, ex) user name.
+// It returns the context itself if the context has a principal already, or a copy with the principal.
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	if holder, ok := ctx.Value(principalKey{}).(*principalHolder); ok {
+		holder.principal.Store(principal)
+		return ctx
+	}
+	holder := &principalHolder{}
+	holder.principal.Store(principal)
+	return context.WithValue(ctx, principalKey{}, holder)
+}
+
+// PrincipalFromContext returns the principal of the context, or "anonymous".
+func PrincipalFromContext(ctx context.Context) string {
+	if holder, ok := ctx.Value(principalKey{}).(*principalHolder); ok {
+		if principal, _ := holder.principal.Load().(string); principal != "" {
+			return principal
+		}
+	}
+	return ANONYMOUS_PRINCIPAL
+}
+
+// GetRequestID returns the Request ID bound to the current goroutine, or "".
+func GetRequestID() string {
+	return RequestIDFromContext(getRequestContext())
+}
+
+// BindRequestContext binds the context of a request to the current goroutine.
+//
+//	ex) unbind := cmrt.BindRequestContext(ctx)
+//	    defer unbind()
+func BindRequestContext(ctx context.Context) (unbind func()) {
+	return bindRequestContext(ctx)
+}
+
+func bindRequestContext(ctx context.Context) func() {
+	gid := goroutineID()
+	parent := setRequestContext(gid, ctx)
+	return func() { setRequestContext(gid, parent) }
+}
+
+func getRequestContext() context.Context {
+	if atomic.LoadInt64(&requestContextCount) == 0 {
+		return context.Background()
+	}
+	return getRequestContextOf(goroutineID())
+}
+
+func getRequestContextOf(gid uint64) context.Context {
+	requestContextLock.RLock()
+	defer requestContextLock.RUnlock()
+
+	if ctx, ok := requestContextMap[gid]; ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+// setRequestContext sets the context of the goroutine and returns the previous one.
+func setRequestContext(gid uint64, ctx context.Context) context.Context {
+	requestContextLock.Lock()
+	defer requestContextLock.Unlock()
+
+	prev, ok := requestContextMap[gid]
+	if !ok {
+		prev = context.Background()
+	}
+	if ctx == nil || ctx == context.Background() {
+		delete(requestContextMap, gid)
+	} else {
+		requestContextMap[gid] = ctx
+	}
+	atomic.StoreInt64(&requestContextCount, int64(len(requestContextMap)))
+	return prev
+}
+
+// goroutineID returns the ID of the current goroutine, ex) "goroutine 123 [running]:" => 123
+func goroutineID() uint64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	fields := bytes.Fields(buf[:n])
+	if len(fields) < 2 {
+		return 0
+	}
+	id, _ := strconv.ParseUint(string(fields[1]), 10, 64)
+	return id
+}
+
+// requestIDLogHook adds the Request ID to the log fields.
+// The Request ID of the entry's context is used first, ex) cblog.WithContext(ctx).Info("..."),
+// and the Request ID of the current goroutine is looked up only while some requests are bound.
+type requestIDLogHook struct{}
+
+func (requestIDLogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (requestIDLogHook) Fire(entry *logrus.Entry) error {
+	if entry.Context != nil {
+		if requestID := RequestIDFromContext(entry.Context); requestID != "" {
+			entry.Data[REQUEST_ID_LOG_FIELD] = requestID
+		}
+		return nil
+	}
+	if requestID := GetRequestID(); requestID != "" {
+		entry.Data[REQUEST_ID_LOG_FIELD] = requestID
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+// Retry Policy Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+)
+
+//================ Retry Policy for transient driver failures
+// The read-only and idempotent driver calls are retried with the exponential backoff and jitter
+// when the error is one of the retryable kinds.
+//
+// env)
+//	SPIDER_RETRY_MAX_ATTEMPTS : max attempts including the first call, 1: no retry (default: 3)
+//	SPIDER_RETRY_BASE_DELAY   : delay before the first retry (default: 1s)
+//	SPIDER_RETRY_MAX_DELAY    : max delay between the retries (default: 20s)
+//	SPIDER_RETRY_ERROR_KINDS  : retryable error kinds, throttling,server,timeout,network (default: throttling,server,timeout)
+
+// kinds of the retryable errors
+const (
+	RETRY_THROTTLING = "throttling" // ex) 429, Throttling, RequestLimitExceeded
+	RETRY_SERVER     = "server"     // ex) 500, 502, 503, 504
+	RETRY_TIMEOUT    = "timeout"    // ex) i/o timeout, context deadline exceeded
+	RETRY_NETWORK    = "network"    // ex) connection reset, connection refused
+)
+
+const (
+	DEFAULT_RETRY_MAX_ATTEMPTS = 3
+	DEFAULT_RETRY_BASE_DELAY   = 1 * time.Second
+	DEFAULT_RETRY_MAX_DELAY    = 20 * time.Second
+)
+
+var defaultRetryErrorKindList = []string{RETRY_THROTTLING, RETRY_SERVER, RETRY_TIMEOUT}
+
+// error message patterns of each kind, compared after removing spaces and lowering
+var retryErrorPatternMap = map[string][]string{
+	RETRY_THROTTLING: {"throttl", "ratelimit", "rateexceeded", "toomanyrequests", "requestlimitexceeded",
+		"statuscode:429", "statuscode=429", "error429", "slowdown"},
+	RETRY_SERVER: {"statuscode:500", "statuscode=500", "error500", "internalservererror", "internalerror",
+		"statuscode:502", "statuscode=502", "error502", "badgateway",
+		"statuscode:503", "statuscode=503", "error503", "serviceunavailable",
+		"statuscode:504", "statuscode=504", "error504", "gatewaytimeout"},
+	RETRY_TIMEOUT: {"timeout", "timedout", "deadlineexceeded"},
+	RETRY_NETWORK: {"connectionreset", "connectionrefused", "brokenpipe", "unexpectedeof", "nosuchhost", "connectionclosed"},
+}
+
+// terminal error patterns, not retried even if matched with a retryable kind
+// ex) "Quota exceeded for quota metric 'Queries'... rateLimitExceeded": not resolved until the quota is increased
+var terminalErrorPatternList = []string{"quotaexceeded", "insufficientquota"}
+
+type RetryPolicyInfo struct {
+	MaxAttempts   int
+	BaseDelay     time.Duration
+	MaxDelay      time.Duration
+	ErrorKindList []string
+}
+
+var (
+	retryPolicy     RetryPolicyInfo
+	retryPolicyOnce sync.Once
+)
+
+// GetRetryPolicy returns the retry policy loaded from the env at the first call.
+func GetRetryPolicy() RetryPolicyInfo {
+	retryPolicyOnce.Do(func() {
+		retryPolicy = loadRetryPolicy()
+		cblog.Infof("retry policy: %+v", retryPolicy)
+	})
+	return retryPolicy
+}
+
+func loadRetryPolicy() RetryPolicyInfo {
+	policy := RetryPolicyInfo{
+		MaxAttempts:   DEFAULT_RETRY_MAX_ATTEMPTS,
+		BaseDelay:     DEFAULT_RETRY_BASE_DELAY,
+		MaxDelay:      DEFAULT_RETRY_MAX_DELAY,
+		ErrorKindList: defaultRetryErrorKindList,
+	}
+
+	if value := os.Getenv("SPIDER_RETRY_MAX_ATTEMPTS"); value != "" {
+		attempts, err := strconv.Atoi(value)
+		if err != nil || attempts < 1 {
+			cblog.Errorf("invalid SPIDER_RETRY_MAX_ATTEMPTS(%s), use the default %d", value, DEFAULT_RETRY_MAX_ATTEMPTS)
+		} else {
+			policy.MaxAttempts = attempts
+		}
+	}
+	if value := os.Getenv("SPIDER_RETRY_BASE_DELAY"); value != "" {
+		delay, err := time.ParseDuration(value)
+		if err != nil || delay < 0 {
+			cblog.Errorf("invalid SPIDER_RETRY_BASE_DELAY(%s), use the default %v", value, DEFAULT_RETRY_BASE_DELAY)
+		} else {
+			policy.BaseDelay = delay
+		}
+	}
+	if value := os.Getenv("SPIDER_RETRY_MAX_DELAY"); value != "" {
+		delay, err := time.ParseDuration(value)
+		if err != nil || delay < 0 {
+			cblog.Errorf("invalid SPIDER_RETRY_MAX_DELAY(%s), use the default %v", value, DEFAULT_RETRY_MAX_DELAY)
+		} else {
+			policy.MaxDelay = delay
+		}
+	}
+	if value := os.Getenv("SPIDER_RETRY_ERROR_KINDS"); value != "" {
+		kindList := []string{}
+		for _, kind := range strings.Split(value, ",") {
+			kind = strings.ToLower(strings.TrimSpace(kind))
+			if _, ok := retryErrorPatternMap[kind]; !ok {
+				cblog.Errorf("invalid kind '%s' of SPIDER_RETRY_ERROR_KINDS(%s), ignored", kind, value)
+				continue
+			}
+			kindList = append(kindList, kind)
+		}
+		policy.ErrorKindList = kindList
+	}
+
+	return policy
+}
+
+// backoff returns the delay before the given retry(1, 2, ...): exponential backoff with the equal jitter
+func (policy RetryPolicyInfo) backoff(retry int) time.Duration {
+	delay := policy.BaseDelay
+	for i := 1; i < retry && delay < policy.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// getRetryErrorKind returns the kind of a retryable error, or "" if not retryable
+func (policy RetryPolicyInfo) getRetryErrorKind(err error) string {
+	msg := strings.ToLower(strings.ReplaceAll(err.Error(), " ", ""))
+	for _, pattern := range terminalErrorPatternList {
+		if strings.Contains(msg, pattern) {
+			return ""
+		}
+	}
+	for _, kind := range policy.ErrorKindList {
+		for _, pattern := range retryErrorPatternMap[kind] {
+			if strings.Contains(msg, pattern) {
+				return kind
+			}
+		}
+	}
+	return ""
+}
+
+// retryCall calls a read-only or idempotent driver API through the call guard with the retry policy.
+// Each retry is logged in the call-log with the attempt number.
+//
+//	ex) info, err := retryCall(connectionName, call.VM, "GetVM()", func() (cres.VMInfo, error) {
+//			return handler.GetVM(driverIID)
+//		})
+func retryCall[T any](connectionName string, resType call.RES_TYPE, apiName string, fn func() (T, error)) (T, error) {
+	policy := GetRetryPolicy()
+
+	result, err := guardCall(connectionName, fn)
+	for attempt := 2; err != nil && attempt <= policy.MaxAttempts; attempt++ {
+		if errors.Is(err, ErrCircuitBreakerOpen) {
+			break
+		}
+		kind := policy.getRetryErrorKind(err)
+		if kind == "" {
+			break
+		}
+
+		delay := policy.backoff(attempt - 1)
+		cblog.Infof("retry %s after %v (attempt %d/%d, %s error): %v", apiName, delay, attempt, policy.MaxAttempts, kind, err)
+		logRetryCall(connectionName, resType, apiName, attempt, policy.MaxAttempts, err)
+		time.Sleep(delay)
+
+		result, err = guardCall(connectionName, fn)
+	}
+
+	return result, err
+}
+
+func logRetryCall(connectionName string, resType call.RES_TYPE, apiName string, attempt int, maxAttempts int, cause error) {
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+	}
+	regionName, zoneName, err := ccm.GetRegionNameByConnectionName(connectionName)
+	if err != nil {
+		cblog.Error(err)
+	}
+
+	callInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.CLOUD_OS(providerName),
+		RegionZone:   regionName + "/" + zoneName,
+		ResourceType: resType,
+		ResourceName: "",
+		CloudOSAPI:   fmt.Sprintf(SPIDER_CALL_LOG_PREFIX+"%s:retry(attempt %d/%d)", apiName, attempt, maxAttempts),
+		ElapsedTime:  "",
+		ErrorMSG:     cause.Error(),
+	}
+	call.Observe(callInfo)
+	callogger.Info(call.String(callInfo))
+}
@@ -0,0 +1,73 @@
+// Saga(Compensation) Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"fmt"
+	"strings"
+)
+
+//================ Compensation(Saga) of Composite Create Operations
+// A composite create operation records each completed step(CSP resource, IID info)
+// with its compensation, and undoes the completed steps in reverse order when a later step fails.
+//
+// usage)
+//	sg := newSaga("CreateVPC", reqIId.NameId)
+//	info, err := handler.CreateVPC(reqInfo)
+//	if err != nil { return nil, err }
+//	sg.done("VPC-CSP", info.IId.SystemId, func() error { _, err := handler.DeleteVPC(info.IId); return err })
+//	...
+//	if err != nil { return nil, sg.rollback(err) }
+
+type sagaStep struct {
+	name   string // ex) VPC-CSP, VPC-IID, SUBNET-CSP
+	target string // ex) vpc-01, vpc-0a1b2c3d
+	undo   func() error
+}
+
+type saga struct {
+	operation string // ex) CreateVPC
+	target    string // ex) vpc-01
+	stepList  []sagaStep
+}
+
+func newSaga(operation string, target string) *saga {
+	return &saga{operation: operation, target: target}
+}
+
+// done records a completed step with the compensation to undo it.
+func (s *saga) done(name string, target string, undo func() error) {
+	s.stepList = append(s.stepList, sagaStep{name: name, target: target, undo: undo})
+}
+
+// rollback undoes all completed steps in reverse order, even if some of them fail,
+// and returns the cause with the result of each compensation.
+// ex) "create failed (rollback of CreateVPC 'vpc-01': SUBNET-IID(subnet-01):OK, VPC-CSP(vpc-0a1b):FAILED(timeout))"
+func (s *saga) rollback(cause error) error {
+	if len(s.stepList) == 0 {
+		return cause
+	}
+
+	resultList := []string{}
+	for idx := len(s.stepList) - 1; idx >= 0; idx-- {
+		step := s.stepList[idx]
+		cblog.Info("<<ROLLBACK:TRY:" + step.name + ">> " + step.target)
+		if err := step.undo(); err != nil {
+			cblog.Error(err)
+			resultList = append(resultList, fmt.Sprintf("%s(%s):FAILED(%v)", step.name, step.target, err))
+			continue
+		}
+		resultList = append(resultList, fmt.Sprintf("%s(%s):OK", step.name, step.target))
+	}
+	s.stepList = nil
+
+	err := fmt.Errorf("%w (rollback of %s '%s': %s)", cause, s.operation, s.target, strings.Join(resultList, ", "))
+	cblog.Error(err)
+	return err
+}
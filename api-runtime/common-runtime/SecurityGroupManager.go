@@ -13,6 +13,8 @@ import (
 	"strings"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
@@ -44,6 +46,8 @@ func init() {
 func GetSGOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err error) {
 	cblog.Info("call GetSGOwnerVPC()")
 
+	defer startSpan("GetSGOwnerVPC", connectionName, cspID).end()
+
 	// check empty and trim user inputs
 	connectionName, err = EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -104,7 +108,9 @@ func GetSGOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err e
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetSecurity(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	getInfo, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+		return handler.GetSecurity(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	})
 	if err != nil {
 		//vpcSPLock.RUnlock()
 		//sgSPLock.RUnlock()
@@ -152,6 +158,8 @@ func GetSGOwnerVPC(connectionName string, cspID string) (owerVPC cres.IID, err e
 func RegisterSecurity(connectionName string, vpcUserID string, userIID cres.IID) (*cres.SecurityInfo, error) {
 	cblog.Info("call RegisterSecurity()")
 
+	defer startSpan("RegisterSecurity", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -219,7 +227,9 @@ func RegisterSecurity(connectionName string, vpcUserID string, userIID cres.IID)
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetSecurity(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+		return handler.GetSecurity(cres.IID{NameId: getMSShortID(userIID.SystemId), SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -247,6 +257,13 @@ func RegisterSecurity(connectionName string, vpcUserID string, userIID cres.IID)
 	// set up SecurityGroup User IID for return info
 	getInfo.IId = userIID
 
+	// set up peer SecurityGroup User IIDs of rules
+	err = setPeerSGUserIIDByConnection(connectionName, getInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	// set up VPC UserIID for return info
 	var iidInfo VPCIIDInfo
 	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, vpcUserID)
@@ -272,6 +289,8 @@ func RegisterSecurity(connectionName string, vpcUserID string, userIID cres.IID)
 func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityReqInfo, IDTransformMode string) (*cres.SecurityInfo, error) {
 	cblog.Info("call CreateSecurity()")
 
+	defer startSpan("CreateSecurity", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -293,6 +312,20 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 	   }
 	*/
 
+	// apply the default tag policy of the connection
+	reqInfo.TagList, err = applyTagPolicy(connectionName, rsType, reqInfo.IId.NameId, reqInfo.TagList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check the admission policy before calling the driver
+	err = admitSecurity(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	vpcSPLock.Lock(connectionName, reqInfo.VpcIID.NameId)
 	defer vpcSPLock.Unlock(connectionName, reqInfo.VpcIID.NameId)
 
@@ -324,6 +357,19 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(reqInfo.SecurityRules)
 
+	err = validateRuleArgs(connectionName, reqInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// peer SG: Spider's NameId => driver's IID
+	err = setPeerSGDriverIID(connectionName, reqInfo.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	sgSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer sgSPLock.Unlock(connectionName, reqInfo.IId.NameId)
 	// (1) check exist(NameID)
@@ -412,6 +458,9 @@ func CreateSecurity(connectionName string, rsType string, reqInfo cres.SecurityR
 	// set VPC SystemId
 	info.VpcIID.SystemId = getDriverSystemId(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
 
+	// set peer SG UserIIDs
+	setPeerSGUserIID(iidInfoList, info.SecurityRules)
+
 	return &info, nil
 }
 
@@ -421,19 +470,324 @@ func transformArgs(ruleList *[]cres.SecurityRuleInfo) {
 		(*ruleList)[n].Direction = strings.ToLower((*ruleList)[n].Direction)
 		// IPProtocol: to upper => ALL | TCP | UDP | ICMP
 		(*ruleList)[n].IPProtocol = strings.ToUpper((*ruleList)[n].IPProtocol)
-		// no CIDR, set default ("0.0.0.0/0")
-		if (*ruleList)[n].CIDR == "" {
+		// no CIDR and no peer SG, set default ("0.0.0.0/0")
+		if (*ruleList)[n].CIDR == "" && (*ruleList)[n].PeerSecurityGroupIID == nil {
 			(*ruleList)[n].CIDR = "0.0.0.0/0"
 		}
 	}
 }
 
+// check the driver capability and set the driver's IID of peer SGs
+// peer SG: {NameId: Spider's NameId} => {NameId: SP-XID, SystemId: CSP's ID}
+func setPeerSGDriverIID(connectionName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+
+	err := checkPeerSGRules(connectionName, *ruleList)
+	if err != nil {
+		return err
+	}
+
+	for n, rule := range *ruleList {
+		if rule.PeerSecurityGroupIID == nil {
+			continue
+		}
+		peerName := strings.TrimSpace(rule.PeerSecurityGroupIID.NameId)
+
+		var iidInfo SGIIDInfo
+		err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, peerName)
+		if err != nil {
+			return fmt.Errorf("The peer %s '%s' does not exist!", RSTypeString(SG), peerName)
+		}
+		driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+		(*ruleList)[n].PeerSecurityGroupIID = &driverIId
+	}
+	return nil
+}
+
+// checkPeerSGRules checks the driver of the connection supports the peer SG rules.
+func checkPeerSGRules(connectionName string, ruleList []cres.SecurityRuleInfo) error {
+	hasPeerSG := false
+	for _, rule := range ruleList {
+		if rule.PeerSecurityGroupIID != nil {
+			hasPeerSG = true
+			break
+		}
+	}
+	if !hasPeerSG {
+		return nil
+	}
+
+	drv, err := ccm.GetCloudDriver(connectionName)
+	if err != nil {
+		return err
+	}
+	return checkPeerSGRulesByCapability(connectionName, drv.GetDriverCapability(), ruleList)
+}
+
+func checkPeerSGRulesByCapability(connectionName string, drvCapability idrv.DriverCapabilityInfo, ruleList []cres.SecurityRuleInfo) error {
+	for _, rule := range ruleList {
+		if rule.PeerSecurityGroupIID == nil {
+			continue
+		}
+		if !drvCapability.SG_PEER_SECURITY_GROUP {
+			return fmt.Errorf("The Cloud Driver of '%s' does not support a %s rule with a peer %s!", connectionName, RSTypeString(SG), RSTypeString(SG))
+		}
+		if rule.CIDR != "" {
+			return fmt.Errorf("A %s rule can have only one of CIDR(%s) and peer %s(%s)!", RSTypeString(SG), rule.CIDR,
+				RSTypeString(SG), rule.PeerSecurityGroupIID.NameId)
+		}
+		if _, err := EmptyCheckAndTrim("PeerSecurityGroupIID.NameId", rule.PeerSecurityGroupIID.NameId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check the arguments of rules, which can not be checked by the driver
+func validateRuleArgs(connectionName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+
+	checkedIPv6 := false
+	for _, rule := range *ruleList {
+		if strings.Contains(rule.CIDR, ":") { // IPv6 CIDR
+			err := validateIPv6CIDR("rule", rule.CIDR)
+			if err != nil {
+				return err
+			}
+			if !checkedIPv6 {
+				err = checkIPv6Capability(connectionName)
+				if err != nil {
+					return err
+				}
+				checkedIPv6 = true
+			}
+		}
+		if (rule.ICMPType != "" || rule.ICMPCode != "") && rule.IPProtocol != "ICMP" {
+			return fmt.Errorf("ICMPType(%s) and ICMPCode(%s) can be used only with the ICMP protocol, not with %s!",
+				rule.ICMPType, rule.ICMPCode, rule.IPProtocol)
+		}
+		if rule.ICMPType == "" && rule.ICMPCode != "" {
+			return fmt.Errorf("ICMPCode(%s) can not be used without ICMPType!", rule.ICMPCode)
+		}
+	}
+	return nil
+}
+
+// check the identity of two rules, including Description
+// ex) the rule changing only the description is replaced
+func isSameRule(a cres.SecurityRuleInfo, b cres.SecurityRuleInfo) bool {
+	return isSameRuleExceptDescription(a, b) && a.Description == b.Description
+}
+
+// the CSPs identify a rule without the description, ex) AWS
+func isSameRuleExceptDescription(a cres.SecurityRuleInfo, b cres.SecurityRuleInfo) bool {
+	if a.Direction != b.Direction || a.IPProtocol != b.IPProtocol || a.CIDR != b.CIDR {
+		return false
+	}
+	if strings.EqualFold(a.IPProtocol, "ICMP") {
+		// ICMP is identified with the type and the code, not the ports
+		if getICMPValue(a.ICMPType) != getICMPValue(b.ICMPType) || getICMPValue(a.ICMPCode) != getICMPValue(b.ICMPCode) {
+			return false
+		}
+	} else if a.FromPort != b.FromPort || a.ToPort != b.ToPort {
+		return false
+	}
+	if (a.PeerSecurityGroupIID == nil) != (b.PeerSecurityGroupIID == nil) {
+		return false
+	}
+	if a.PeerSecurityGroupIID != nil &&
+		getMSShortID(a.PeerSecurityGroupIID.SystemId) != getMSShortID(b.PeerSecurityGroupIID.SystemId) {
+		return false
+	}
+	return true
+}
+
+// "" and "-1" mean all types or codes
+func getICMPValue(value string) string {
+	if value == "-1" {
+		return ""
+	}
+	return value
+}
+
+func hasSameRule(ruleList []cres.SecurityRuleInfo, rule cres.SecurityRuleInfo) bool {
+	for _, one := range ruleList {
+		if isSameRule(one, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasSameRuleExceptDescription(ruleList []cres.SecurityRuleInfo, rule cres.SecurityRuleInfo) bool {
+	for _, one := range ruleList {
+		if isSameRuleExceptDescription(one, rule) {
+			return true
+		}
+	}
+	return false
+}
+
+// diffRules returns the rules to add, to remove and unchanged to make the current rules the desired rules.
+func diffRules(curRuleInfoList []cres.SecurityRuleInfo, desiredRuleInfoList []cres.SecurityRuleInfo) (addList, removeList, unchangedList []cres.SecurityRuleInfo) {
+	addList = []cres.SecurityRuleInfo{}
+	removeList = []cres.SecurityRuleInfo{}
+	unchangedList = []cres.SecurityRuleInfo{}
+
+	for _, rule := range desiredRuleInfoList {
+		if hasSameRule(unchangedList, rule) || hasSameRule(addList, rule) {
+			continue // duplicated rule in the request
+		}
+		if hasSameRule(curRuleInfoList, rule) {
+			unchangedList = append(unchangedList, rule)
+		} else {
+			addList = append(addList, rule)
+		}
+	}
+	for _, rule := range curRuleInfoList {
+		if !hasSameRule(desiredRuleInfoList, rule) {
+			removeList = append(removeList, rule)
+		}
+	}
+	return addList, removeList, unchangedList
+}
+
+// replaceRules makes the current rules of a SG the desired rules.
+// The new rules are added before removing the old rules to keep the allowed traffic,
+// except the rules changing only the description, which are added after removing the old ones.
+// When a step fails, the rules are rolled back to the current rules,
+// and the error reports the partial state when the rollback fails.
+func replaceRules(connectionName string, handler cres.SecurityHandler, driverIId cres.IID, curRuleInfoList []cres.SecurityRuleInfo,
+	desiredRuleInfoList []cres.SecurityRuleInfo) (addList, removeList, unchangedList []cres.SecurityRuleInfo, err error) {
+
+	addList, removeList, unchangedList = diffRules(curRuleInfoList, desiredRuleInfoList)
+
+	addFirstList := []cres.SecurityRuleInfo{}
+	addLastList := []cres.SecurityRuleInfo{}
+	for _, rule := range addList {
+		if hasSameRuleExceptDescription(removeList, rule) {
+			addLastList = append(addLastList, rule)
+		} else {
+			addFirstList = append(addFirstList, rule)
+		}
+	}
+
+	// (1) add new Rules, (2) remove old Rules, (3) add Rules of the changed descriptions
+	if len(addFirstList) > 0 {
+		if _, err = guardCall(connectionName, func() (cres.SecurityInfo, error) {
+			return handler.AddRules(driverIId, &addFirstList)
+		}); err != nil {
+			return nil, nil, nil, rollbackRules(connectionName, handler, driverIId, curRuleInfoList, err)
+		}
+	}
+	if len(removeList) > 0 {
+		if _, err = guardCall(connectionName, func() (bool, error) {
+			return handler.RemoveRules(driverIId, &removeList)
+		}); err != nil {
+			return nil, nil, nil, rollbackRules(connectionName, handler, driverIId, curRuleInfoList, err)
+		}
+	}
+	if len(addLastList) > 0 {
+		if _, err = guardCall(connectionName, func() (cres.SecurityInfo, error) {
+			return handler.AddRules(driverIId, &addLastList)
+		}); err != nil {
+			return nil, nil, nil, rollbackRules(connectionName, handler, driverIId, curRuleInfoList, err)
+		}
+	}
+	return addList, removeList, unchangedList, nil
+}
+
+// rollbackRules restores the rules of a SG to the original rules after a failed replacement,
+// the rules may be partially added or removed by the failed driver call.
+func rollbackRules(connectionName string, handler cres.SecurityHandler, driverIId cres.IID, orgRuleInfoList []cres.SecurityRuleInfo, cause error) error {
+	rollbackErr := func() error {
+		info, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+			return handler.GetSecurity(driverIId)
+		})
+		if err != nil {
+			return err
+		}
+		curRuleInfoList := []cres.SecurityRuleInfo{}
+		if info.SecurityRules != nil {
+			curRuleInfoList = *info.SecurityRules
+		}
+		transformArgs(&curRuleInfoList)
+
+		// the original rules are the desired rules of the rollback
+		addList, removeList, _ := diffRules(curRuleInfoList, orgRuleInfoList)
+		if len(removeList) > 0 {
+			if _, err := guardCall(connectionName, func() (bool, error) {
+				return handler.RemoveRules(driverIId, &removeList)
+			}); err != nil {
+				return err
+			}
+		}
+		if len(addList) > 0 {
+			if _, err := guardCall(connectionName, func() (cres.SecurityInfo, error) {
+				return handler.AddRules(driverIId, &addList)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}()
+
+	if rollbackErr != nil {
+		return fmt.Errorf("failed to replace the rules: %v, and failed to rollback, the rules may be partially replaced: %v", cause, rollbackErr)
+	}
+	return fmt.Errorf("failed to replace the rules and rolled back: %v", cause)
+}
+
+// set the user's IID of peer SGs with the SG IID list of a connection
+// peer SG: {SystemId: CSP's ID} => {NameId: Spider's NameId, SystemId: CSP's ID}
+func setPeerSGUserIID(iidInfoList []*SGIIDInfo, ruleList *[]cres.SecurityRuleInfo) {
+	if ruleList == nil {
+		return
+	}
+
+	for n, rule := range *ruleList {
+		if rule.PeerSecurityGroupIID == nil {
+			continue
+		}
+		peerCSPID := getMSShortID(rule.PeerSecurityGroupIID.SystemId)
+		userIId := cres.IID{NameId: "", SystemId: rule.PeerSecurityGroupIID.SystemId}
+		for _, iidInfo := range iidInfoList {
+			if getMSShortID(getDriverSystemId(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})) == peerCSPID {
+				userIId.NameId = iidInfo.NameId
+				break
+			}
+		}
+		(*ruleList)[n].PeerSecurityGroupIID = &userIId
+	}
+}
+
+// set the user's IID of peer SGs, this loads the SG IID list of the connection
+func setPeerSGUserIIDByConnection(connectionName string, ruleList *[]cres.SecurityRuleInfo) error {
+	if ruleList == nil {
+		return nil
+	}
+
+	var iidInfoList []*SGIIDInfo
+	err := infostore.ListByCondition(&iidInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		return err
+	}
+	setPeerSGUserIID(iidInfoList, ruleList)
+	return nil
+}
+
 // (1) get IID:list
 // (2) get SecurityInfo:list
 // (3) set userIID, and ...
 func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, error) {
 	cblog.Info("call ListSecurity()")
 
+	defer startSpan("ListSecurity", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -474,7 +828,9 @@ func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, e
 		sgSPLock.RLock(connectionName, iidInfo.NameId)
 
 		// get resource(SystemId)
-		info, err := handler.GetSecurity(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		info, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+			return handler.GetSecurity(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+		})
 		if err != nil {
 			sgSPLock.RUnlock(connectionName, iidInfo.NameId)
 			if checkNotFoundError(err) {
@@ -503,6 +859,9 @@ func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, e
 		}
 		info.VpcIID = getUserIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
 
+		// set peer SG UserIIDs
+		setPeerSGUserIID(iidInfoList, info.SecurityRules)
+
 		infoList2 = append(infoList2, &info)
 	}
 
@@ -515,6 +874,8 @@ func ListSecurity(connectionName string, rsType string) ([]*cres.SecurityInfo, e
 func GetSecurity(connectionName string, rsType string, nameID string) (*cres.SecurityInfo, error) {
 	cblog.Info("call GetSecurity()")
 
+	defer startSpan("GetSecurity", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -552,7 +913,9 @@ func GetSecurity(connectionName string, rsType string, nameID string) (*cres.Sec
 	}
 
 	// (2) get resource(SystemId)
-	info, err := handler.GetSecurity(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+		return handler.GetSecurity(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -575,6 +938,13 @@ func GetSecurity(connectionName string, rsType string, nameID string) (*cres.Sec
 	}
 	info.VpcIID = getUserIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
 
+	// set peer SG UserIIDs
+	err = setPeerSGUserIIDByConnection(connectionName, info.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	return &info, nil
 }
 
@@ -583,6 +953,8 @@ func GetSecurity(connectionName string, rsType string, nameID string) (*cres.Sec
 func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityRuleInfo) (*cres.SecurityInfo, error) {
 	cblog.Info("call AddRules()")
 
+	defer startSpan("AddRules", connectionName, sgName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -613,6 +985,26 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(&reqInfoList)
 
+	err = validateRuleArgs(connectionName, &reqInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check the admission policy before calling the driver
+	err = admitSecurityRules(connectionName, sgName, reqInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// peer SG: Spider's NameId => driver's IID
+	err = setPeerSGDriverIID(connectionName, &reqInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	sgSPLock.Lock(connectionName, sgName)
 	defer sgSPLock.Unlock(connectionName, sgName)
 
@@ -637,7 +1029,9 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 
 	// (2) add Rules
 	// driverIID for driver
-	info, err := handler.AddRules(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), &reqInfoList)
+	info, err := guardCall(connectionName, func() (cres.SecurityInfo, error) {
+		return handler.AddRules(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), &reqInfoList)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -659,6 +1053,13 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 	}
 	info.VpcIID = getUserIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
 
+	// set peer SG UserIIDs
+	err = setPeerSGUserIIDByConnection(connectionName, info.SecurityRules)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	return &info, nil
 }
 
@@ -667,6 +1068,8 @@ func AddRules(connectionName string, sgName string, reqInfoList []cres.SecurityR
 func RemoveRules(connectionName string, sgName string, reqRuleInfoList []cres.SecurityRuleInfo) (bool, error) {
 	cblog.Info("call RemoveRules()")
 
+	defer startSpan("RemoveRules", connectionName, sgName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -697,6 +1100,19 @@ func RemoveRules(connectionName string, sgName string, reqRuleInfoList []cres.Se
 	// no CIDR: "0.0.0.0/0"
 	transformArgs(&reqRuleInfoList)
 
+	err = validateRuleArgs(connectionName, &reqRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	// peer SG: Spider's NameId => driver's IID
+	err = setPeerSGDriverIID(connectionName, &reqRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
 	sgSPLock.Lock(connectionName, sgName)
 	defer sgSPLock.Unlock(connectionName, sgName)
 
@@ -730,12 +1146,179 @@ func RemoveRules(connectionName string, sgName string, reqRuleInfoList []cres.Se
 	return result, nil
 }
 
+// result of ReplaceRules
+type SecurityRulesReplaceInfo struct {
+	SecurityInfo   *cres.SecurityInfo
+	AddedRules     []cres.SecurityRuleInfo
+	RemovedRules   []cres.SecurityRuleInfo
+	UnchangedRules []cres.SecurityRuleInfo
+}
+
+// (1) check exist(NameID)
+// (2) get current Rules, and admit the Rules to add
+// (3) replace current Rules with desired Rules: add new Rules, remove old Rules,
+// add Rules of the changed descriptions, and rollback to current Rules when a step fails
+// (4) get the result SecurityInfo
+func ReplaceRules(connectionName string, sgName string, desiredRuleInfoList []cres.SecurityRuleInfo) (*SecurityRulesReplaceInfo, error) {
+	cblog.Info("call ReplaceRules()")
+
+	defer startSpan("ReplaceRules", connectionName, sgName).end()
+
+	// check empty and trim user inputs
+	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	sgName, err = EmptyCheckAndTrim("sgName", sgName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	cldConn, err := ccm.GetCloudConnection(connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	handler, err := cldConn.CreateSecurityHandler()
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// Direction: to lower
+	// IPProtocol: to upper
+	// no CIDR: "0.0.0.0/0"
+	transformArgs(&desiredRuleInfoList)
+
+	err = validateRuleArgs(connectionName, &desiredRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// peer SG: Spider's NameId => driver's IID
+	err = setPeerSGDriverIID(connectionName, &desiredRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	sgSPLock.Lock(connectionName, sgName)
+	defer sgSPLock.Unlock(connectionName, sgName)
+
+	// (1) check exist(sgName)
+	bool_ret, err := infostore.HasByConditions(&SGIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if !bool_ret {
+		err := fmt.Errorf("The %s '%s' does not exist!", RSTypeString(SG), sgName)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	var iidInfo SGIIDInfo
+	err = infostore.GetByConditions(&iidInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, sgName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	driverIId := getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// (2) get current Rules
+	curInfo, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+		return handler.GetSecurity(driverIId)
+	})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	curRuleInfoList := []cres.SecurityRuleInfo{}
+	if curInfo.SecurityRules != nil {
+		curRuleInfoList = *curInfo.SecurityRules
+	}
+	transformArgs(&curRuleInfoList)
+
+	// check the admission policy of the rules to add before calling the driver,
+	// with the Spider's NameId of the peer SGs like CreateSecurity and AddRules
+	addRuleInfoList, _, _ := diffRules(curRuleInfoList, desiredRuleInfoList)
+	admitRuleInfoList := append([]cres.SecurityRuleInfo{}, addRuleInfoList...)
+	err = setPeerSGUserIIDByConnection(connectionName, &admitRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	err = admitSecurityRules(connectionName, sgName, admitRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (3) replace current Rules with desired Rules
+	addRuleInfoList, removeRuleInfoList, unchangedRuleInfoList, err := replaceRules(connectionName, handler, driverIId, curRuleInfoList, desiredRuleInfoList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// (4) get the result SecurityInfo
+	info, err := retryCall(connectionName, call.SECURITYGROUP, "GetSecurity()", func() (cres.SecurityInfo, error) {
+		return handler.GetSecurity(driverIId)
+	})
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	// Direction: to lower
+	// IPProtocol: to upper
+	// no CIDR: "0.0.0.0/0"
+	transformArgs(info.SecurityRules)
+
+	// set ResourceInfo(userIID)
+	info.IId = getUserIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId})
+
+	// set VPC SystemId
+	var vpcIIDInfo VPCIIDInfo
+	err = infostore.GetByConditions(&vpcIIDInfo, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, iidInfo.OwnerVPCName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	info.VpcIID = getUserIID(cres.IID{NameId: vpcIIDInfo.NameId, SystemId: vpcIIDInfo.SystemId})
+
+	// set peer SG UserIIDs
+	var sgIIDInfoList []*SGIIDInfo
+	err = infostore.ListByCondition(&sgIIDInfoList, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	setPeerSGUserIID(sgIIDInfoList, info.SecurityRules)
+	setPeerSGUserIID(sgIIDInfoList, &addRuleInfoList)
+	setPeerSGUserIID(sgIIDInfoList, &removeRuleInfoList)
+	setPeerSGUserIID(sgIIDInfoList, &unchangedRuleInfoList)
+
+	return &SecurityRulesReplaceInfo{
+		SecurityInfo:   &info,
+		AddedRules:     addRuleInfoList,
+		RemovedRules:   removeRuleInfoList,
+		UnchangedRules: unchangedRuleInfoList,
+	}, nil
+}
+
 // (1) get spiderIID
 // (2) delete Resource(SystemId)
 // (3) delete IID
 func DeleteSecurity(connectionName string, rsType string, nameID string, force string) (bool, error) {
 	cblog.Info("call DeleteSecurity()")
 
+	defer startSpan("DeleteSecurity", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
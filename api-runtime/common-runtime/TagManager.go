@@ -9,16 +9,38 @@
 package commonruntime
 
 import (
+	"errors"
+	"fmt"
+
+	splock "github.com/cloud-barista/cb-spider/api-runtime/common-runtime/sp-lock"
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
 )
 
 //================ Tag Handler
 
+// The Tag API addresses a resource by its Spider NameId(resIID.NameId).
+// The NameId is translated into the driver IID with the IID table of the resource type.
+// If the NameId is empty, the resIID.SystemId is passed to the driver as a CSP ID without translation.
+
+// errNotSupportedTagRSType means the resource type has no IID table for Tag API.
+var errNotSupportedTagRSType = errors.New("not supported by the Tag API")
+
+// common view of the IID tables for Tag API
+type tagTargetIIDInfo struct {
+	NameId    string
+	SystemId  string // SP-XID:CSP-ID
+	OwnerName string // owner VPC name of Subnet, owner Cluster name of NodeGroup
+}
+
 // AddTag adds a tag to a resource.
 func AddTag(connectionName string, resType cres.RSType, resIID cres.IID, tag cres.KeyValue) (cres.KeyValue, error) {
 	cblog.Info("call AddTag()")
 
+	defer startSpan("AddTag", connectionName, resIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -38,13 +60,26 @@ func AddTag(connectionName string, resType cres.RSType, resIID cres.IID, tag cre
 		return cres.KeyValue{}, err
 	}
 
-	return handler.AddTag(resType, resIID, tag)
+	target, driverIID, err := getTagTargetDriverIID(connectionName, resType, resIID)
+	if err != nil {
+		cblog.Error(err)
+		return cres.KeyValue{}, err
+	}
+	if target != nil {
+		lock, lockId := getTagSPLock(resType, target)
+		lock.Lock(connectionName, lockId)
+		defer lock.Unlock(connectionName, lockId)
+	}
+
+	return handler.AddTag(resType, driverIID, tag)
 }
 
 // ListTag lists all tags of a resource.
 func ListTag(connectionName string, resType cres.RSType, resIID cres.IID) ([]cres.KeyValue, error) {
 	cblog.Info("call ListTag()")
 
+	defer startSpan("ListTag", connectionName, resIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -64,13 +99,28 @@ func ListTag(connectionName string, resType cres.RSType, resIID cres.IID) ([]cre
 		return nil, err
 	}
 
-	return handler.ListTag(resType, resIID)
+	target, driverIID, err := getTagTargetDriverIID(connectionName, resType, resIID)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+	if target != nil {
+		lock, lockId := getTagSPLock(resType, target)
+		lock.RLock(connectionName, lockId)
+		defer lock.RUnlock(connectionName, lockId)
+	}
+
+	return retryCall(connectionName, call.TAG, "ListTag()", func() ([]cres.KeyValue, error) {
+		return handler.ListTag(resType, driverIID)
+	})
 }
 
 // GetTag gets a specific tag of a resource.
 func GetTag(connectionName string, resType cres.RSType, resIID cres.IID, key string) (cres.KeyValue, error) {
 	cblog.Info("call GetTag()")
 
+	defer startSpan("GetTag", connectionName, resIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -90,13 +140,28 @@ func GetTag(connectionName string, resType cres.RSType, resIID cres.IID, key str
 		return cres.KeyValue{}, err
 	}
 
-	return handler.GetTag(resType, resIID, key)
+	target, driverIID, err := getTagTargetDriverIID(connectionName, resType, resIID)
+	if err != nil {
+		cblog.Error(err)
+		return cres.KeyValue{}, err
+	}
+	if target != nil {
+		lock, lockId := getTagSPLock(resType, target)
+		lock.RLock(connectionName, lockId)
+		defer lock.RUnlock(connectionName, lockId)
+	}
+
+	return retryCall(connectionName, call.TAG, "GetTag()", func() (cres.KeyValue, error) {
+		return handler.GetTag(resType, driverIID, key)
+	})
 }
 
 // RemoveTag removes a specific tag from a resource.
 func RemoveTag(connectionName string, resType cres.RSType, resIID cres.IID, key string) (bool, error) {
 	cblog.Info("call RemoveTag()")
 
+	defer startSpan("RemoveTag", connectionName, resIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -116,13 +181,27 @@ func RemoveTag(connectionName string, resType cres.RSType, resIID cres.IID, key
 		return false, err
 	}
 
-	return handler.RemoveTag(resType, resIID, key)
+	target, driverIID, err := getTagTargetDriverIID(connectionName, resType, resIID)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if target != nil {
+		lock, lockId := getTagSPLock(resType, target)
+		lock.Lock(connectionName, lockId)
+		defer lock.Unlock(connectionName, lockId)
+	}
+
+	return handler.RemoveTag(resType, driverIID, key)
 }
 
 // FindTag finds tags by key or value.
+// The IIDs of the results are mapped back to the Spider NameIds.
 func FindTag(connectionName string, resType cres.RSType, keyword string) ([]*cres.TagInfo, error) {
 	cblog.Info("call FindTag()")
 
+	defer startSpan("FindTag", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -142,5 +221,192 @@ func FindTag(connectionName string, resType cres.RSType, keyword string) ([]*cre
 		return nil, err
 	}
 
-	return handler.FindTag(resType, keyword)
+	tagInfoList, err := handler.FindTag(resType, keyword)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// map the CSP IDs back to the Spider NameIds
+	iidInfoListMap := map[cres.RSType][]*tagTargetIIDInfo{}
+	for _, tagInfo := range tagInfoList {
+		iidInfoList, ok := iidInfoListMap[tagInfo.ResType]
+		if !ok {
+			iidInfoList, err = listTagTargetIIDInfo(connectionName, tagInfo.ResType, "")
+			if errors.Is(err, errNotSupportedTagRSType) {
+				// ex) AWS returns the raw CSP resource types, keep the CSP IID of the tag
+				cblog.Debugf("skip mapping the IIDs of the tag results: %v", err)
+			} else if err != nil {
+				cblog.Error(err)
+				return nil, err
+			}
+			iidInfoListMap[tagInfo.ResType] = iidInfoList
+		}
+
+		for _, iidInfo := range iidInfoList {
+			if isSameCSPID(getDriverSystemId(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}), tagInfo.ResIId.SystemId) {
+				tagInfo.ResIId = cres.IID{NameId: iidInfo.NameId, SystemId: tagInfo.ResIId.SystemId}
+				break
+			}
+		}
+	}
+
+	return tagInfoList, nil
+}
+
+func isSameCSPID(a string, b string) bool {
+	return a == b || getMSShortID(a) == getMSShortID(b)
+}
+
+// getTagTargetDriverIID returns the IID info of the resource and the driver IID.
+// If resIID.NameId is empty, the target is nil and the resIID is returned as it is.
+func getTagTargetDriverIID(connectionName string, resType cres.RSType, resIID cres.IID) (*tagTargetIIDInfo, cres.IID, error) {
+	if resIID.NameId == "" {
+		if resIID.SystemId == "" {
+			return nil, cres.IID{}, fmt.Errorf("The %s IID is empty!", cres.RSTypeString(resType))
+		}
+		return nil, resIID, nil
+	}
+
+	iidInfoList, err := listTagTargetIIDInfo(connectionName, resType, resIID.NameId)
+	if err != nil {
+		return nil, cres.IID{}, err
+	}
+	if len(iidInfoList) == 0 {
+		return nil, cres.IID{}, fmt.Errorf("The %s '%s' does not exist!", cres.RSTypeString(resType), resIID.NameId)
+	}
+	if len(iidInfoList) > 1 {
+		return nil, cres.IID{}, fmt.Errorf("The %s '%s' is ambiguous, it exists in %d owners!", cres.RSTypeString(resType), resIID.NameId, len(iidInfoList))
+	}
+
+	target := iidInfoList[0]
+	return target, getDriverIID(cres.IID{NameId: target.NameId, SystemId: target.SystemId}), nil
+}
+
+// listTagTargetIIDInfo lists the IID infos of the resource type in the connection.
+// If nameId is not empty, only the infos with the nameId are listed.
+func listTagTargetIIDInfo(connectionName string, resType cres.RSType, nameId string) ([]*tagTargetIIDInfo, error) {
+	listByConditions := func(infoList interface{}) error {
+		if nameId == "" {
+			return infostore.ListByCondition(infoList, CONNECTION_NAME_COLUMN, connectionName)
+		}
+		return infostore.ListByConditions(infoList, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, nameId)
+	}
+
+	resultList := []*tagTargetIIDInfo{}
+	switch resType {
+	case cres.VPC:
+		var infoList []*VPCIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, ""})
+		}
+	case cres.SUBNET:
+		var infoList []*SubnetIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, info.OwnerVPCName})
+		}
+	case cres.SG:
+		var infoList []*SGIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, info.OwnerVPCName})
+		}
+	case cres.KEY:
+		var infoList []*KeyIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, ""})
+		}
+	case cres.VM:
+		var infoList []*VMIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, ""})
+		}
+	case cres.NLB:
+		var infoList []*NLBIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, info.OwnerVPCName})
+		}
+	case cres.DISK:
+		var infoList []*DiskIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, ""})
+		}
+	case cres.MYIMAGE:
+		var infoList []*MyImageIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, ""})
+		}
+	case cres.CLUSTER:
+		var infoList []*ClusterIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, info.OwnerVPCName})
+		}
+	case cres.NODEGROUP:
+		var infoList []*NodeGroupIIDInfo
+		if err := listByConditions(&infoList); err != nil {
+			return nil, err
+		}
+		for _, info := range infoList {
+			resultList = append(resultList, &tagTargetIIDInfo{info.NameId, info.SystemId, info.OwnerClusterName})
+		}
+	default:
+		return nil, fmt.Errorf("The resource type '%s' is %w!", resType, errNotSupportedTagRSType)
+	}
+
+	return resultList, nil
+}
+
+// getTagSPLock returns the SPLock and the lock id of the resource.
+// Subnet is locked by the owner VPC, NodeGroup is locked by the owner Cluster like other managers.
+func getTagSPLock(resType cres.RSType, target *tagTargetIIDInfo) (*splock.SPLOCK, string) {
+	switch resType {
+	case cres.VPC:
+		return vpcSPLock, target.NameId
+	case cres.SUBNET:
+		return vpcSPLock, target.OwnerName
+	case cres.SG:
+		return sgSPLock, target.NameId
+	case cres.KEY:
+		return keySPLock, target.NameId
+	case cres.VM:
+		return vmSPLock, target.NameId
+	case cres.NLB:
+		return nlbSPLock, target.NameId
+	case cres.DISK:
+		return diskSPLock, target.NameId
+	case cres.MYIMAGE:
+		return myImageSPLock, target.NameId
+	case cres.CLUSTER:
+		return clusterSPLock, target.NameId
+	case cres.NODEGROUP:
+		return clusterSPLock, target.OwnerName
+	}
+	// not reached, listTagTargetIIDInfo() rejects the unsupported types.
+	return vmSPLock, target.NameId
 }
@@ -0,0 +1,215 @@
+// Tag Policy Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"fmt"
+	"strings"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+// ====================================================================
+// type for GORM
+
+// connection name of the global tag policy, applied to all connections
+const GLOBAL_TAG_POLICY = "*"
+
+// TagPolicyInfo is the default tag set and the required tag keys of a connection.
+type TagPolicyInfo struct {
+	ConnectionName  string            `gorm:"primaryKey"` // "*": global policy
+	DefaultTagList  infostore.KVList  `gorm:"type:text"`  // stored with json format, ex) { {created-by, cb-spider}, ...}
+	RequiredKeyList infostore.StrList `gorm:"type:text"`  // stored with json format, ex) { owner, cost-center }
+}
+
+func (TagPolicyInfo) TableName() string {
+	return "tag_policy_infos"
+}
+
+//====================================================================
+
+func init() {
+	db, err := infostore.Open()
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	db.AutoMigrate(&TagPolicyInfo{})
+	infostore.Close(db)
+}
+
+//================ Tag Policy Handler
+
+// SetTagPolicy registers or replaces the tag policy of a connection.
+// An empty ConnectionName or "*" sets the global policy.
+func SetTagPolicy(reqInfo TagPolicyInfo) (*TagPolicyInfo, error) {
+	cblog.Info("call SetTagPolicy()")
+
+	reqInfo.ConnectionName = getTagPolicyName(reqInfo.ConnectionName)
+
+	for idx, tag := range reqInfo.DefaultTagList {
+		key, err := EmptyCheckAndTrim("DefaultTagList.Key", tag.Key)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		reqInfo.DefaultTagList[idx].Key = key
+	}
+	for idx, key := range reqInfo.RequiredKeyList {
+		key, err := EmptyCheckAndTrim("RequiredKeyList", key)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		reqInfo.RequiredKeyList[idx] = key
+	}
+
+	err := infostore.Insert(&reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &reqInfo, nil
+}
+
+func ListTagPolicy() ([]*TagPolicyInfo, error) {
+	cblog.Info("call ListTagPolicy()")
+
+	var policyList []*TagPolicyInfo
+	err := infostore.List(&policyList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return policyList, nil
+}
+
+func GetTagPolicy(connectionName string) (*TagPolicyInfo, error) {
+	cblog.Info("call GetTagPolicy()")
+
+	connectionName = getTagPolicyName(connectionName)
+
+	var policy TagPolicyInfo
+	err := infostore.Get(&policy, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func DeleteTagPolicy(connectionName string) (bool, error) {
+	cblog.Info("call DeleteTagPolicy()")
+
+	connectionName = getTagPolicyName(connectionName)
+
+	bool_ret, err := infostore.Has(&TagPolicyInfo{}, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+	if !bool_ret {
+		err := fmt.Errorf("The Tag Policy of '%s' does not exist!", connectionName)
+		cblog.Error(err)
+		return false, err
+	}
+
+	result, err := infostore.Delete(&TagPolicyInfo{}, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		cblog.Error(err)
+		return false, err
+	}
+
+	return result, nil
+}
+
+func getTagPolicyName(connectionName string) string {
+	connectionName = strings.TrimSpace(connectionName)
+	if connectionName == "" {
+		return GLOBAL_TAG_POLICY
+	}
+	return connectionName
+}
+
+// applyTagPolicy merges the default tags into the request tags and checks the required keys.
+// Precedence of the same key: request tag > connection default tag > global default tag.
+// The required keys are the union of the global and the connection policy,
+// and a required key must have a non-empty value.
+func applyTagPolicy(connectionName string, rsType string, nameId string, tagList []cres.KeyValue) ([]cres.KeyValue, error) {
+	var globalPolicy, connPolicy TagPolicyInfo
+	hasGlobal, err := getTagPolicyIfExists(GLOBAL_TAG_POLICY, &globalPolicy)
+	if err != nil {
+		return nil, err
+	}
+	hasConn, err := getTagPolicyIfExists(connectionName, &connPolicy)
+	if err != nil {
+		return nil, err
+	}
+	if !hasGlobal && !hasConn {
+		return tagList, nil
+	}
+
+	mergedList := []cres.KeyValue{}
+	keyIdxMap := map[string]int{}
+	merge := func(list []cres.KeyValue) {
+		for _, tag := range list {
+			if idx, ok := keyIdxMap[tag.Key]; ok {
+				mergedList[idx] = tag
+				continue
+			}
+			keyIdxMap[tag.Key] = len(mergedList)
+			mergedList = append(mergedList, tag)
+		}
+	}
+	merge(globalPolicy.DefaultTagList)
+	merge(connPolicy.DefaultTagList)
+	merge(tagList)
+
+	missingKeyList := []string{}
+	for _, key := range append(globalPolicy.RequiredKeyList, connPolicy.RequiredKeyList...) {
+		idx, ok := keyIdxMap[key]
+		if ok && strings.TrimSpace(mergedList[idx].Value) != "" {
+			continue
+		}
+		if !containsString(missingKeyList, key) {
+			missingKeyList = append(missingKeyList, key)
+		}
+	}
+	if len(missingKeyList) > 0 {
+		return nil, fmt.Errorf("The required tag key(s) [%s] are missing in the %s '%s' create request!",
+			strings.Join(missingKeyList, ", "), rsType, nameId)
+	}
+
+	return mergedList, nil
+}
+
+func getTagPolicyIfExists(connectionName string, policy *TagPolicyInfo) (bool, error) {
+	bool_ret, err := infostore.Has(&TagPolicyInfo{}, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil || !bool_ret {
+		return false, err
+	}
+	err = infostore.Get(policy, CONNECTION_NAME_COLUMN, connectionName)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
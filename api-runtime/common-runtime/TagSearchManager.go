@@ -0,0 +1,354 @@
+// Tag Search and Bulk Operation Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+)
+
+//================ Cross-Connection Tag Search and Tag-based Bulk Operations
+
+// TagSelectorInfo selects the resources tagged with Key(=Value).
+type TagSelectorInfo struct {
+	ConnectionNames []string    // empty: all connection configs
+	ResType         cres.RSType // "" or "all": all resource types
+	Key             string      // tag key, ex) "env"
+	Value           string      // tag value, ex) "staging", "": any value
+}
+
+type ConnectionTagSearchInfo struct {
+	ConnectionName string
+	ResTypeList    []*ResTypeTagSearchInfo
+	ErrorMsg       string `json:",omitempty"`
+}
+
+type ResTypeTagSearchInfo struct {
+	ResType     cres.RSType
+	TagInfoList []*cres.TagInfo
+}
+
+// SearchTag finds the resources matched with the selector across the connections in parallel.
+// The results are grouped by connection and resource type.
+func SearchTag(selector TagSelectorInfo) ([]*ConnectionTagSearchInfo, error) {
+	cblog.Info("call SearchTag()")
+
+	selector, err := checkTagSelector(selector)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	resultList := make([]*ConnectionTagSearchInfo, len(selector.ConnectionNames))
+	var wg sync.WaitGroup
+	reqCtx := getRequestContext()
+	for idx, connectionName := range selector.ConnectionNames {
+		wg.Add(1)
+		go func(idx int, connectionName string) {
+			defer wg.Done()
+			defer bindRequestContext(reqCtx)()
+			resultList[idx] = searchTagByConnection(connectionName, selector)
+		}(idx, connectionName)
+	}
+	wg.Wait()
+
+	return resultList, nil
+}
+
+func checkTagSelector(selector TagSelectorInfo) (TagSelectorInfo, error) {
+	key, err := EmptyCheckAndTrim("Key", selector.Key)
+	if err != nil {
+		return selector, err
+	}
+	selector.Key = key
+	selector.Value = strings.TrimSpace(selector.Value)
+
+	if selector.ResType == "" {
+		selector.ResType = cres.ALL
+	}
+
+	if len(selector.ConnectionNames) == 0 {
+		configList, err := ccim.ListConnectionConfig()
+		if err != nil {
+			return selector, err
+		}
+		for _, config := range configList {
+			selector.ConnectionNames = append(selector.ConnectionNames, config.ConfigName)
+		}
+	}
+	return selector, nil
+}
+
+func searchTagByConnection(connectionName string, selector TagSelectorInfo) *ConnectionTagSearchInfo {
+	result := &ConnectionTagSearchInfo{ConnectionName: connectionName, ResTypeList: []*ResTypeTagSearchInfo{}}
+
+	tagInfoList, err := FindTag(connectionName, selector.ResType, selector.Key)
+	if err != nil {
+		cblog.Error(err)
+		result.ErrorMsg = err.Error()
+		return result
+	}
+
+	resTypeMap := map[cres.RSType]*ResTypeTagSearchInfo{}
+	for _, tagInfo := range tagInfoList {
+		if !hasSelectedTag(tagInfo.TagList, selector) {
+			continue
+		}
+		resTypeInfo, ok := resTypeMap[tagInfo.ResType]
+		if !ok {
+			resTypeInfo = &ResTypeTagSearchInfo{ResType: tagInfo.ResType}
+			resTypeMap[tagInfo.ResType] = resTypeInfo
+			result.ResTypeList = append(result.ResTypeList, resTypeInfo)
+		}
+		resTypeInfo.TagInfoList = append(resTypeInfo.TagInfoList, tagInfo)
+	}
+
+	return result
+}
+
+// FindTag() matches the keyword with the key or the value, so check the exact key and value.
+func hasSelectedTag(tagList []cres.KeyValue, selector TagSelectorInfo) bool {
+	for _, tag := range tagList {
+		if tag.Key == selector.Key && (selector.Value == "" || tag.Value == selector.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// ====================================================================
+// Tag-based Bulk Operations
+
+const (
+	TAG_BULK_CONTROL_VM = "controlvm"
+	TAG_BULK_DELETE     = "delete"
+	TAG_BULK_ADD_TAG    = "addtag"
+	TAG_BULK_REMOVE_TAG = "removetag"
+)
+
+// Result of the dry-run, the action is not run.
+const TAG_BULK_DRY_RUN_RESULT = "DryRun"
+
+type TagBulkActionReqInfo struct {
+	Selector TagSelectorInfo
+	Action   string // controlvm | delete | addtag | removetag
+
+	VMAction string        // for controlvm: suspend | resume | reboot
+	Force    string        // for delete: "true" | "false"(default)
+	Tag      cres.KeyValue // for addtag: {Key, Value}, for removetag: {Key}
+
+	Confirm string // for delete without ConnectionNames: "true" to delete in all connections
+	DryRun  string // "true": return the target resources without running the action
+}
+
+type TagBulkActionResultInfo struct {
+	ConnectionName string
+	ResType        cres.RSType
+	IId            cres.IID
+	Success        bool
+	Result         string `json:",omitempty"`
+	ErrorMsg       string `json:",omitempty"`
+}
+
+// delete order by the dependency between resource types, a group is deleted in parallel.
+var tagBulkDeleteGroups = [][]cres.RSType{
+	{cres.NODEGROUP},
+	{cres.CLUSTER, cres.MYIMAGE, cres.NLB},
+	{cres.VM},
+	{cres.DISK},
+	{cres.KEY, cres.SG},
+	{cres.SUBNET},
+	{cres.VPC},
+}
+
+// TagBulkAction runs the action to all resources matched with the selector.
+// The connections are processed in parallel, and the result of each resource is returned.
+func TagBulkAction(reqInfo TagBulkActionReqInfo) ([]*TagBulkActionResultInfo, error) {
+	cblog.Info("call TagBulkAction()")
+
+	reqInfo.Action = strings.ToLower(strings.TrimSpace(reqInfo.Action))
+	switch reqInfo.Action {
+	case TAG_BULK_CONTROL_VM:
+		if reqInfo.VMAction == "" {
+			err := fmt.Errorf("VMAction is empty! (suspend | resume | reboot)")
+			cblog.Error(err)
+			return nil, err
+		}
+		reqInfo.Selector.ResType = cres.VM
+	case TAG_BULK_DELETE:
+		if reqInfo.Force == "" {
+			reqInfo.Force = "false"
+		}
+		if len(reqInfo.Selector.ConnectionNames) == 0 && !strings.EqualFold(reqInfo.Confirm, "true") && !isTagBulkDryRun(reqInfo) {
+			err := fmt.Errorf("Selector.ConnectionNames is empty! Set the connection names or Confirm 'true' to delete in all connections.")
+			cblog.Error(err)
+			return nil, err
+		}
+	case TAG_BULK_ADD_TAG, TAG_BULK_REMOVE_TAG:
+		key, err := EmptyCheckAndTrim("Tag.Key", reqInfo.Tag.Key)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		reqInfo.Tag.Key = key
+	default:
+		err := fmt.Errorf("The action '%s' is not supported! (%s | %s | %s | %s)", reqInfo.Action,
+			TAG_BULK_CONTROL_VM, TAG_BULK_DELETE, TAG_BULK_ADD_TAG, TAG_BULK_REMOVE_TAG)
+		cblog.Error(err)
+		return nil, err
+	}
+
+	searchList, err := SearchTag(reqInfo.Selector)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	resultLists := make([][]*TagBulkActionResultInfo, len(searchList))
+	var wg sync.WaitGroup
+	reqCtx := getRequestContext()
+	for idx, searchInfo := range searchList {
+		wg.Add(1)
+		go func(idx int, searchInfo *ConnectionTagSearchInfo) {
+			defer wg.Done()
+			defer bindRequestContext(reqCtx)()
+			resultLists[idx] = tagBulkActionByConnection(reqInfo, searchInfo)
+		}(idx, searchInfo)
+	}
+	wg.Wait()
+
+	resultList := []*TagBulkActionResultInfo{}
+	for _, list := range resultLists {
+		resultList = append(resultList, list...)
+	}
+	return resultList, nil
+}
+
+func tagBulkActionByConnection(reqInfo TagBulkActionReqInfo, searchInfo *ConnectionTagSearchInfo) []*TagBulkActionResultInfo {
+	resultList := []*TagBulkActionResultInfo{}
+	if searchInfo.ErrorMsg != "" {
+		return append(resultList, &TagBulkActionResultInfo{
+			ConnectionName: searchInfo.ConnectionName,
+			ErrorMsg:       searchInfo.ErrorMsg,
+		})
+	}
+
+	tagInfoListMap := map[cres.RSType][]*cres.TagInfo{}
+	for _, resTypeInfo := range searchInfo.ResTypeList {
+		tagInfoListMap[resTypeInfo.ResType] = resTypeInfo.TagInfoList
+	}
+
+	resTypeGroups := tagBulkDeleteGroups
+	if reqInfo.Action != TAG_BULK_DELETE {
+		// no dependency, all types in one group
+		allTypes := []cres.RSType{}
+		for _, resTypeInfo := range searchInfo.ResTypeList {
+			allTypes = append(allTypes, resTypeInfo.ResType)
+		}
+		resTypeGroups = [][]cres.RSType{allTypes}
+	}
+
+	var mu sync.Mutex
+	for _, resTypes := range resTypeGroups {
+		var wg sync.WaitGroup
+		reqCtx := getRequestContext()
+		for _, resType := range resTypes {
+			for _, tagInfo := range tagInfoListMap[resType] {
+				wg.Add(1)
+				go func(resType cres.RSType, resIID cres.IID) {
+					defer wg.Done()
+					defer bindRequestContext(reqCtx)()
+					result := runTagBulkAction(searchInfo.ConnectionName, resType, resIID, reqInfo)
+					mu.Lock()
+					resultList = append(resultList, result)
+					mu.Unlock()
+				}(resType, tagInfo.ResIId)
+			}
+		}
+		wg.Wait()
+	}
+
+	return resultList
+}
+
+func isTagBulkDryRun(reqInfo TagBulkActionReqInfo) bool {
+	return strings.EqualFold(strings.TrimSpace(reqInfo.DryRun), "true")
+}
+
+func runTagBulkAction(connectionName string, resType cres.RSType, resIID cres.IID, reqInfo TagBulkActionReqInfo) *TagBulkActionResultInfo {
+	result := &TagBulkActionResultInfo{ConnectionName: connectionName, ResType: resType, IId: resIID}
+
+	if isTagBulkDryRun(reqInfo) {
+		result.Success = true
+		result.Result = TAG_BULK_DRY_RUN_RESULT
+		return result
+	}
+
+	var err error
+	switch reqInfo.Action {
+	case TAG_BULK_CONTROL_VM:
+		var status cres.VMStatus
+		status, err = ControlVM(connectionName, VM, resIID.NameId, reqInfo.VMAction)
+		result.Result = string(status)
+	case TAG_BULK_DELETE:
+		err = deleteTaggedResource(connectionName, resType, resIID.NameId, reqInfo.Force)
+	case TAG_BULK_ADD_TAG:
+		_, err = AddTag(connectionName, resType, cres.IID{NameId: resIID.NameId}, reqInfo.Tag)
+	case TAG_BULK_REMOVE_TAG:
+		_, err = RemoveTag(connectionName, resType, cres.IID{NameId: resIID.NameId}, reqInfo.Tag.Key)
+	}
+
+	if err != nil {
+		cblog.Error(err)
+		result.ErrorMsg = err.Error()
+		return result
+	}
+	result.Success = true
+	return result
+}
+
+func deleteTaggedResource(connectionName string, resType cres.RSType, nameId string, force string) error {
+	var err error
+	switch resType {
+	case cres.VPC:
+		_, err = DeleteVPC(connectionName, VPC, nameId, force)
+	case cres.SG:
+		_, err = DeleteSecurity(connectionName, SG, nameId, force)
+	case cres.KEY:
+		_, err = DeleteKey(connectionName, KEY, nameId, force)
+	case cres.VM:
+		_, _, err = DeleteVM(connectionName, VM, nameId, force)
+	case cres.NLB:
+		_, err = DeleteNLB(connectionName, NLB, nameId, force)
+	case cres.DISK:
+		_, err = DeleteDisk(connectionName, DISK, nameId, force)
+	case cres.MYIMAGE:
+		_, err = DeleteMyImage(connectionName, MYIMAGE, nameId, force)
+	case cres.CLUSTER:
+		_, err = DeleteCluster(connectionName, CLUSTER, nameId, force)
+	case cres.SUBNET, cres.NODEGROUP:
+		// Subnet and NodeGroup are removed from the owner
+		target, _, err2 := getTagTargetDriverIID(connectionName, resType, cres.IID{NameId: nameId})
+		if err2 != nil {
+			return err2
+		}
+		if resType == cres.SUBNET {
+			_, err = RemoveSubnet(connectionName, target.OwnerName, nameId, force)
+		} else {
+			_, err = RemoveNodeGroup(connectionName, target.OwnerName, nameId, force)
+		}
+	default:
+		err = fmt.Errorf("%s is not supported Resource!!", resType)
+	}
+	return err
+}
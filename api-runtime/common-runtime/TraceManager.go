@@ -0,0 +1,144 @@
+// OpenTelemetry Trace Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+)
+
+//================ OpenTelemetry Tracing
+// The spans are exported by OTLP/HTTP when the OTLP endpoint is set.
+//
+// env) the standard env of OpenTelemetry, ex)
+//	OTEL_EXPORTER_OTLP_ENDPOINT        : ex) http://localhost:4318 (tracing is disabled if not set)
+//	OTEL_EXPORTER_OTLP_TRACES_ENDPOINT : ex) http://localhost:4318/v1/traces
+//	OTEL_SERVICE_NAME                  : default: cb-spider
+//	OTEL_TRACES_SAMPLER                : default: parentbased_always_on
+//
+// The spans of the common-runtime and the drivers are the children of the request span
+// bound to the goroutine by BindRequestContext().
+
+const TRACER_NAME = "github.com/cloud-barista/cb-spider"
+
+// span attributes
+const (
+	TRACE_CONNECTION_NAME = "spider.connection_name"
+	TRACE_NAME_ID         = "spider.name_id"
+)
+
+var tracingEnabled bool
+
+func init() {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "" {
+		return
+	}
+
+	ctx := context.Background()
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	res, err := resource.New(ctx,
+		resource.WithAttributes(attribute.String("service.name", "cb-spider")),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		cblog.Error(err)
+		return
+	}
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	))
+	tracingEnabled = true
+
+	call.AddCallObserver(traceDriverCall)
+	cblog.Info("**** OTLP Tracing Enabled ****")
+}
+
+// Tracer returns the tracer of CB-Spider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TRACER_NAME)
+}
+
+type traceSpan struct {
+	span   trace.Span
+	gid    uint64
+	parent context.Context
+}
+
+// startSpan starts a span of a common-runtime operation as the current span of the goroutine.
+//
+//	ex) defer startSpan("StartVM", connectionName, reqInfo.IId.NameId).end()
+func startSpan(name string, connectionName string, nameId string, attrs ...attribute.KeyValue) *traceSpan {
+	if !tracingEnabled {
+		return nil
+	}
+
+	gid := goroutineID()
+	parent := getRequestContextOf(gid)
+	ctx, span := Tracer().Start(parent, name, trace.WithAttributes(
+		attribute.String(TRACE_CONNECTION_NAME, connectionName),
+		attribute.String(TRACE_NAME_ID, nameId),
+	), trace.WithAttributes(attrs...))
+	setRequestContext(gid, ctx)
+	return &traceSpan{span: span, gid: gid, parent: parent}
+}
+
+// end ends the span and restores the parent span as the current span.
+func (ts *traceSpan) end() {
+	if ts == nil {
+		return
+	}
+	ts.span.End()
+	setRequestContext(ts.gid, ts.parent)
+}
+
+// traceDriverCall adds a span of a CSP API call with the call-log of the drivers.
+func traceDriverCall(callInfo call.CLOUDLOGSCHEMA) {
+	if isSpiderCallLog(callInfo) {
+		return
+	}
+
+	end := time.Now()
+	start := end
+	if elapsed, ok := call.ElapsedSeconds(callInfo); ok {
+		start = end.Add(-time.Duration(elapsed * float64(time.Second)))
+	}
+
+	_, span := Tracer().Start(getRequestContext(), "driver:"+callInfo.CloudOSAPI,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(start),
+		trace.WithAttributes(
+			attribute.String("spider.cloud_os", string(callInfo.CloudOS)),
+			attribute.String("spider.region_zone", callInfo.RegionZone),
+			attribute.String("spider.resource_type", string(callInfo.ResourceType)),
+			attribute.String("spider.resource_name", callInfo.ResourceName),
+		))
+	if callInfo.ErrorMSG != "" {
+		span.SetStatus(codes.Error, callInfo.ErrorMSG)
+	}
+	span.End(trace.WithTimestamp(end))
+}
@@ -61,6 +61,8 @@ type VMUsingResources struct {
 func GetVMUsingRS(connectionName string, cspID string) (VMUsingResources, error) {
 	cblog.Info("call GetVMUsingRS()")
 
+	defer startSpan("GetVMUsingRS", connectionName, cspID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -123,7 +125,9 @@ func GetVMUsingRS(connectionName string, cspID string) (VMUsingResources, error)
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetVM(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	getInfo, err := retryCall(connectionName, call.VM, "GetVM()", func() (cres.VMInfo, error) {
+		return handler.GetVM(cres.IID{NameId: getMSShortID(cspID), SystemId: cspID})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return VMUsingResources{}, err
@@ -231,6 +235,8 @@ func GetVMUsingRS(connectionName string, cspID string) (VMUsingResources, error)
 func RegisterVM(connectionName string, userIID cres.IID) (*cres.VMInfo, error) {
 	cblog.Info("call RegisterVM()")
 
+	defer startSpan("RegisterVM", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -278,7 +284,9 @@ func RegisterVM(connectionName string, userIID cres.IID) (*cres.VMInfo, error) {
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not user NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetVM(cres.IID{NameId: userIID.SystemId, SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.VM, "GetVM()", func() (cres.VMInfo, error) {
+		return handler.GetVM(cres.IID{NameId: userIID.SystemId, SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -335,6 +343,17 @@ func RegisterVM(connectionName string, userIID cres.IID) (*cres.VMInfo, error) {
 	return &getInfo, nil
 }
 
+// fields of VMReqInfo allowed to be empty
+var vmReqEmptyPermissionList = []string{
+	"resources.IID:SystemId",
+	"resources.VMReqInfo:RootDiskType", // because can be set without disk type
+	"resources.VMReqInfo:RootDiskSize", // because can be set without disk size
+	// "resources.VMReqInfo:KeyPairName",  // because can be set without KeyPair for Windows
+	//	"resources.IID:NameId",
+	"resources.VMReqInfo:VMUserId",     // because can be set without VM User
+	"resources.VMReqInfo:VMUserPasswd", // because can be set without VM PW
+}
+
 // (1) check exist(NameID)
 // (2) generate SP-XID and create reqIID, driverIID
 // (3) clone the reqInfo with DriverIID
@@ -345,6 +364,8 @@ func RegisterVM(connectionName string, userIID cres.IID) (*cres.VMInfo, error) {
 func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTransformMode string) (*cres.VMInfo, error) {
 	cblog.Info("call StartVM()")
 
+	defer startSpan("StartVM", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -352,17 +373,7 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		return nil, err
 	}
 
-	emptyPermissionList := []string{
-		"resources.IID:SystemId",
-		"resources.VMReqInfo:RootDiskType", // because can be set without disk type
-		"resources.VMReqInfo:RootDiskSize", // because can be set without disk size
-		// "resources.VMReqInfo:KeyPairName",  // because can be set without KeyPair for Windows
-		//	"resources.IID:NameId",
-		"resources.VMReqInfo:VMUserId",     // because can be set without VM User
-		"resources.VMReqInfo:VMUserPasswd", // because can be set without VM PW
-	}
-
-	err = ValidateStruct(reqInfo, emptyPermissionList)
+	err = ValidateStruct(reqInfo, vmReqEmptyPermissionList)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -386,6 +397,20 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		return nil, err
 	}
 
+	// apply the default tag policy of the connection
+	reqInfo.TagList, err = applyTagPolicy(connectionName, rsType, reqInfo.IId.NameId, reqInfo.TagList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
+	// check the admission policy before calling the driver
+	err = admitVM(connectionName, reqInfo)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	vmSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer vmSPLock.Unlock(connectionName, reqInfo.IId.NameId)
 
@@ -497,21 +522,34 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 		RegionZone:   regionName + "/" + zoneName,
 		ResourceType: call.VM,
 		ResourceName: reqInfo.IId.NameId,
-		CloudOSAPI:   "CB-Spider:StartVM()",
+		CloudOSAPI:   SPIDER_CALL_LOG_PREFIX + "StartVM()",
 		ElapsedTime:  "",
 		ErrorMSG:     "",
 	}
 	start := call.Start()
 
 	// (4) create Resource
-	info, err := handler.StartVM(reqInfoForDriver)
+	info, err := guardCall(connectionName, func() (cres.VMInfo, error) {
+		return handler.StartVM(reqInfoForDriver)
+	})
 	if err != nil {
 		cblog.Error(err)
 		callInfo.ErrorMSG = err.Error()
+		call.Observe(callInfo)
 		callogger.Info(call.String(callInfo))
 		return nil, err
 	}
 
+	// record the created VM to terminate it when a later step fails
+	sg := newSaga("StartVM", reqIId.NameId)
+	vmDriverIId := info.IId
+	sg.done("VM-CSP", vmDriverIId.SystemId, func() error {
+		_, err := guardCall(connectionName, func() (cres.VMStatus, error) {
+			return handler.TerminateVM(vmDriverIId)
+		})
+		return err
+	})
+
 	// Check Sync Called and Make sure cb-user prepared -----------------
 	// --- <step-1> Get PublicIP of new VM
 	var checkError struct {
@@ -520,32 +558,17 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 	}
 
 	waiter := NewWaiter(5, 240) // (sleep, timeout)
-	var publicIP string
-	for {
-		vmInfo, err := handler.GetVM(info.IId)
-		if err != nil {
-			cblog.Error(err)
-			if checkNotFoundError(err) { // VM is not created yet.
-				continue
-			}
-			callInfo.ErrorMSG = err.Error()
-			callogger.Info(call.String(callInfo))
-
-			//handler.TerminateVM(info.IId)
-
-			return nil, err
-		}
-		if vmInfo.PublicIP != "" {
-			publicIP = vmInfo.PublicIP
-			break
-		}
+	publicIP, timedOut, err := waitVMPublicIP(func() (cres.VMInfo, error) { return handler.GetVM(info.IId) }, waiter.Wait)
+	if err != nil {
+		callInfo.ErrorMSG = err.Error()
+		call.Observe(callInfo)
+		callogger.Info(call.String(callInfo))
 
-		if !waiter.Wait() {
-			//handler.TerminateVM(info.IId)
-			checkError.Flag = true
-			checkError.MSG = fmt.Sprintf("[%s] Failed to Start VM %s when getting PublicIP. (Timeout=%v)", connectionName, reqIId.NameId, waiter.Timeout)
-			break
-		}
+		return nil, sg.rollback(err)
+	}
+	if timedOut {
+		checkError.Flag = true
+		checkError.MSG = fmt.Sprintf("[%s] Failed to Start VM %s when getting PublicIP. (Timeout=%v)", connectionName, reqIId.NameId, waiter.Timeout)
 	}
 
 	if !checkError.Flag && !isWindowsOS && providerName != "MOCK" {
@@ -567,6 +590,7 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 	}
 
 	callInfo.ElapsedTime = call.Elapsed(start)
+	call.Observe(callInfo)
 	callogger.Info(call.String(callInfo))
 
 	// End : Check Sync Called and Make sure cb-user prepared -----------------
@@ -580,14 +604,7 @@ func StartVM(connectionName string, rsType string, reqInfo cres.VMReqInfo, IDTra
 	err = infostore.Insert(&iidInfo)
 	if err != nil {
 		cblog.Error(err)
-		// rollback
-		_, err2 := handler.TerminateVM(info.IId) // @todo check validation
-		if err2 != nil {
-			cblog.Error(err2)
-			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-		}
-		cblog.Error(err)
-		return nil, err
+		return nil, sg.rollback(err)
 	}
 
 	/*
@@ -771,6 +788,27 @@ func cloneReqInfoWithDriverIID(ConnectionName string, reqInfo cres.VMReqInfo) (c
 	return newReqInfo, nil
 }
 
+// waitVMPublicIP waits until the created VM has a public IP.
+// NotFound(the VM is not visible yet) and transient errors are waited until the timeout,
+// and the other errors are returned as definitive failures to roll back the VM.
+func waitVMPublicIP(getVM func() (cres.VMInfo, error), wait func() bool) (string, bool, error) {
+	for {
+		vmInfo, err := getVM()
+		if err != nil {
+			cblog.Error(err)
+			if !checkNotFoundError(err) && !isTransientError(err) {
+				return "", false, err
+			}
+		} else if vmInfo.PublicIP != "" {
+			return vmInfo.PublicIP, false, nil
+		}
+
+		if !wait() {
+			return "", true, nil
+		}
+	}
+}
+
 func checkSSH(serverPort string) bool {
 
 	dummyKey := []byte(`
@@ -971,6 +1009,8 @@ type ResultVMInfo struct {
 func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
 	cblog.Info("call ListVM()")
 
+	defer startSpan("ListVM", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1012,11 +1052,15 @@ func ListVM(connectionName string, rsType string) ([]*cres.VMInfo, error) {
 		retChanInfos = append(retChanInfos, make(chan ResultVMInfo))
 	}
 
+	reqCtx := getRequestContext()
 	for idx, iidInfo := range iidInfoList {
 
 		wg.Add(1)
 
-		go getVMInfo(connectionName, handler, cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}, retChanInfos[idx])
+		go func(iid cres.IID, retInfo chan ResultVMInfo) {
+			defer bindRequestContext(reqCtx)()
+			getVMInfo(connectionName, handler, iid, retInfo)
+		}(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}, retChanInfos[idx])
 
 		wg.Done()
 
@@ -1052,7 +1096,9 @@ func getVMInfo(connectionName string, handler cres.VMHandler, iid cres.IID, retI
 
 	vmSPLock.RLock(connectionName, iid.NameId)
 	// get resource(SystemId)
-	info, err := handler.GetVM(getDriverIID(iid))
+	info, err := retryCall(connectionName, call.VM, "GetVM()", func() (cres.VMInfo, error) {
+		return handler.GetVM(getDriverIID(iid))
+	})
 	if err != nil {
 		vmSPLock.RUnlock(connectionName, iid.NameId)
 		cblog.Error(err)
@@ -1201,6 +1247,8 @@ func getSetNameId(ConnectionName string, vmInfo *cres.VMInfo) error {
 func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, error) {
 	cblog.Info("call GetVM()")
 
+	defer startSpan("GetVM", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1238,7 +1286,9 @@ func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, e
 	}
 
 	// (2) get resource(SystemId)
-	info, err := handler.GetVM(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.VM, "GetVM()", func() (cres.VMInfo, error) {
+		return handler.GetVM(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1292,6 +1342,8 @@ func GetVM(connectionName string, rsType string, nameID string) (*cres.VMInfo, e
 func GetCSPVM(connectionName string, rsType string, cspID string) (*cres.VMInfo, error) {
 	cblog.Info("call GetVM()")
 
+	defer startSpan("GetCSPVM", connectionName, cspID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1317,7 +1369,9 @@ func GetCSPVM(connectionName string, rsType string, cspID string) (*cres.VMInfo,
 		return nil, err
 	}
 
-	info, err := handler.GetVM(cres.IID{NameId: "", SystemId: cspID})
+	info, err := retryCall(connectionName, call.VM, "GetVM()", func() (cres.VMInfo, error) {
+		return handler.GetVM(cres.IID{NameId: "", SystemId: cspID})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -1331,6 +1385,8 @@ func GetCSPVM(connectionName string, rsType string, cspID string) (*cres.VMInfo,
 func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, error) {
 	cblog.Info("call ListVMStatus()")
 
+	defer startSpan("ListVMStatus", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1415,6 +1471,8 @@ func ListVMStatus(connectionName string, rsType string) ([]*cres.VMStatusInfo, e
 func GetVMStatus(connectionName string, rsType string, nameID string) (cres.VMStatus, error) {
 	cblog.Info("call GetVMStatus()")
 
+	defer startSpan("GetVMStatus", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1487,6 +1545,8 @@ func GetVMStatus(connectionName string, rsType string, nameID string) (cres.VMSt
 func ControlVM(connectionName string, rsType string, nameID string, action string) (cres.VMStatus, error) {
 	cblog.Info("call ControlVM()")
 
+	defer startSpan("ControlVM", connectionName, nameID).end()
+
 	cldConn, err := ccm.GetCloudConnection(connectionName)
 	if err != nil {
 		cblog.Error(err)
@@ -1537,6 +1597,8 @@ func ControlVM(connectionName string, rsType string, nameID string, action strin
 func DeleteVM(connectionName string, rsType string, nameID string, force string) (bool, cres.VMStatus, error) {
 	cblog.Info("call DeleteVM()")
 
+	defer startSpan("DeleteVM", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1594,7 +1656,7 @@ func DeleteVM(connectionName string, rsType string, nameID string, force string)
 		RegionZone:   regionName + "/" + zoneName,
 		ResourceType: call.VM,
 		ResourceName: iidInfo.NameId,
-		CloudOSAPI:   "CB-Spider:TerminateVM()",
+		CloudOSAPI:   SPIDER_CALL_LOG_PREFIX + "TerminateVM()",
 		ElapsedTime:  "",
 		ErrorMSG:     "",
 	}
@@ -1604,6 +1666,7 @@ func DeleteVM(connectionName string, rsType string, nameID string, force string)
 		cblog.Error(err)
 		if force != "true" {
 			callInfo.ErrorMSG = err.Error()
+			call.Observe(callInfo)
 			callogger.Info(call.String(callInfo))
 			return false, vmStatus, err
 		}
@@ -1613,7 +1676,9 @@ func DeleteVM(connectionName string, rsType string, nameID string, force string)
 	waiter := NewWaiter(5, 240) // (sleep, timeout)
 
 	for {
-		status, err := handler.(cres.VMHandler).GetVMStatus(driverIId)
+		status, err := retryCall(connectionName, call.VM, "GetVMStatus()", func() (cres.VMStatus, error) {
+			return handler.(cres.VMHandler).GetVMStatus(driverIId)
+		})
 		if status == cres.NotExist { // alibaba returns NotExist with err==nil
 			err = fmt.Errorf("Not Found %s", driverIId.SystemId)
 		}
@@ -1627,6 +1692,7 @@ func DeleteVM(connectionName string, rsType string, nameID string, force string)
 			cblog.Error(err)
 			if force != "true" {
 				callInfo.ErrorMSG = err.Error()
+				call.Observe(callInfo)
 				callogger.Info(call.String(callInfo))
 				return false, status, err
 			} else {
@@ -1642,6 +1708,7 @@ func DeleteVM(connectionName string, rsType string, nameID string, force string)
 			err := fmt.Errorf("[%s] Failed to terminate VM %s. (Timeout=%v)", connectionName, driverIId.NameId, waiter.Timeout)
 			if force != "true" {
 				callInfo.ErrorMSG = err.Error()
+				call.Observe(callInfo)
 				callogger.Info(call.String(callInfo))
 				return false, status, err
 			}
@@ -1649,6 +1716,7 @@ func DeleteVM(connectionName string, rsType string, nameID string, force string)
 	} // end of for
 
 	callInfo.ElapsedTime = call.Elapsed(start)
+	call.Observe(callInfo)
 	callogger.Info(call.String(callInfo))
 
 	// (3) delete IID
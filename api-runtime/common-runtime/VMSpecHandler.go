@@ -10,6 +10,7 @@ package commonruntime
 
 import (
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
 
@@ -17,6 +18,8 @@ import (
 func ListVMSpec(connectionName string) ([]*cres.VMSpecInfo, error) {
 	cblog.Info("call ListVMSpec()")
 
+	defer startSpan("ListVMSpec", connectionName, "").end()
+
 	// check empty and trim user inputs
         connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
         if err != nil {
@@ -36,7 +39,9 @@ func ListVMSpec(connectionName string) ([]*cres.VMSpecInfo, error) {
 		return nil, err
 	}
 
-	infoList, err := handler.ListVMSpec()
+	infoList, err := retryCall(connectionName, call.VMSPEC, "ListVMSpec()", func() ([]*cres.VMSpecInfo, error) {
+		return handler.ListVMSpec()
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -52,6 +57,8 @@ func ListVMSpec(connectionName string) ([]*cres.VMSpecInfo, error) {
 func GetVMSpec(connectionName string, nameID string) (*cres.VMSpecInfo, error) {
 	cblog.Info("call GetVMSpec()")
 
+	defer startSpan("GetVMSpec", connectionName, nameID).end()
+
 	// check empty and trim user inputs
         connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
         if err != nil {
@@ -76,7 +83,9 @@ func GetVMSpec(connectionName string, nameID string) (*cres.VMSpecInfo, error) {
 		cblog.Error(err)
 		return nil, err
 	}
-	info, err := handler.GetVMSpec(nameID)
+	info, err := retryCall(connectionName, call.VMSPEC, "GetVMSpec()", func() (cres.VMSpecInfo, error) {
+		return handler.GetVMSpec(nameID)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -88,6 +97,8 @@ func GetVMSpec(connectionName string, nameID string) (*cres.VMSpecInfo, error) {
 func ListOrgVMSpec(connectionName string) (string, error) {
 	cblog.Info("call ListOrgVMSpec()")
 
+	defer startSpan("ListOrgVMSpec", connectionName, "").end()
+
 	// check empty and trim user inputs
         connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
         if err != nil {
@@ -107,7 +118,9 @@ func ListOrgVMSpec(connectionName string) (string, error) {
 		return "", err
 	}
 
-	infoList, err := handler.ListOrgVMSpec()
+	infoList, err := retryCall(connectionName, call.VMSPEC, "ListOrgVMSpec()", func() (string, error) {
+		return handler.ListOrgVMSpec()
+	})
 	if err != nil {
 		cblog.Error(err)
 		return "", err
@@ -119,6 +132,8 @@ func ListOrgVMSpec(connectionName string) (string, error) {
 func GetOrgVMSpec(connectionName string, nameID string) (string, error) {
 	cblog.Info("call GetOrgVMSpec()")
 
+	defer startSpan("GetOrgVMSpec", connectionName, nameID).end()
+
 	// check empty and trim user inputs
         connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
         if err != nil {
@@ -143,7 +158,9 @@ func GetOrgVMSpec(connectionName string, nameID string) (string, error) {
 		cblog.Error(err)
 		return "", err
 	}
-	info, err := handler.GetOrgVMSpec(nameID)
+	info, err := retryCall(connectionName, call.VMSPEC, "GetOrgVMSpec()", func() (string, error) {
+		return handler.GetOrgVMSpec(nameID)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return "", err
@@ -0,0 +1,354 @@
+// VM Spec Recommendation Handler of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package commonruntime
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// default max number of candidate specs per connection to look up the price,
+// the smallest candidates are looked up first, and the others are ranked with the unknown price.
+// It can be changed by VMSpecRequirementInfo.MaxPriceCandidates.
+const DEFAULT_MAX_PRICE_CANDIDATES = 10
+
+const UNKNOWN_PRICE = -1.0
+
+// VMSpecRequirementInfo is the resource requirement of VMSpec recommendation.
+type VMSpecRequirementInfo struct {
+	ConnectionNames []string
+	MinVCpu         int
+	MinMemGB        float64
+	MinGpu          int
+	MaxPricePerHour float64 // 0: no limit
+	Limit           int     // 0: no limit
+
+	// max number of candidate specs per connection to look up the price,
+	// 0: DEFAULT_MAX_PRICE_CANDIDATES, -1: all candidates
+	MaxPriceCandidates int
+}
+
+// VMSpecRecommendInfo is an item of the ranked VMSpec recommendation list.
+type VMSpecRecommendInfo struct {
+	Rank           int
+	ConnectionName string
+	ProviderName   string
+	RegionName     string
+	VMSpecName     string
+	VCpu           int
+	MemGB          float64
+	Gpu            int
+	PricePerHour   float64 // -1: unknown
+	Currency       string
+	ZoneList       []VMSpecZoneInfo
+
+	VMSpecInfo *cres.VMSpecInfo
+}
+
+// VMSpecZoneInfo is the availability of a VMSpec in a zone.
+type VMSpecZoneInfo struct {
+	ZoneName     string
+	Status       cres.ZoneStatus
+	PricePerHour float64 // -1: unknown
+}
+
+// VMSpecRecommendErrorInfo is the error of a connection failed in the recommendation.
+type VMSpecRecommendErrorInfo struct {
+	ConnectionName string `json:"ConnectionName"`
+	ErrorMsg       string `json:"ErrorMsg"`
+}
+
+type ResultVMSpecRecommendInfo struct {
+	connectionName string
+	infoList       []*VMSpecRecommendInfo
+	err            error
+}
+
+// RecommendVMSpec returns the VMSpecs that meet the requirement across the connections,
+// ranked by the price per hour. The specs without price info are ranked last.
+// The errors of the failed connections are returned with the list of the other connections.
+func RecommendVMSpec(reqInfo VMSpecRequirementInfo) ([]*VMSpecRecommendInfo, []*VMSpecRecommendErrorInfo, error) {
+	cblog.Info("call RecommendVMSpec()")
+
+	if len(reqInfo.ConnectionNames) == 0 {
+		err := fmt.Errorf("ConnectionNames is empty!")
+		cblog.Error(err)
+		return nil, nil, err
+	}
+	if reqInfo.MaxPriceCandidates < -1 {
+		err := fmt.Errorf("MaxPriceCandidates(%d) must be -1, 0 or a positive number!", reqInfo.MaxPriceCandidates)
+		cblog.Error(err)
+		return nil, nil, err
+	}
+
+	// do not change the caller's list
+	connectionNames := make([]string, len(reqInfo.ConnectionNames))
+	for idx, connectionName := range reqInfo.ConnectionNames {
+		connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, nil, err
+		}
+		connectionNames[idx] = connectionName
+	}
+	reqInfo.ConnectionNames = connectionNames
+
+	var wg sync.WaitGroup
+	reqCtx := getRequestContext()
+	retChanInfos := []chan ResultVMSpecRecommendInfo{}
+	for _, connectionName := range reqInfo.ConnectionNames {
+		retChan := make(chan ResultVMSpecRecommendInfo, 1)
+		retChanInfos = append(retChanInfos, retChan)
+
+		wg.Add(1)
+		go func(connectionName string, retChan chan ResultVMSpecRecommendInfo) {
+			defer wg.Done()
+			defer bindRequestContext(reqCtx)()
+			infoList, err := recommendVMSpecByConnection(connectionName, reqInfo)
+			retChan <- ResultVMSpecRecommendInfo{connectionName, infoList, err}
+		}(connectionName, retChan)
+	}
+	wg.Wait()
+
+	resultList := []*VMSpecRecommendInfo{}
+	errInfoList := []*VMSpecRecommendErrorInfo{}
+	errList := []string{}
+	for _, retChan := range retChanInfos {
+		ret := <-retChan
+		if ret.err != nil {
+			cblog.Error(ret.err)
+			errInfoList = append(errInfoList, &VMSpecRecommendErrorInfo{ret.connectionName, ret.err.Error()})
+			errList = append(errList, ret.connectionName+": "+ret.err.Error())
+			continue
+		}
+		resultList = append(resultList, ret.infoList...)
+	}
+
+	// every connection failed
+	if len(errList) > 0 && len(errList) == len(reqInfo.ConnectionNames) {
+		err := fmt.Errorf("%s", strings.Join(errList, ", "))
+		cblog.Error(err)
+		return nil, errInfoList, err
+	}
+
+	sort.SliceStable(resultList, func(i, j int) bool {
+		return isLessRecommend(resultList[i], resultList[j])
+	})
+
+	if reqInfo.Limit > 0 && len(resultList) > reqInfo.Limit {
+		resultList = resultList[:reqInfo.Limit]
+	}
+	for idx, info := range resultList {
+		info.Rank = idx + 1
+	}
+
+	return resultList, errInfoList, nil
+}
+
+func recommendVMSpecByConnection(connectionName string, reqInfo VMSpecRequirementInfo) ([]*VMSpecRecommendInfo, error) {
+	providerName, err := ccm.GetProviderNameByConnectionName(connectionName)
+	if err != nil {
+		return nil, err
+	}
+	regionName, zoneName, err := ccm.GetRegionNameByConnectionName(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	specList, err := ListVMSpec(connectionName)
+	if err != nil {
+		return nil, err
+	}
+
+	// (1) filter by the requirement
+	candidateList := []*VMSpecRecommendInfo{}
+	for _, spec := range specList {
+		info := &VMSpecRecommendInfo{
+			ConnectionName: connectionName,
+			ProviderName:   providerName,
+			RegionName:     regionName,
+			VMSpecName:     spec.Name,
+			VCpu:           int(parseSpecNumber(spec.VCpu.Count)),
+			MemGB:          parseSpecNumber(spec.Mem) / 1024,
+			PricePerHour:   UNKNOWN_PRICE,
+			VMSpecInfo:     spec,
+		}
+		for _, gpu := range spec.Gpu {
+			info.Gpu += int(parseSpecNumber(gpu.Count))
+		}
+
+		if info.VCpu < reqInfo.MinVCpu || info.MemGB < reqInfo.MinMemGB || info.Gpu < reqInfo.MinGpu {
+			continue
+		}
+		candidateList = append(candidateList, info)
+	}
+
+	// (2) look up the prices of the smallest candidates
+	sort.SliceStable(candidateList, func(i, j int) bool {
+		return isLessSize(candidateList[i], candidateList[j])
+	})
+	maxPriceCandidates := reqInfo.MaxPriceCandidates
+	if maxPriceCandidates == 0 {
+		maxPriceCandidates = DEFAULT_MAX_PRICE_CANDIDATES
+	}
+
+	var regionZoneList []VMSpecZoneInfo
+	for idx, info := range candidateList {
+		if maxPriceCandidates < 0 || idx < maxPriceCandidates {
+			setVMSpecPrice(connectionName, regionName, info)
+		}
+
+		// price info has no zone granularity, so the spec is regarded as available in the zones of the region.
+		if len(info.ZoneList) == 0 {
+			if regionZoneList == nil {
+				regionZoneList = getRegionZoneList(connectionName, regionName, zoneName)
+			}
+			for _, zone := range regionZoneList {
+				info.ZoneList = append(info.ZoneList, VMSpecZoneInfo{zone.ZoneName, zone.Status, info.PricePerHour})
+			}
+		}
+	}
+
+	// (3) filter by the max price
+	resultList := []*VMSpecRecommendInfo{}
+	for _, info := range candidateList {
+		if reqInfo.MaxPricePerHour > 0 && (info.PricePerHour == UNKNOWN_PRICE || info.PricePerHour > reqInfo.MaxPricePerHour) {
+			continue
+		}
+		resultList = append(resultList, info)
+	}
+
+	return resultList, nil
+}
+
+// setVMSpecPrice sets the on-demand price per hour and the zone list of the spec.
+// The price stays unknown when the CSP has no price info of the spec.
+func setVMSpecPrice(connectionName string, regionName string, info *VMSpecRecommendInfo) {
+	filterList := []cres.KeyValue{{Key: "instanceType", Value: info.VMSpecName}}
+	priceJson, err := GetPriceInfo(connectionName, "ComputeInstance", regionName, filterList)
+	if err != nil {
+		cblog.Errorf("failed to get the price of %s in %s: %v", info.VMSpecName, connectionName, err)
+		return
+	}
+
+	var priceData cres.CloudPriceData
+	err = json.Unmarshal([]byte(priceJson), &priceData)
+	if err != nil {
+		cblog.Errorf("failed to parse the price of %s in %s: %v", info.VMSpecName, connectionName, err)
+		return
+	}
+
+	for _, cloudPrice := range priceData.CloudPriceList {
+		for _, price := range cloudPrice.PriceList {
+			if price.ProductInfo.InstanceType != "" && price.ProductInfo.InstanceType != info.VMSpecName {
+				continue
+			}
+			pricePerHour, currency := getOnDemandPricePerHour(price.PriceInfo.PricingPolicies)
+			if pricePerHour == UNKNOWN_PRICE {
+				continue
+			}
+			if info.PricePerHour == UNKNOWN_PRICE || pricePerHour < info.PricePerHour {
+				info.PricePerHour = pricePerHour
+				info.Currency = currency
+			}
+
+			zoneName := price.ProductInfo.ZoneName
+			if zoneName != "" && zoneName != "NA" {
+				info.ZoneList = append(info.ZoneList, VMSpecZoneInfo{zoneName, cres.ZoneAvailable, pricePerHour})
+			}
+		}
+	}
+}
+
+// getOnDemandPricePerHour returns the lowest on-demand price per hour of the policies.
+func getOnDemandPricePerHour(policyList []cres.PricingPolicies) (float64, string) {
+	pricePerHour := UNKNOWN_PRICE
+	currency := ""
+	for _, policy := range policyList {
+		if !strings.EqualFold(policy.PricingPolicy, "OnDemand") {
+			continue
+		}
+		price, err := strconv.ParseFloat(strings.TrimSpace(policy.Price), 64)
+		if err != nil || price <= 0 {
+			continue
+		}
+
+		unit := strings.ToLower(policy.Unit)
+		switch {
+		case strings.Contains(unit, "hr") || strings.Contains(unit, "hour"):
+		case strings.Contains(unit, "month"):
+			price = price / 730 // 730 hours per month
+		default:
+			continue
+		}
+
+		if pricePerHour == UNKNOWN_PRICE || price < pricePerHour {
+			pricePerHour = price
+			currency = policy.Currency
+		}
+	}
+	return pricePerHour, currency
+}
+
+func getRegionZoneList(connectionName string, regionName string, zoneName string) []VMSpecZoneInfo {
+	zoneList := []VMSpecZoneInfo{}
+	regionZoneInfo, err := GetRegionZone(connectionName, regionName)
+	if err != nil || len(regionZoneInfo.ZoneList) == 0 {
+		// use the zone of the connection
+		if zoneName != "" {
+			zoneList = append(zoneList, VMSpecZoneInfo{zoneName, cres.NotSupported, UNKNOWN_PRICE})
+		}
+		return zoneList
+	}
+	for _, zone := range regionZoneInfo.ZoneList {
+		zoneList = append(zoneList, VMSpecZoneInfo{zone.Name, zone.Status, UNKNOWN_PRICE})
+	}
+	return zoneList
+}
+
+// cheaper first, the unknown price is the last
+func isLessRecommend(a *VMSpecRecommendInfo, b *VMSpecRecommendInfo) bool {
+	if a.PricePerHour != b.PricePerHour {
+		if a.PricePerHour == UNKNOWN_PRICE {
+			return false
+		}
+		if b.PricePerHour == UNKNOWN_PRICE {
+			return true
+		}
+		return a.PricePerHour < b.PricePerHour
+	}
+	return isLessSize(a, b)
+}
+
+// smaller first
+func isLessSize(a *VMSpecRecommendInfo, b *VMSpecRecommendInfo) bool {
+	if a.VCpu != b.VCpu {
+		return a.VCpu < b.VCpu
+	}
+	if a.MemGB != b.MemGB {
+		return a.MemGB < b.MemGB
+	}
+	return a.Gpu < b.Gpu
+}
+
+// the count or size of VMSpecInfo, "" or invalid number => 0
+func parseSpecNumber(value string) float64 {
+	n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
@@ -11,11 +11,14 @@ package commonruntime
 import (
 	"errors"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 	"sync"
 
 	ccm "github.com/cloud-barista/cb-spider/cloud-control-manager"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	iidm "github.com/cloud-barista/cb-spider/cloud-control-manager/iid-manager"
 	infostore "github.com/cloud-barista/cb-spider/info-store"
@@ -58,6 +61,8 @@ func init() {
 func RegisterVPC(connectionName string, userIID cres.IID) (*cres.VPCInfo, error) {
 	cblog.Info("call RegisterVPC()")
 
+	defer startSpan("RegisterVPC", connectionName, userIID.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -104,7 +109,9 @@ func RegisterVPC(connectionName string, userIID cres.IID) (*cres.VPCInfo, error)
 	// (2) get resource info(CSP-ID)
 	// check existence and get info of this resouce in the CSP
 	// Do not use NameId, because Azure driver use it like SystemId
-	getInfo, err := handler.GetVPC(cres.IID{NameId: userIID.SystemId, SystemId: userIID.SystemId})
+	getInfo, err := retryCall(connectionName, call.VPCSUBNET, "GetVPC()", func() (cres.VPCInfo, error) {
+		return handler.GetVPC(cres.IID{NameId: userIID.SystemId, SystemId: userIID.SystemId})
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -174,6 +181,8 @@ func RegisterVPC(connectionName string, userIID cres.IID) (*cres.VPCInfo, error)
 func RegisterSubnet(connectionName string, zoneId string, vpcName string, userIID cres.IID) (*cres.VPCInfo, error) {
 	cblog.Info("call RegisterSubnet()")
 
+	defer startSpan("RegisterSubnet", connectionName, vpcName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -232,7 +241,9 @@ func RegisterSubnet(connectionName string, zoneId string, vpcName string, userII
 	}
 
 	// (2) get resource(driverIID)
-	getInfo, err := handler.GetVPC(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	getInfo, err := retryCall(connectionName, call.VPCSUBNET, "GetVPC()", func() (cres.VPCInfo, error) {
+		return handler.GetVPC(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -280,6 +291,8 @@ func RegisterSubnet(connectionName string, zoneId string, vpcName string, userII
 func UnregisterSubnet(connectionName string, vpcName string, nameId string) (bool, error) {
 	cblog.Info("call UnregisterSubnet()")
 
+	defer startSpan("UnregisterSubnet", connectionName, nameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -336,9 +349,22 @@ type SubnetReqZoneInfo struct {
 	Zone string
 }
 
+// fields of VPCReqInfo allowed to be empty
+var vpcReqEmptyPermissionList = []string{
+	"resources.IID:SystemId",
+	"resources.VPCReqInfo:IPv4_CIDR", // because can be unused in some VPC
+	"resources.VPCReqInfo:IPv6_CIDR", // because IPv6 is optional
+	"resources.SubnetInfo:Zone",      // because can be unused in some Zone
+	"resources.SubnetInfo:IPv6_CIDR", // because IPv6 is optional
+	"resources.KeyValue:Key",         // because unusing key-value list
+	"resources.KeyValue:Value",       // because unusing key-value list
+}
+
 func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, IDTransformMode string) (*cres.VPCInfo, error) {
 	cblog.Info("call CreateVPC()")
 
+	defer startSpan("CreateVPC", connectionName, reqInfo.IId.NameId).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -346,15 +372,13 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 		return nil, err
 	}
 
-	emptyPermissionList := []string{
-		"resources.IID:SystemId",
-		"resources.VPCReqInfo:IPv4_CIDR", // because can be unused in some VPC
-		"resources.SubnetInfo:Zone",      // because can be unused in some Zone
-		"resources.KeyValue:Key",         // because unusing key-value list
-		"resources.KeyValue:Value",       // because unusing key-value list
+	err = ValidateStruct(reqInfo, vpcReqEmptyPermissionList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
 	}
 
-	err = ValidateStruct(reqInfo, emptyPermissionList)
+	err = validateVPCIPv6Args(connectionName, reqInfo)
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -372,6 +396,13 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 		return nil, err
 	}
 
+	// apply the default tag policy of the connection
+	reqInfo.TagList, err = applyTagPolicy(connectionName, rsType, reqInfo.IId.NameId, reqInfo.TagList)
+	if err != nil {
+		cblog.Error(err)
+		return nil, err
+	}
+
 	vpcSPLock.Lock(connectionName, reqInfo.IId.NameId)
 	defer vpcSPLock.Unlock(connectionName, reqInfo.IId.NameId)
 
@@ -476,12 +507,33 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 
 	// (3) create Resource
 	// VPC: driverIId, Subnet: driverIId List
-	info, err := handler.CreateVPC(reqInfo)
+	info, err := guardCall(connectionName, func() (cres.VPCInfo, error) {
+		return handler.CreateVPC(reqInfo)
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
 	}
 
+	// record the created resources to undo them when a later step fails
+	sg := newSaga("CreateVPC", reqIId.NameId)
+	vpcDriverIId := info.IId
+	sg.done("VPC-CSP", vpcDriverIId.SystemId, func() error {
+		_, err := guardCall(connectionName, func() (bool, error) {
+			return handler.DeleteVPC(vpcDriverIId)
+		})
+		return err
+	})
+	for _, subnetInfo := range info.SubnetInfoList {
+		subnetDriverIId := subnetInfo.IId
+		sg.done("SUBNET-CSP", subnetDriverIId.SystemId, func() error {
+			_, err := guardCall(connectionName, func() (bool, error) {
+				return handler.RemoveSubnet(vpcDriverIId, subnetDriverIId)
+			})
+			return err
+		})
+	}
+
 	// (4) create spiderIID: {reqNameID, driverNameID:driverSystemID}
 	//     ex) spiderIID {"seoul-service", "vm-01-9m4e2mr0ui3e8a215n4g:i-0bc7123b7e5cbf79d"}
 	spiderIId := cres.IID{NameId: reqIId.NameId, SystemId: spUUID + ":" + info.IId.SystemId}
@@ -491,15 +543,12 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 	err = infostore.Insert(&VPCIIDInfo{ConnectionName: connectionName, NameId: spiderIId.NameId, SystemId: spiderIId.SystemId})
 	if err != nil {
 		cblog.Error(err)
-		// rollback
-		_, err2 := handler.DeleteVPC(info.IId)
-		if err2 != nil {
-			cblog.Error(err2)
-			return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-		}
-		cblog.Error(err)
-		return nil, err
+		return nil, sg.rollback(err)
 	}
+	sg.done("VPC-IID", spiderIId.NameId, func() error {
+		_, err := infostore.DeleteByConditions(&VPCIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, spiderIId.NameId)
+		return err
+	})
 	// for Subnet list
 	for _, subnetInfo := range info.SubnetInfoList {
 		subnetReqNameId := getSubnetReqNameId(subnetReqIIdZoneList, subnetInfo.IId.NameId)
@@ -516,31 +565,13 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 			OwnerVPCName: reqIId.NameId})
 		if err != nil {
 			cblog.Error(err)
-			// rollback
-			// (1) for resource
-			cblog.Info("<<ROLLBACK:TRY:VPC-CSP>> " + info.IId.SystemId)
-			_, err2 := handler.DeleteVPC(info.IId)
-			if err2 != nil {
-				cblog.Error(err2)
-				return nil, fmt.Errorf(err.Error() + ", " + err2.Error())
-			}
-			// (2) for VPC IID
-			cblog.Info("<<ROLLBACK:TRY:VPC-IID>> " + info.IId.NameId)
-			_, err3 := infostore.DeleteByConditions(&VPCIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, NAME_ID_COLUMN, spiderIId.NameId)
-			if err3 != nil {
-				cblog.Error(err3)
-				return nil, fmt.Errorf(err.Error() + ", " + err3.Error())
-			}
-			// (3) for Subnet IID
-			// delete all subnets of target VPC
-			_, err := infostore.DeleteByConditions(&SubnetIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName, OWNER_VPC_NAME_COLUMN, info.IId.NameId)
-			if err != nil {
-				cblog.Error(err)
-				return nil, err
-			}
-			cblog.Error(err)
-			return nil, err
+			return nil, sg.rollback(err)
 		}
+		sg.done("SUBNET-IID", subnetSpiderIId.NameId, func() error {
+			_, err := infostore.DeleteBy3Conditions(&SubnetIIDInfo{}, CONNECTION_NAME_COLUMN, connectionName,
+				NAME_ID_COLUMN, subnetSpiderIId.NameId, OWNER_VPC_NAME_COLUMN, reqIId.NameId)
+			return err
+		})
 	}
 
 	// (6) create userIID: {reqNameID, driverSystemID}
@@ -565,6 +596,105 @@ func CreateVPC(connectionName string, rsType string, reqInfo cres.VPCReqInfo, ID
 	return &info, nil
 }
 
+// validateVPCIPv6Args checks the optional IPv6 CIDRs of a VPC creation request.
+// IPv6 CIDRs are allowed only when the driver supports the dual-stack.
+func validateVPCIPv6Args(connectionName string, reqInfo cres.VPCReqInfo) error {
+	hasIPv6 := reqInfo.IPv6_CIDR != ""
+	for _, subnetInfo := range reqInfo.SubnetInfoList {
+		if subnetInfo.IPv6_CIDR != "" {
+			hasIPv6 = true
+		}
+	}
+	if !hasIPv6 {
+		return nil
+	}
+
+	err := checkIPv6Capability(connectionName)
+	if err != nil {
+		return err
+	}
+
+	// "auto": the VPC IPv6 CIDR is assigned by the CSP
+	if reqInfo.IPv6_CIDR != "" && !isAutoIPv6CIDR(reqInfo.IPv6_CIDR) {
+		err = validateIPv6CIDR("VPC '"+reqInfo.IId.NameId+"'", reqInfo.IPv6_CIDR)
+		if err != nil {
+			return err
+		}
+		_, vpcNet, _ := net.ParseCIDR(reqInfo.IPv6_CIDR)
+		if prefixLength, _ := vpcNet.Mask.Size(); prefixLength > cdcom.SUBNET_IPV6_PREFIX_LENGTH {
+			return fmt.Errorf("The VPC '%s' IPv6 CIDR '%s' should be /%d or larger!", reqInfo.IId.NameId, reqInfo.IPv6_CIDR, cdcom.SUBNET_IPV6_PREFIX_LENGTH)
+		}
+	}
+	for _, subnetInfo := range reqInfo.SubnetInfoList {
+		if subnetInfo.IPv6_CIDR == "" {
+			continue
+		}
+		err = validateSubnetIPv6CIDR(subnetInfo.IId.NameId, subnetInfo.IPv6_CIDR, reqInfo.IId.NameId, reqInfo.IPv6_CIDR)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "auto": the IPv6 CIDR is assigned by the CSP(VPC) or by the driver from the VPC IPv6 CIDR(Subnet)
+func isAutoIPv6CIDR(cidr string) bool {
+	return strings.EqualFold(cidr, "auto")
+}
+
+// validateSubnetIPv6CIDR checks the IPv6 CIDR of a subnet is a /64 in the IPv6 CIDR of the VPC.
+// The subnet of the VPC with "auto" should be "auto", because the VPC CIDR is unknown before the creation.
+func validateSubnetIPv6CIDR(subnetName string, subnetCIDR string, vpcName string, vpcCIDR string) error {
+	if vpcCIDR == "" {
+		return fmt.Errorf("The Subnet '%s' has an IPv6 CIDR, but the VPC '%s' has no IPv6 CIDR!", subnetName, vpcName)
+	}
+	if isAutoIPv6CIDR(subnetCIDR) {
+		return nil
+	}
+	if isAutoIPv6CIDR(vpcCIDR) {
+		return fmt.Errorf("The Subnet '%s' should use the IPv6 CIDR 'auto', because the IPv6 CIDR of the VPC '%s' is 'auto'!", subnetName, vpcName)
+	}
+
+	err := validateIPv6CIDR("Subnet '"+subnetName+"'", subnetCIDR)
+	if err != nil {
+		return err
+	}
+	subnetIP, subnetNet, _ := net.ParseCIDR(subnetCIDR)
+	if prefixLength, _ := subnetNet.Mask.Size(); prefixLength != cdcom.SUBNET_IPV6_PREFIX_LENGTH {
+		return fmt.Errorf("The Subnet '%s' IPv6 CIDR '%s' should be a /%d!", subnetName, subnetCIDR, cdcom.SUBNET_IPV6_PREFIX_LENGTH)
+	}
+	_, vpcNet, err := net.ParseCIDR(vpcCIDR)
+	if err != nil {
+		return fmt.Errorf("The VPC '%s' has an invalid IPv6 CIDR '%s'!", vpcName, vpcCIDR)
+	}
+	if !vpcNet.Contains(subnetIP) {
+		return fmt.Errorf("The Subnet '%s' IPv6 CIDR '%s' is not in the VPC '%s' IPv6 CIDR '%s'!", subnetName, subnetCIDR, vpcName, vpcCIDR)
+	}
+	return nil
+}
+
+// checkIPv6Capability returns an error when the driver of the connection does not support IPv6 dual-stack.
+func checkIPv6Capability(connectionName string) error {
+	drv, err := ccm.GetCloudDriver(connectionName)
+	if err != nil {
+		return err
+	}
+	if !drv.GetDriverCapability().IPV6_DUAL_STACK {
+		providerName, _ := ccm.GetProviderNameByConnectionName(connectionName)
+		return fmt.Errorf("IPv6 dual-stack is not supported by the %s driver!", providerName)
+	}
+	return nil
+}
+
+// validateIPv6CIDR checks whether the cidr is a valid IPv6 CIDR, ex) "2001:db8:1234::/56"
+func validateIPv6CIDR(target string, cidr string) error {
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil || ip.To4() != nil {
+		return fmt.Errorf("The %s has an invalid IPv6 CIDR '%s'!", target, cidr)
+	}
+	return nil
+}
+
 // Get reqNameId from reqIIdZoneList whith driver NameId
 func getSubnetReqNameId(reqIIdZoneList []SubnetReqZoneInfo, driverNameId string) string {
 	for _, reqInfo := range reqIIdZoneList {
@@ -596,6 +726,8 @@ type ResultVPCInfo struct {
 func ListVPC(connectionName string, rsType string) ([]*cres.VPCInfo, error) {
 	cblog.Info("call ListVPC()")
 
+	defer startSpan("ListVPC", connectionName, "").end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -677,7 +809,9 @@ func getVPCInfo(connectionName string, handler cres.VPCHandler, iid cres.IID, re
 
 	vpcSPLock.RLock(connectionName, iid.NameId)
 	// get resource(SystemId)
-	info, err := handler.GetVPC(getDriverIID(iid))
+	info, err := retryCall(connectionName, call.VPCSUBNET, "GetVPC()", func() (cres.VPCInfo, error) {
+		return handler.GetVPC(getDriverIID(iid))
+	})
 	if err != nil {
 		vpcSPLock.RUnlock(connectionName, iid.NameId)
 		cblog.Error(err)
@@ -727,6 +861,8 @@ func getVPCInfo(connectionName string, handler cres.VPCHandler, iid cres.IID, re
 func GetVPC(connectionName string, rsType string, nameID string) (*cres.VPCInfo, error) {
 	cblog.Info("call GetVPC()")
 
+	defer startSpan("GetVPC", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -763,7 +899,9 @@ func GetVPC(connectionName string, rsType string, nameID string) (*cres.VPCInfo,
 	}
 
 	// (2) get resource(driverIID)
-	info, err := handler.GetVPC(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	info, err := retryCall(connectionName, call.VPCSUBNET, "GetVPC()", func() (cres.VPCInfo, error) {
+		return handler.GetVPC(getDriverIID(cres.IID{NameId: iidInfo.NameId, SystemId: iidInfo.SystemId}))
+	})
 	if err != nil {
 		cblog.Error(err)
 		return nil, err
@@ -806,6 +944,8 @@ func GetVPC(connectionName string, rsType string, nameID string) (*cres.VPCInfo,
 func AddSubnet(connectionName string, rsType string, vpcName string, reqInfo cres.SubnetInfo, IDTransformMode string) (*cres.VPCInfo, error) {
 	cblog.Info("call AddSubnet()")
 
+	defer startSpan("AddSubnet", connectionName, vpcName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -831,6 +971,14 @@ func AddSubnet(connectionName string, rsType string, vpcName string, reqInfo cre
 		return nil, err
 	}
 
+	if reqInfo.IPv6_CIDR != "" {
+		err = checkIPv6Capability(connectionName)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
 	vpcSPLock.Lock(connectionName, vpcName)
 	defer vpcSPLock.Unlock(connectionName, vpcName)
 	// (1) check exist(NameID)
@@ -853,6 +1001,22 @@ func AddSubnet(connectionName string, rsType string, vpcName string, reqInfo cre
 		return nil, err
 	}
 
+	// check the IPv6 CIDR of the subnet with the IPv6 CIDR of the VPC
+	if reqInfo.IPv6_CIDR != "" {
+		vpcInfo, err := retryCall(connectionName, call.VPCSUBNET, "GetVPC()", func() (cres.VPCInfo, error) {
+			return handler.GetVPC(getDriverIID(cres.IID{NameId: iidVPCInfo.NameId, SystemId: iidVPCInfo.SystemId}))
+		})
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		err = validateSubnetIPv6CIDR(reqInfo.IId.NameId, reqInfo.IPv6_CIDR, vpcName, vpcInfo.IPv6_CIDR)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+	}
+
 	subnetUUID := ""
 	if GetID_MGMT(IDTransformMode) == "ON" { // Use IID Management
 		subnetUUID, err = iidm.New(connectionName, rsType, reqInfo.IId.NameId)
@@ -956,6 +1120,8 @@ func AddSubnet(connectionName string, rsType string, vpcName string, reqInfo cre
 func RemoveSubnet(connectionName string, vpcName string, nameID string, force string) (bool, error) {
 	cblog.Info("call RemoveSubnet()")
 
+	defer startSpan("RemoveSubnet", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1039,6 +1205,8 @@ func RemoveSubnet(connectionName string, vpcName string, nameID string, force st
 func RemoveCSPSubnet(connectionName string, vpcName string, systemID string) (bool, error) {
 	cblog.Info("call DeleteCSPSubnet()")
 
+	defer startSpan("RemoveCSPSubnet", connectionName, vpcName).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
@@ -1096,6 +1264,8 @@ func RemoveCSPSubnet(connectionName string, vpcName string, systemID string) (bo
 func DeleteVPC(connectionName string, rsType string, nameID string, force string) (bool, error) {
 	cblog.Info("call DeleteeVPC()")
 
+	defer startSpan("DeleteVPC", connectionName, nameID).end()
+
 	// check empty and trim user inputs
 	connectionName, err := EmptyCheckAndTrim("connectionName", connectionName)
 	if err != nil {
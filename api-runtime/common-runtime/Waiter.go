@@ -9,6 +9,8 @@ package commonruntime
 
 import (
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
 )
 
 //============================================
@@ -41,7 +43,10 @@ func (waiter *WAITER)Wait() bool {
 	elapsed := time.Since(waiter.start)
 
 	if int(elapsed.Seconds()) < waiter.Timeout {
+		sp := startSpan("Waiter.Wait", "", "", attribute.Int("spider.waiter.elapsed_sec", int(elapsed.Seconds())),
+			attribute.Int("spider.waiter.sleep_sec", waiter.Sleep), attribute.Int("spider.waiter.timeout_sec", waiter.Timeout))
 		time.Sleep(time.Duration(waiter.Sleep) * time.Second)
+		sp.end()
 		return true // more waiting
 	}
 	return false // stop waiting
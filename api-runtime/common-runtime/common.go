@@ -22,6 +22,7 @@ var ServerPort string
 // REST SERVICE_ADDRESS for AdminWeb since v0.4.4
 var ServiceIPorName string
 var ServicePort string
+var ServiceScheme = "http" // http | https
 
 // GO Service Port
 var GoServicePort string
@@ -12,6 +12,7 @@ import (
         "sync"
         "bytes"
         "fmt"
+        "time"
 )
 
 
@@ -19,6 +20,7 @@ import (
 type SPLOCK struct {
         rwMutex	sync.RWMutex	// lock for handling lockMap
 	lockMap	map[LockKey]*LockValue
+	waitObserver	func(mode string, wait time.Duration)	// ex) metrics of the wait time
 }
 
 type LockKey struct {
@@ -38,6 +40,17 @@ func New() *SPLOCK {
 	return spLock
 }
 
+// SetWaitObserver sets an observer called with the wait time of each Lock(mode: "write") and RLock(mode: "read").
+func (spLock *SPLOCK)SetWaitObserver(observer func(mode string, wait time.Duration)) {
+	spLock.waitObserver = observer
+}
+
+func (spLock *SPLOCK)observeWait(mode string, start time.Time) {
+	if spLock.waitObserver != nil {
+		spLock.waitObserver(mode, time.Since(start))
+	}
+}
+
 func (spLock *SPLOCK)Lock(conn string, id string) {
 spLock.rwMutex.Lock()
 	lockValue := spLock.lockMap[LockKey{conn, id}]
@@ -48,7 +61,9 @@ spLock.rwMutex.Lock()
 	lockValue.count++
 spLock.rwMutex.Unlock()
 
+	start := time.Now()
 	lockValue.lock.Lock()
+	spLock.observeWait("write", start)
 }
 
 func (spLock *SPLOCK)Unlock(conn string, id string) {
@@ -74,7 +89,9 @@ spLock.rwMutex.Lock()
         lockValue.count++
 spLock.rwMutex.Unlock()
 
+        start := time.Now()
         lockValue.lock.RLock()
+        spLock.observeWait("read", start)
 }
 
 func (spLock *SPLOCK)RUnlock(conn string, id string) {
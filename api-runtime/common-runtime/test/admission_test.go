@@ -0,0 +1,77 @@
+// Test for Admission Policy Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func setTestAdmissionRule(t *testing.T, rule cmrt.AdmissionRuleInfo) {
+	t.Helper()
+	if _, err := cmrt.SetAdmissionRule(rule); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cmrt.DeleteAdmissionRule(rule.ConnectionName, rule.RuleName) })
+}
+
+func hasProblem(result *cmrt.DryRunResultInfo, problem string) bool {
+	for _, one := range result.ProblemList {
+		if strings.Contains(one, problem) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDeniedCIDRPort(t *testing.T) {
+	const connectionName = "test-admission-conn"
+	setMockConnection(t, connectionName)
+
+	rule := func(protocol string, fromPort string, toPort string, cidr string) cres.SecurityRuleInfo {
+		return cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: protocol, FromPort: fromPort, ToPort: toPort, CIDR: cidr}
+	}
+
+	testList := []struct {
+		name   string
+		denied string
+		rule   cres.SecurityRuleInfo
+		want   bool
+	}{
+		{"same CIDR", "0.0.0.0/0:22", rule("TCP", "22", "22", "0.0.0.0/0"), true},
+		{"rule CIDR in the denied CIDR", "0.0.0.0/0:22", rule("TCP", "22", "22", "10.0.0.0/8"), true},
+		{"denied CIDR in the rule CIDR", "10.1.0.0/16:22", rule("TCP", "22", "22", "10.0.0.0/8"), true},
+		{"disjoint CIDR", "10.1.0.0/16:22", rule("TCP", "22", "22", "192.168.0.0/16"), false},
+		{"other port", "0.0.0.0/0:22", rule("TCP", "80", "80", "0.0.0.0/0"), false},
+		{"port range", "0.0.0.0/0:22", rule("TCP", "1", "1024", "0.0.0.0/0"), true},
+		{"all ports", "0.0.0.0/0:*", rule("UDP", "53", "53", "172.16.0.0/12"), true},
+		{"ALL protocol", "0.0.0.0/0:3389", rule("ALL", "-1", "-1", "0.0.0.0/0"), true},
+		{"ICMP", "0.0.0.0/0:*", rule("ICMP", "-1", "-1", "0.0.0.0/0"), false},
+		{"IPv6 rule with IPv4 denied", "0.0.0.0/0:22", rule("TCP", "22", "22", "::/0"), false},
+		{"IPv6", "::/0:22", rule("TCP", "22", "22", "2001:db8::/32"), true},
+	}
+	for _, tc := range testList {
+		setTestAdmissionRule(t, cmrt.AdmissionRuleInfo{ConnectionName: connectionName, RuleName: "deny-port",
+			RuleType: cmrt.ADMISSION_DENIED_CIDR_PORT, ValueList: []string{tc.denied}})
+
+		reqInfo := cres.SecurityReqInfo{IId: cres.IID{NameId: "sg-01"}, VpcIID: cres.IID{NameId: "vpc-01"},
+			SecurityRules: &[]cres.SecurityRuleInfo{tc.rule}}
+		result, err := cmrt.DryRunSecurity(connectionName, cmrt.SG, reqInfo)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got := hasProblem(result, "denied by the admission policy"); got != tc.want {
+			t.Errorf("%s: denied(%s, %s) = %v, want %v, problems: %v", tc.name, tc.denied, tc.rule.CIDR, got, tc.want, result.ProblemList)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// Test for Audit Trail Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+func TestGetAuditRequestInfo(t *testing.T) {
+	testList := []struct {
+		name         string
+		body         string
+		resourceType string
+		wantConn     string
+		wantName     string
+		wantMasked   []string // masked values not in the summary
+		wantKept     []string // values in the summary
+	}{
+		{
+			name:         "secret keys",
+			body:         `{"ConnectionName":"aws-config01","ReqInfo":{"Name":"vm-01","VMUserPasswd":"pw-1","KeyPairName":"key-01","SSHKeyPassphrase":"phrase-1"}}`,
+			resourceType: "vm",
+			wantConn:     "aws-config01",
+			wantName:     "vm-01",
+			wantMasked:   []string{"pw-1", "phrase-1"},
+			wantKept:     []string{"key-01"},
+		},
+		{
+			name:         "KeyValue pairs",
+			body:         `{"ConnectionName":"conn","ReqInfo":{"Name":"sg-01","TagList":[{"Key":"ApiToken","Value":"tk-1"},{"Key":"env","Value":"dev"}]}}`,
+			resourceType: "securitygroup",
+			wantConn:     "conn",
+			wantName:     "sg-01",
+			wantMasked:   []string{"tk-1"},
+			wantKept:     []string{"dev"},
+		},
+		{
+			name:         "all KeyValue pairs of the credential",
+			body:         `{"CredentialName":"cred-01","ProviderName":"AWS","KeyValueInfoList":[{"Key":"aws_access_key_id","Value":"AKIA1"},{"Key":"Region","Value":"r-1"}]}`,
+			resourceType: "credential",
+			wantName:     "cred-01",
+			wantMasked:   []string{"AKIA1", "r-1"},
+			wantKept:     []string{"AWS"},
+		},
+	}
+	for _, tc := range testList {
+		info := cmrt.GetAuditRequestInfo([]byte(tc.body), tc.resourceType)
+		if info.ConnectionName != tc.wantConn || info.NameId != tc.wantName {
+			t.Errorf("%s: target = (%s, %s), want (%s, %s)", tc.name, info.ConnectionName, info.NameId, tc.wantConn, tc.wantName)
+		}
+		for _, value := range tc.wantMasked {
+			if strings.Contains(info.RequestSummary, value) {
+				t.Errorf("%s: '%s' is not masked: %s", tc.name, value, info.RequestSummary)
+			}
+		}
+		for _, value := range tc.wantKept {
+			if !strings.Contains(info.RequestSummary, value) {
+				t.Errorf("%s: '%s' is masked: %s", tc.name, value, info.RequestSummary)
+			}
+		}
+	}
+
+	if info := cmrt.GetAuditRequestInfo([]byte("not json"), "vm"); info.RequestSummary != "(not JSON, 8 bytes)" {
+		t.Errorf("not JSON: summary = %s", info.RequestSummary)
+	}
+}
@@ -0,0 +1,133 @@
+// Test for User, API Token and Role Binding Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"reflect"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+func TestAuthorize(t *testing.T) {
+	devOperator := &cmrt.AuthUserInfo{UserName: "dev", RoleBindingList: []cmrt.RoleBindingInfo{
+		{UserName: "dev", Role: cmrt.ROLE_OPERATOR, ConnectionPattern: "aws-dev-*"},
+		{UserName: "dev", Role: cmrt.ROLE_READONLY, ConnectionPattern: "gcp-*"},
+	}}
+	allOperator := &cmrt.AuthUserInfo{UserName: "ops", RoleBindingList: []cmrt.RoleBindingInfo{
+		{UserName: "ops", Role: cmrt.ROLE_OPERATOR, ConnectionPattern: "*"},
+	}}
+	admin := cmrt.NewAdminAuthUser("admin")
+
+	testList := []struct {
+		name     string
+		authUser *cmrt.AuthUserInfo
+		action   string
+		connList []string
+		wantErr  bool
+	}{
+		{"operate a permitted connection", devOperator, cmrt.AUTH_ACTION_OPERATE, []string{"aws-dev-01"}, false},
+		{"operate a read-only connection", devOperator, cmrt.AUTH_ACTION_OPERATE, []string{"gcp-01"}, true},
+		{"read a read-only connection", devOperator, cmrt.AUTH_ACTION_READ, []string{"gcp-01"}, false},
+		{"operate connections with one not permitted", devOperator, cmrt.AUTH_ACTION_OPERATE, []string{"aws-dev-01", "aws-prod-01"}, true},
+		{"read without a connection", devOperator, cmrt.AUTH_ACTION_READ, nil, false},
+		{"operate without a connection", devOperator, cmrt.AUTH_ACTION_OPERATE, nil, true},
+		{"operate without a connection on all connections", allOperator, cmrt.AUTH_ACTION_OPERATE, nil, false},
+		{"administer by an operator of all connections", allOperator, cmrt.AUTH_ACTION_ADMIN, nil, true},
+		{"administer by the admin", admin, cmrt.AUTH_ACTION_ADMIN, nil, false},
+		{"no role binding", &cmrt.AuthUserInfo{UserName: "none"}, cmrt.AUTH_ACTION_READ, nil, true},
+	}
+	for _, tc := range testList {
+		err := tc.authUser.Authorize(tc.action, tc.connList)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+func TestGetAuthAction(t *testing.T) {
+	testList := []struct {
+		resource   string
+		isMutating bool
+		want       string
+	}{
+		{"vm", false, cmrt.AUTH_ACTION_READ},
+		{"vm", true, cmrt.AUTH_ACTION_OPERATE},
+		{"driver", false, cmrt.AUTH_ACTION_READ},
+		{"driver", true, cmrt.AUTH_ACTION_ADMIN},
+		{"CloudDriver", true, cmrt.AUTH_ACTION_ADMIN}, // gRPC CreateCloudDriver
+		{"credential", false, cmrt.AUTH_ACTION_ADMIN},
+		{"calllog", false, cmrt.AUTH_ACTION_ADMIN},
+		{"circuitbreaker", false, cmrt.AUTH_ACTION_ADMIN},
+		{"sshrun", true, cmrt.AUTH_ACTION_ADMIN},
+		{"SSHRun", true, cmrt.AUTH_ACTION_ADMIN}, // gRPC SSHRun
+	}
+	for _, tc := range testList {
+		if got := cmrt.GetAuthAction(tc.resource, tc.isMutating); got != tc.want {
+			t.Errorf("cmrt.GetAuthAction(%s, %v) = %s, want %s", tc.resource, tc.isMutating, got, tc.want)
+		}
+	}
+}
+
+func TestGetRequestConnectionNames(t *testing.T) {
+	testList := []struct {
+		name string
+		body string
+		want []string
+	}{
+		{"ConnectionName", `{"ConnectionName":"conn-01","ReqInfo":{"Name":"vm-01"}}`, []string{"conn-01"}},
+		{"ConnectionNames", `{"ConnectionNames":["conn-01","conn-02"]}`, []string{"conn-01", "conn-02"}},
+		{"Selector.ConnectionNames", `{"Action":"delete","Selector":{"ConnectionNames":["conn-01"]}}`, []string{"conn-01"}},
+		{"infra spec in JSON", `{"ConnectionList":[{"ConnectionName":"conn-01"},{"ConnectionName":"conn-02"}]}`, []string{"conn-01", "conn-02"}},
+		{"infra spec in YAML", "connectionlist:\n  - connectionname: conn-01\n  - connectionname: conn-02\n", []string{"conn-01", "conn-02"}},
+		{"no connection", `{"Selector":{}}`, []string{}},
+	}
+	for _, tc := range testList {
+		if got := cmrt.GetRequestConnectionNames([]byte(tc.body)); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: connections = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+// the first user is the admin of all connections, not to lock everyone out
+func TestCreateFirstUser(t *testing.T) {
+	userList, err := cmrt.ListUser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(userList) > 0 {
+		t.Skip("users are registered already")
+	}
+
+	for _, userName := range []string{"test-first-user", "test-second-user"} {
+		if _, err := cmrt.CreateUser(userName, "password-01"); err != nil {
+			t.Fatal(err)
+		}
+		defer cmrt.DeleteUser(userName)
+	}
+	if !cmrt.IsUserAuthEnabled() {
+		t.Errorf("the user authentication is not enabled")
+	}
+
+	firstUser, err := cmrt.AuthenticateUser("test-first-user", "password-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := firstUser.Authorize(cmrt.AUTH_ACTION_ADMIN, nil); err != nil {
+		t.Errorf("the first user is not the admin: %v", err)
+	}
+
+	secondUser, err := cmrt.AuthenticateUser("test-second-user", "password-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secondUser.RoleBindingList) != 0 {
+		t.Errorf("the second user has role bindings: %v", secondUser.RoleBindingList)
+	}
+}
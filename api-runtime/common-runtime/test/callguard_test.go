@@ -0,0 +1,71 @@
+// Test for Call Guard(Rate Limit, Circuit Breaker) Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+func getConnectionCallLimit(connectionName string) *cmrt.CallLimitInfo {
+	for _, info := range cmrt.ListCallLimit() {
+		if info.Scope == cmrt.CALL_LIMIT_CONNECTION && info.Name == connectionName {
+			return &info
+		}
+	}
+	return nil
+}
+
+func TestCallGuard(t *testing.T) {
+	const connectionName = "test-call-guard-conn"
+	t.Setenv("SPIDER_CONNECTION_RATE_LIMIT", "50")
+	t.Setenv("SPIDER_CONNECTION_MAX_CONCURRENT", "4")
+	t.Setenv("SPIDER_BREAKER_FAILURE_THRESHOLD", "2")
+	setMockConnection(t, connectionName)
+
+	breakerInfo, err := cmrt.GetCircuitBreaker(connectionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakerInfo.State != cmrt.BREAKER_CLOSED || breakerInfo.FailureThreshold != 2 || breakerInfo.ConsecutiveFailures != 0 {
+		t.Errorf("circuit breaker = %+v", *breakerInfo)
+	}
+
+	limitInfo := getConnectionCallLimit(connectionName)
+	if limitInfo == nil {
+		t.Fatalf("no call limit of the connection")
+	}
+	if limitInfo.RateLimit != 50 || limitInfo.MaxConcurrent != 4 || limitInfo.RunningCalls != 0 {
+		t.Errorf("call limit = %+v", *limitInfo)
+	}
+}
+
+// the guard of a connection is created again with the changed connection config
+func TestCallGuardOfChangedConnection(t *testing.T) {
+	const connectionName = "test-call-guard-changed-conn"
+	t.Setenv("SPIDER_BREAKER_FAILURE_THRESHOLD", "2")
+	setMockConnection(t, connectionName)
+	if _, err := cmrt.GetCircuitBreaker(connectionName); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("SPIDER_BREAKER_FAILURE_THRESHOLD", "3")
+	setMockConnection(t, connectionName)
+	if getConnectionCallLimit(connectionName) != nil {
+		t.Errorf("the guard of the changed connection is not dropped")
+	}
+	breakerInfo, err := cmrt.GetCircuitBreaker(connectionName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if breakerInfo.FailureThreshold != 3 {
+		t.Errorf("failure threshold = %d, want 3", breakerInfo.FailureThreshold)
+	}
+}
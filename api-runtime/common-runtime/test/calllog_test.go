@@ -0,0 +1,86 @@
+// Test for Call-Log Store Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+func deleteTestCallLog(t *testing.T, cloudOS string) {
+	db, err := infostore.OpenFile(cmrt.CALLLOG_DB_FILE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer infostore.Close(db)
+	db.Where("cloud_os = ?", cloudOS).Delete(&cmrt.CallLogInfo{})
+}
+
+// waitCallLogCount waits for the background writer to store the call-logs
+func waitCallLogCount(t *testing.T, query cmrt.CallLogQueryInfo, count int) []*cmrt.CallLogInfo {
+	t.Helper()
+	for deadline := time.Now().Add(10 * time.Second); ; time.Sleep(200 * time.Millisecond) {
+		infoList, err := cmrt.ListCallLog(query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(infoList) >= count || time.Now().After(deadline) {
+			return infoList
+		}
+	}
+}
+
+func TestGetCallLogStat(t *testing.T) {
+	if err := cmrt.StartCallLogStore(); err != nil {
+		t.Fatal(err)
+	}
+	const cloudOS = "TESTOS"
+	deleteTestCallLog(t, cloudOS)
+	t.Cleanup(func() { deleteTestCallLog(t, cloudOS) })
+
+	// the call-log of CB-Spider itself is not stored
+	call.Observe(call.CLOUDLOGSCHEMA{CloudOS: cloudOS, CloudOSAPI: cmrt.SPIDER_CALL_LOG_PREFIX + "StartVM()", ElapsedTime: "1"})
+	// RunInstances(): 1 ~ 20 sec, 2 errors
+	for idx := 1; idx <= 20; idx++ {
+		callInfo := call.CLOUDLOGSCHEMA{CloudOS: cloudOS, CloudOSAPI: "RunInstances()", ElapsedTime: strconv.Itoa(idx)}
+		if idx > 18 {
+			callInfo.ErrorMSG = "quota exceeded"
+		}
+		call.Observe(callInfo)
+	}
+	// DescribeInstances(): one call of the unknown elapsed time
+	call.Observe(call.CLOUDLOGSCHEMA{CloudOS: cloudOS, CloudOSAPI: "DescribeInstances()", ElapsedTime: "0.5"})
+	call.Observe(call.CLOUDLOGSCHEMA{CloudOS: cloudOS, CloudOSAPI: "DescribeInstances()", ErrorMSG: "timeout"})
+
+	if infoList := waitCallLogCount(t, cmrt.CallLogQueryInfo{CloudOS: cloudOS}, 22); len(infoList) != 22 {
+		t.Fatalf("call-log count = %d, want 22", len(infoList))
+	}
+
+	statList, err := cmrt.GetCallLogStat(cmrt.CallLogQueryInfo{CloudOS: cloudOS})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []cmrt.CallLogStatInfo{
+		{CloudOS: cloudOS, CloudOSAPI: "DescribeInstances()", Count: 2, ErrorCount: 1, ErrorRate: 0.5, P50Latency: 0.5, P95Latency: 0.5},
+		{CloudOS: cloudOS, CloudOSAPI: "RunInstances()", Count: 20, ErrorCount: 2, ErrorRate: 0.1, P50Latency: 10, P95Latency: 19},
+	}
+	if len(statList) != len(want) {
+		t.Fatalf("stat count = %d, want %d", len(statList), len(want))
+	}
+	for idx, stat := range statList {
+		if *stat != want[idx] {
+			t.Errorf("stat = %+v, want %+v", *stat, want[idx])
+		}
+	}
+}
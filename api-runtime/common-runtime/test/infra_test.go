@@ -0,0 +1,124 @@
+// Test for Infra Spec Plan and Apply Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"testing"
+	"time"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+const testInfraSpec = `
+connectionlist:
+  - connectionname: test-infra-conn
+    vpclist:
+      - name: vpc-infra-01
+        ipv4_cidr: 10.0.0.0/16
+        subnetlist:
+          - { name: subnet-infra-01, ipv4_cidr: 10.0.1.0/24 }
+    securitygrouplist:
+      - name: sg-infra-01
+        vpcname: vpc-infra-01
+        securityrules:
+          - { direction: inbound, ipprotocol: TCP, fromport: "22", toport: "22", cidr: 0.0.0.0/0 }
+          - { direction: outbound, ipprotocol: ALL, cidr: 0.0.0.0/0 }
+`
+
+// the changed VPC CIDR is a conflict, and blocks the SG of the VPC
+const testInfraConflictSpec = `
+connectionlist:
+  - connectionname: test-infra-conn
+    vpclist:
+      - name: vpc-infra-01
+        ipv4_cidr: 10.1.0.0/16
+        subnetlist:
+          - { name: subnet-infra-01, ipv4_cidr: 10.1.1.0/24 }
+    securitygrouplist:
+      - name: sg-infra-02
+        vpcname: vpc-infra-01
+`
+
+func planInfra(t *testing.T, specData string) []*cmrt.InfraPlanStepInfo {
+	t.Helper()
+	spec, err := cmrt.ParseInfraSpec([]byte(specData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stepList, err := cmrt.PlanInfra(*spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stepList
+}
+
+func applyInfra(t *testing.T, specData string) *cmrt.InfraApplyJobInfo {
+	t.Helper()
+	spec, err := cmrt.ParseInfraSpec([]byte(specData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	job, err := cmrt.ApplyInfra(*spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for deadline := time.Now().Add(30 * time.Second); job.Status == cmrt.INFRA_JOB_RUNNING; time.Sleep(100 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("the job %s is still running", job.JobId)
+		}
+		if job, err = cmrt.GetInfraApplyJob(job.JobId); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return job
+}
+
+func checkStepActions(t *testing.T, stepList []*cmrt.InfraPlanStepInfo, want map[string]string) {
+	t.Helper()
+	if len(stepList) != len(want) {
+		t.Errorf("step count = %d, want %d", len(stepList), len(want))
+	}
+	for _, step := range stepList {
+		if want[step.Name] != step.Action {
+			t.Errorf("step %s: action = %s, want %s, diff: %v", step.Name, step.Action, want[step.Name], step.DiffList)
+		}
+	}
+}
+
+func TestApplyInfra(t *testing.T) {
+	const connectionName = "test-infra-conn"
+	setMockConnection(t, connectionName)
+	t.Cleanup(func() {
+		cmrt.DeleteSecurity(connectionName, cmrt.SG, "sg-infra-01", "true")
+		cmrt.DeleteSecurity(connectionName, cmrt.SG, "sg-infra-02", "true")
+		cmrt.DeleteVPC(connectionName, cmrt.VPC, "vpc-infra-01", "true")
+	})
+
+	checkStepActions(t, planInfra(t, testInfraSpec), map[string]string{
+		"vpc-infra-01": cmrt.INFRA_CREATE, "sg-infra-01": cmrt.INFRA_CREATE})
+
+	job := applyInfra(t, testInfraSpec)
+	if job.Status != cmrt.INFRA_JOB_SUCCEEDED {
+		t.Fatalf("job status = %s, want %s", job.Status, cmrt.INFRA_JOB_SUCCEEDED)
+	}
+
+	// the applied spec has nothing to change, including the rule of all ports
+	checkStepActions(t, planInfra(t, testInfraSpec), map[string]string{
+		"vpc-infra-01": cmrt.INFRA_NOOP, "sg-infra-01": cmrt.INFRA_NOOP})
+
+	checkStepActions(t, planInfra(t, testInfraConflictSpec), map[string]string{
+		"vpc-infra-01": cmrt.INFRA_CONFLICT, "sg-infra-02": cmrt.INFRA_CREATE})
+	job = applyInfra(t, testInfraConflictSpec)
+	if job.Status != cmrt.INFRA_JOB_FAILED {
+		t.Errorf("job status = %s, want %s", job.Status, cmrt.INFRA_JOB_FAILED)
+	}
+	if _, err := cmrt.GetSecurity(connectionName, cmrt.SG, "sg-infra-02"); err == nil {
+		t.Errorf("the SG of the conflicting VPC is created")
+	}
+}
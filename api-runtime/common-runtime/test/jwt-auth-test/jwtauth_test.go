@@ -0,0 +1,178 @@
+// Test for JWT Authentication Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package jwtauthtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// The JWT config is loaded from the env once, so all tests share an HMAC key and an OIDC issuer.
+const testHMACKey = "test-hmac-key"
+const testAudience = "cb-spider"
+
+var (
+	issuerURL string
+	issuerKey *rsa.PrivateKey
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	issuerKey, err = rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	issuer := newTestOIDCIssuer("key-01", issuerKey)
+	issuerURL = issuer.URL
+
+	os.Setenv("SPIDER_JWT_HMAC_KEY", testHMACKey)
+	os.Setenv("SPIDER_OIDC_ISSUER", issuerURL)
+	os.Setenv("SPIDER_JWT_AUDIENCE", testAudience)
+
+	code := m.Run()
+	issuer.Close()
+	os.Exit(code)
+}
+
+// newTestOIDCIssuer starts an OIDC issuer with the JWKS of the RSA key
+func newTestOIDCIssuer(kid string, key *rsa.PrivateKey) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": []map[string]string{{
+			"kid": kid, "kty": "RSA", "use": "sig",
+			"n": base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e": base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	return server
+}
+
+func newTestClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss":                issuerURL,
+		"aud":                testAudience,
+		"sub":                "alice",
+		"exp":                time.Now().Add(time.Hour).Unix(),
+		"spider_roles":       []string{"operator"},
+		"spider_connections": []string{"aws-dev-*"},
+	}
+}
+
+func signHS256(t *testing.T, key string, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func signRS256(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	tokenString, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokenString
+}
+
+func TestAuthenticateJWTHMAC(t *testing.T) {
+	if !cmrt.IsJWTAuthEnabled() {
+		t.Fatalf("the JWT authentication is not enabled")
+	}
+
+	withClaims := func(update func(claims jwt.MapClaims)) string {
+		claims := newTestClaims()
+		update(claims)
+		return signHS256(t, testHMACKey, claims)
+	}
+
+	testList := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"valid", withClaims(func(claims jwt.MapClaims) {}), false},
+		{"expired", withClaims(func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Minute).Unix() }), true},
+		{"without exp", withClaims(func(claims jwt.MapClaims) { delete(claims, "exp") }), true},
+		{"other issuer", withClaims(func(claims jwt.MapClaims) { claims["iss"] = "https://other.example.com" }), true},
+		{"without issuer", withClaims(func(claims jwt.MapClaims) { delete(claims, "iss") }), true},
+		{"other audience", withClaims(func(claims jwt.MapClaims) { claims["aud"] = "other" }), true},
+		{"other key", signHS256(t, "other-key", newTestClaims()), true},
+	}
+	for _, tc := range testList {
+		authUser, err := cmrt.AuthenticateJWT(tc.token)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: err = %v, wantErr %v", tc.name, err, tc.wantErr)
+			continue
+		}
+		if err == nil && (authUser.UserName != "alice" || len(authUser.RoleBindingList) != 1 ||
+			authUser.RoleBindingList[0].Role != cmrt.ROLE_OPERATOR || authUser.RoleBindingList[0].ConnectionPattern != "aws-dev-*") {
+			t.Errorf("%s: user = %+v", tc.name, authUser)
+		}
+	}
+}
+
+// the user without the connection claim is not permitted any connection
+func TestAuthenticateJWTWithoutConnectionClaim(t *testing.T) {
+	claims := newTestClaims()
+	delete(claims, "spider_connections")
+	authUser, err := cmrt.AuthenticateJWT(signHS256(t, testHMACKey, claims))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := authUser.Authorize(cmrt.AUTH_ACTION_READ, []string{"aws-dev-01"}); err == nil {
+		t.Errorf("the user without the connection claim is permitted: %+v", authUser)
+	}
+}
+
+func TestAuthenticateJWTJWKS(t *testing.T) {
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := newTestClaims()
+
+	authUser, err := cmrt.AuthenticateJWT(signRS256(t, "key-01", issuerKey, claims))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if authUser.UserName != "alice" {
+		t.Errorf("user = %s, want alice", authUser.UserName)
+	}
+
+	if _, err := cmrt.AuthenticateJWT(signRS256(t, "key-01", otherKey, claims)); err == nil {
+		t.Errorf("the token signed with the other key is valid")
+	}
+	if _, err := cmrt.AuthenticateJWT(signRS256(t, "key-02", issuerKey, claims)); err == nil {
+		t.Errorf("the token of the unknown key id is valid")
+	}
+	claims["aud"] = "other"
+	if _, err := cmrt.AuthenticateJWT(signRS256(t, "key-01", issuerKey, claims)); err == nil || !strings.Contains(err.Error(), "audience") {
+		t.Errorf("err = %v, want the audience error", err)
+	}
+}
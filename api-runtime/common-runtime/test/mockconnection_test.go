@@ -0,0 +1,50 @@
+// Test Connection of the Mock Driver for Common Runtime Tests of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"testing"
+
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	ccim "github.com/cloud-barista/cb-spider/cloud-info-manager/connection-config-info-manager"
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+	dim "github.com/cloud-barista/cb-spider/cloud-info-manager/driver-info-manager"
+	rim "github.com/cloud-barista/cb-spider/cloud-info-manager/region-info-manager"
+)
+
+const MOCK_PROVIDER = "MOCK"
+
+// setMockConnection registers a connection of the Mock Driver.
+// The Mock Driver keeps the resources by the MockName, so each connection has its own resources.
+func setMockConnection(t *testing.T, connectionName string) {
+	t.Helper()
+
+	driverName := connectionName + "-driver"
+	credentialName := connectionName + "-credential"
+	regionName := connectionName + "-region"
+
+	// the infos of a previous test run
+	ccim.DeleteConnectionConfig(connectionName)
+	rim.UnRegisterRegion(regionName)
+	cim.UnRegisterCredential(credentialName)
+	dim.UnRegisterCloudDriver(driverName)
+
+	if _, err := dim.RegisterCloudDriver(driverName, MOCK_PROVIDER, "mock-driver-v1.0.so"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cim.RegisterCredential(credentialName, MOCK_PROVIDER, []cres.KeyValue{{Key: "MockName", Value: connectionName}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rim.RegisterRegion(regionName, MOCK_PROVIDER, []cres.KeyValue{{Key: "Region", Value: "default"}}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ccim.CreateConnectionConfig(connectionName, MOCK_PROVIDER, driverName, credentialName, regionName); err != nil {
+		t.Fatal(err)
+	}
+}
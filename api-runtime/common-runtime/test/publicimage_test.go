@@ -0,0 +1,89 @@
+// Test for Public Image Handler of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	mockdrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/drivers/mock"
+	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+// addMockImages adds the images without the OS attributes to the Mock Driver of the connection
+func addMockImages(t *testing.T, connectionName string, nameList ...string) {
+	t.Helper()
+	cloudConn, err := (&mockdrv.MockDriver{}).ConnectCloud(idrv.ConnectionInfo{CredentialInfo: idrv.CredentialInfo{MockName: connectionName}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	imageHandler, err := cloudConn.CreateImageHandler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range nameList {
+		if _, err := imageHandler.CreateImage(cres.ImageReqInfo{IId: cres.IID{NameId: name}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func searchImageMap(t *testing.T, connectionName string, filter cmrt.ImageFilterInfo, refresh bool) map[string]*cres.ImageInfo {
+	t.Helper()
+	resultList, err := cmrt.SearchImage(connectionName, cmrt.IMAGE, filter, refresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resultMap := map[string]*cres.ImageInfo{}
+	for _, info := range resultList {
+		resultMap[info.IId.NameId] = info
+	}
+	return resultMap
+}
+
+func TestSearchImage(t *testing.T) {
+	const connectionName = "test-search-image-conn"
+	setMockConnection(t, connectionName)
+	t.Cleanup(func() { cmrt.ClearImageIndex(connectionName) })
+
+	const ubuntuImage = "ubuntu/images/hvm-ssd/ubuntu-jammy-22.04-amd64-server-20240207"
+	const gpuImage = "Deep Learning AMI GPU CUDA 11 (Ubuntu 20.04) x86_64"
+	const customImage = "my-custom-image"
+	addMockImages(t, connectionName, ubuntuImage, gpuImage, customImage)
+
+	// the OS attributes are set from the image names, and the architecture of the filter is normalized
+	resultMap := searchImageMap(t, connectionName, cmrt.ImageFilterInfo{OSDistribution: "Ubuntu", OSArchitecture: "AMD64"}, true)
+	for _, name := range []string{"mock-vmimage-01", "mock-vmimage-03", ubuntuImage, gpuImage} {
+		if _, ok := resultMap[name]; !ok {
+			t.Errorf("%s is not searched: %v", name, resultMap)
+		}
+	}
+	if len(resultMap) != 4 {
+		t.Errorf("result count = %d, want 4", len(resultMap))
+	}
+	if info := resultMap[ubuntuImage]; info != nil && (info.OSVersion != "22.04" || info.OSArchitecture != "x86_64" || info.OSPlatform != "Linux/UNIX") {
+		t.Errorf("%s: %+v", ubuntuImage, *info)
+	}
+	if info := resultMap[gpuImage]; info != nil && (info.OSVersion != "20.04" || !info.GPUReady) {
+		t.Errorf("%s: %+v", gpuImage, *info)
+	}
+
+	resultMap = searchImageMap(t, connectionName, cmrt.ImageFilterInfo{OSDistribution: "ubuntu", OSVersion: "22", OSArchitecture: "aarch64"}, false)
+	if _, ok := resultMap["mock-vmimage-02"]; !ok || len(resultMap) != 1 {
+		t.Fatalf("arm64 images = %v, want mock-vmimage-02", resultMap)
+	}
+
+	// the cached index is not changed by the caller
+	resultMap["mock-vmimage-02"].OSVersion = "changed"
+	resultMap = searchImageMap(t, connectionName, cmrt.ImageFilterInfo{OSVersion: "22.04", OSArchitecture: "arm64"}, false)
+	if _, ok := resultMap["mock-vmimage-02"]; !ok {
+		t.Errorf("the cached image is changed by the caller")
+	}
+}
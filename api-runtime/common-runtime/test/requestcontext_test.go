@@ -0,0 +1,82 @@
+// Test for Request Context(Request ID) Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"context"
+	"testing"
+
+	cblogger "github.com/cloud-barista/cb-log"
+	"github.com/sirupsen/logrus"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// captureLogHook keeps the fields of the last log entry
+type captureLogHook struct {
+	data logrus.Fields
+}
+
+func (hook *captureLogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (hook *captureLogHook) Fire(entry *logrus.Entry) error {
+	hook.data = entry.Data
+	return nil
+}
+
+func TestRequestIDLogField(t *testing.T) {
+	logger := cblogger.GetLogger("CLOUD-BARISTA")
+	savedHooks := make(logrus.LevelHooks)
+	for level, hookList := range logger.Hooks {
+		savedHooks[level] = hookList
+	}
+	defer logger.ReplaceHooks(savedHooks)
+
+	// the hook of the Request ID is applied first
+	hook := &captureLogHook{}
+	logger.AddHook(hook)
+
+	// no request is bound
+	logger.Error("no request")
+	if _, ok := hook.data[cmrt.REQUEST_ID_LOG_FIELD]; ok {
+		t.Errorf("Request ID without a request: %v", hook.data)
+	}
+
+	// the Request ID given with the entry
+	logger.WithContext(cmrt.WithRequestID(context.Background(), "req-explicit")).Error("explicit request")
+	if got := hook.data[cmrt.REQUEST_ID_LOG_FIELD]; got != "req-explicit" {
+		t.Errorf("explicit Request ID = %v, want req-explicit", got)
+	}
+
+	// the Request ID of the goroutine
+	reqCtx := cmrt.WithRequestID(context.Background(), "req-bound")
+	unbind := cmrt.BindRequestContext(reqCtx)
+	logger.Error("bound request")
+	if got := hook.data[cmrt.REQUEST_ID_LOG_FIELD]; got != "req-bound" {
+		t.Errorf("bound Request ID = %v, want req-bound", got)
+	}
+
+	// the goroutines started for the request bind the context again
+	done := make(chan [2]string)
+	go func() {
+		notBound := cmrt.GetRequestID()
+		defer cmrt.BindRequestContext(reqCtx)()
+		done <- [2]string{notBound, cmrt.GetRequestID()}
+	}()
+	if got := <-done; got[0] != "" || got[1] != "req-bound" {
+		t.Errorf("Request ID of the child goroutine = %q before and %q after the binding, want \"\" and req-bound", got[0], got[1])
+	}
+
+	unbind()
+	if got := cmrt.GetRequestID(); got != "" {
+		t.Errorf("Request ID after the unbinding = %q", got)
+	}
+}
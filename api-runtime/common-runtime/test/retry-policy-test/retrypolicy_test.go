@@ -0,0 +1,50 @@
+// Test for Retry Policy Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package retrypolicytest
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// The retry policy is loaded from the env once, at the first call of GetRetryPolicy().
+func TestMain(m *testing.M) {
+	os.Setenv("SPIDER_RETRY_MAX_ATTEMPTS", "5")
+	os.Setenv("SPIDER_RETRY_BASE_DELAY", "500ms")
+	os.Setenv("SPIDER_RETRY_MAX_DELAY", "-1s")
+	os.Setenv("SPIDER_RETRY_ERROR_KINDS", "Throttling, network, quota")
+	os.Exit(m.Run())
+}
+
+func TestGetRetryPolicy(t *testing.T) {
+	policy := cmrt.GetRetryPolicy()
+
+	if policy.MaxAttempts != 5 || policy.BaseDelay != 500*time.Millisecond {
+		t.Errorf("policy = %+v, want 5 attempts from 500ms", policy)
+	}
+	// the invalid value is replaced by the default
+	if policy.MaxDelay != cmrt.DEFAULT_RETRY_MAX_DELAY {
+		t.Errorf("max delay = %v, want the default %v", policy.MaxDelay, cmrt.DEFAULT_RETRY_MAX_DELAY)
+	}
+	// the unknown kind is ignored
+	want := []string{cmrt.RETRY_THROTTLING, cmrt.RETRY_NETWORK}
+	if got := fmt.Sprint(policy.ErrorKindList); got != fmt.Sprint(want) {
+		t.Errorf("error kinds = %s, want %v", got, want)
+	}
+
+	// the policy is not changed with the env after the first call
+	os.Setenv("SPIDER_RETRY_MAX_ATTEMPTS", "1")
+	if got := cmrt.GetRetryPolicy().MaxAttempts; got != 5 {
+		t.Errorf("max attempts = %d after the env change, want 5", got)
+	}
+}
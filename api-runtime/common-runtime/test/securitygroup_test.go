@@ -0,0 +1,143 @@
+// Test for Security Group Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func tcpRule(port string, description string) cres.SecurityRuleInfo {
+	return cres.SecurityRuleInfo{Direction: "inbound", IPProtocol: "TCP", FromPort: port, ToPort: port,
+		CIDR: "0.0.0.0/0", Description: description}
+}
+
+func hasRule(ruleList []cres.SecurityRuleInfo, rule cres.SecurityRuleInfo) bool {
+	for _, one := range ruleList {
+		if strings.EqualFold(one.IPProtocol, rule.IPProtocol) && one.FromPort == rule.FromPort &&
+			one.CIDR == rule.CIDR && one.Description == rule.Description {
+			return true
+		}
+	}
+	return false
+}
+
+// createTestSecurity creates a VPC and a SG of the rules, deleted at the end of the test
+func createTestSecurity(t *testing.T, connectionName string, sgName string, ruleList ...cres.SecurityRuleInfo) {
+	t.Helper()
+	vpcName := sgName + "-vpc"
+	if _, err := cmrt.CreateVPC(connectionName, cmrt.VPC, newTestVPCReqInfo(vpcName, nil), "ON"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cmrt.DeleteVPC(connectionName, cmrt.VPC, vpcName, "true") })
+
+	reqInfo := cres.SecurityReqInfo{IId: cres.IID{NameId: sgName}, VpcIID: cres.IID{NameId: vpcName}, SecurityRules: &ruleList}
+	if _, err := cmrt.CreateSecurity(connectionName, cmrt.SG, reqInfo, "ON"); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cmrt.DeleteSecurity(connectionName, cmrt.SG, sgName, "true") })
+}
+
+func TestReplaceRules(t *testing.T) {
+	const connectionName = "test-replace-rules-conn"
+	setMockConnection(t, connectionName)
+	createTestSecurity(t, connectionName, "sg-01", tcpRule("22", "ssh"), tcpRule("80", "web"), tcpRule("443", "tls"))
+
+	// the rule changing only the description is replaced
+	desired := []cres.SecurityRuleInfo{tcpRule("22", "ssh"), tcpRule("80", "http"), tcpRule("8080", "app")}
+	result, err := cmrt.ReplaceRules(connectionName, "sg-01", desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.AddedRules) != 2 || len(result.RemovedRules) != 2 || len(result.UnchangedRules) != 1 {
+		t.Errorf("added: %v, removed: %v, unchanged: %v", result.AddedRules, result.RemovedRules, result.UnchangedRules)
+	}
+
+	sgInfo, err := cmrt.GetSecurity(connectionName, cmrt.SG, "sg-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*sgInfo.SecurityRules) != len(desired) {
+		t.Errorf("rules = %v, want %v", *sgInfo.SecurityRules, desired)
+	}
+	for _, rule := range desired {
+		if !hasRule(*sgInfo.SecurityRules, rule) {
+			t.Errorf("rule %v is not in %v", rule, *sgInfo.SecurityRules)
+		}
+	}
+}
+
+// the added rules are admitted, and the SG is not changed when they are denied
+func TestReplaceRulesAdmission(t *testing.T) {
+	const connectionName = "test-replace-rules-admission-conn"
+	setMockConnection(t, connectionName)
+	createTestSecurity(t, connectionName, "sg-01", tcpRule("80", "web"))
+	setTestAdmissionRule(t, cmrt.AdmissionRuleInfo{ConnectionName: connectionName, RuleName: "deny-ssh",
+		RuleType: cmrt.ADMISSION_DENIED_CIDR_PORT, ValueList: []string{"0.0.0.0/0:22"}})
+
+	_, err := cmrt.ReplaceRules(connectionName, "sg-01", []cres.SecurityRuleInfo{tcpRule("22", "ssh")})
+	if err == nil || !strings.Contains(err.Error(), "denied by the admission policy") {
+		t.Fatalf("error = %v, want denied", err)
+	}
+
+	sgInfo, err := cmrt.GetSecurity(connectionName, cmrt.SG, "sg-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(*sgInfo.SecurityRules) != 1 || !hasRule(*sgInfo.SecurityRules, tcpRule("80", "web")) {
+		t.Errorf("rules = %v, want unchanged", *sgInfo.SecurityRules)
+	}
+}
+
+func TestPeerSGRules(t *testing.T) {
+	const connectionName = "test-peer-sg-rules-conn"
+	setMockConnection(t, connectionName)
+	createTestSecurity(t, connectionName, "sg-web", tcpRule("80", "web"))
+
+	peerRule := tcpRule("22", "ssh")
+	peerRule.CIDR = ""
+	peerRule.PeerSecurityGroupIID = &cres.IID{NameId: "sg-web"}
+	peerAndCIDR := peerRule
+	peerAndCIDR.CIDR = "10.0.0.0/16"
+	emptyPeer := peerRule
+	emptyPeer.PeerSecurityGroupIID = &cres.IID{NameId: ""}
+	unknownPeer := peerRule
+	unknownPeer.PeerSecurityGroupIID = &cres.IID{NameId: "sg-unknown"}
+
+	testList := []struct {
+		name     string
+		ruleList []cres.SecurityRuleInfo
+		wantErr  string
+	}{
+		{"peer rule", []cres.SecurityRuleInfo{tcpRule("80", "web"), peerRule}, ""},
+		{"peer and CIDR", []cres.SecurityRuleInfo{peerAndCIDR}, "only one of"},
+		{"empty peer name", []cres.SecurityRuleInfo{emptyPeer}, "PeerSecurityGroupIID.NameId"},
+		{"unknown peer", []cres.SecurityRuleInfo{unknownPeer}, "sg-unknown"},
+	}
+	for _, tc := range testList {
+		reqInfo := cres.SecurityReqInfo{IId: cres.IID{NameId: "sg-02"}, VpcIID: cres.IID{NameId: "sg-web-vpc"}, SecurityRules: &tc.ruleList}
+		result, err := cmrt.DryRunSecurity(connectionName, cmrt.SG, reqInfo)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if tc.wantErr == "" {
+			if !result.Valid {
+				t.Errorf("%s: problems = %v", tc.name, result.ProblemList)
+			}
+			continue
+		}
+		if !hasProblem(result, tc.wantErr) {
+			t.Errorf("%s: problems = %v, want %q", tc.name, result.ProblemList, tc.wantErr)
+		}
+	}
+}
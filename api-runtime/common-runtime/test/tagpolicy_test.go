@@ -0,0 +1,93 @@
+// Test for Tag Policy Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"strings"
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func setTestTagPolicy(t *testing.T, policy cmrt.TagPolicyInfo) {
+	t.Helper()
+	if _, err := cmrt.SetTagPolicy(policy); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cmrt.DeleteTagPolicy(policy.ConnectionName) })
+}
+
+func newTestVPCReqInfo(name string, tagList []cres.KeyValue) cres.VPCReqInfo {
+	return cres.VPCReqInfo{
+		IId:       cres.IID{NameId: name},
+		IPv4_CIDR: "10.0.0.0/16",
+		SubnetInfoList: []cres.SubnetInfo{
+			{IId: cres.IID{NameId: name + "-subnet"}, IPv4_CIDR: "10.0.1.0/24"},
+		},
+		TagList: tagList,
+	}
+}
+
+func TestTagPolicy(t *testing.T) {
+	const connectionName = "test-tag-policy-conn"
+	const otherConnectionName = "test-tag-policy-other-conn"
+	setMockConnection(t, connectionName)
+	setMockConnection(t, otherConnectionName)
+
+	setTestTagPolicy(t, cmrt.TagPolicyInfo{
+		ConnectionName:  cmrt.GLOBAL_TAG_POLICY,
+		DefaultTagList:  []cres.KeyValue{{Key: "created-by", Value: "cb-spider"}, {Key: "env", Value: "global"}},
+		RequiredKeyList: []string{"owner"},
+	})
+	setTestTagPolicy(t, cmrt.TagPolicyInfo{
+		ConnectionName:  connectionName,
+		DefaultTagList:  []cres.KeyValue{{Key: "env", Value: "conn"}, {Key: "team", Value: "infra"}},
+		RequiredKeyList: []string{"owner", "cost-center"},
+	})
+
+	// the default tags are merged with the precedence: request > connection > global
+	tagList := []cres.KeyValue{{Key: "owner", Value: "alice"}, {Key: "cost-center", Value: "cc-1"}, {Key: "team", Value: "app"}}
+	vpcInfo, err := cmrt.CreateVPC(connectionName, cmrt.VPC, newTestVPCReqInfo("vpc-tag-01", tagList), "ON")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cmrt.DeleteVPC(connectionName, cmrt.VPC, "vpc-tag-01", "true") })
+
+	want := map[string]string{"created-by": "cb-spider", "env": "conn", "team": "app", "owner": "alice", "cost-center": "cc-1"}
+	if len(vpcInfo.TagList) != len(want) {
+		t.Errorf("tags = %v, want %v", vpcInfo.TagList, want)
+	}
+	for _, tag := range vpcInfo.TagList {
+		if want[tag.Key] != tag.Value {
+			t.Errorf("tag %s = %s, want %s", tag.Key, tag.Value, want[tag.Key])
+		}
+	}
+
+	testList := []struct {
+		name           string
+		connectionName string
+		tagList        []cres.KeyValue
+		missingKeys    []string
+	}{
+		{"missing required keys", connectionName, []cres.KeyValue{{Key: "env", Value: "prod"}}, []string{"owner", "cost-center"}},
+		{"empty required value", connectionName, []cres.KeyValue{{Key: "owner", Value: " "}, {Key: "cost-center", Value: "cc-1"}}, []string{"owner"}},
+		{"global policy of the other connection", otherConnectionName, nil, []string{"owner"}},
+	}
+	for _, tc := range testList {
+		result, err := cmrt.DryRunVPC(tc.connectionName, cmrt.VPC, newTestVPCReqInfo("vpc-tag-02", tc.tagList))
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if !hasProblem(result, "["+strings.Join(tc.missingKeys, ", ")+"]") {
+			t.Errorf("%s: problems = %v, want missing %v", tc.name, result.ProblemList, tc.missingKeys)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Test for VPC and Subnet Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"testing"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+)
+
+func TestSubnetIPv6CIDR(t *testing.T) {
+	const connectionName = "test-vpc-ipv6-conn"
+	setMockConnection(t, connectionName)
+
+	testList := []struct {
+		name       string
+		subnetCIDR string
+		vpcCIDR    string
+		wantErr    bool
+	}{
+		{"in the VPC", "2001:db8:1234:ab01::/64", "2001:db8:1234:ab00::/56", false},
+		{"auto", "auto", "2001:db8:1234:ab00::/56", false},
+		{"auto of auto VPC", "auto", "auto", false},
+		{"explicit of auto VPC", "2001:db8:1234:ab01::/64", "auto", true},
+		{"no VPC IPv6", "2001:db8:1234:ab01::/64", "", true},
+		{"not /64", "2001:db8:1234:ab00::/60", "2001:db8:1234:ab00::/56", true},
+		{"out of the VPC", "2001:db8:1234:cd00::/64", "2001:db8:1234:ab00::/56", true},
+		{"IPv4", "10.0.0.0/24", "2001:db8:1234:ab00::/56", true},
+		{"VPC smaller than a subnet", "auto", "2001:db8:1234:ab00::/72", true},
+	}
+	for _, tc := range testList {
+		reqInfo := cres.VPCReqInfo{
+			IId:       cres.IID{NameId: "vpc-01"},
+			IPv4_CIDR: "10.0.0.0/16",
+			IPv6_CIDR: tc.vpcCIDR,
+			SubnetInfoList: []cres.SubnetInfo{
+				{IId: cres.IID{NameId: "subnet-01"}, IPv4_CIDR: "10.0.1.0/24", IPv6_CIDR: tc.subnetCIDR},
+			},
+		}
+		result, err := cmrt.DryRunVPC(connectionName, cmrt.VPC, reqInfo)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if result.Valid == tc.wantErr {
+			t.Errorf("%s: subnet %s of VPC %s: problems %v, wantErr %v", tc.name, tc.subnetCIDR, tc.vpcCIDR, result.ProblemList, tc.wantErr)
+		}
+	}
+}
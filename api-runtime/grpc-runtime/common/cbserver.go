@@ -21,7 +21,12 @@ import (
 	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/jaegertracer"
 
 	grpc_accesslog "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/accesslog"
+	grpc_audit "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/audit"
 	grpc_authjwt "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/authjwt"
+	grpc_authrbac "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/authrbac"
+	grpc_authtoken "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/authtoken"
+	grpc_idempotency "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/idempotency"
+	grpc_requestid "github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/interceptors/requestid"
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
@@ -79,17 +84,31 @@ func NewCBServer(gConf *config.GrpcServerConfig) (*CBServer, io.Closer, error) {
 	unaryIntercepters := []grpc.UnaryServerInterceptor{}
 	streamIntercepters := []grpc.StreamServerInterceptor{}
 
+	// Request ID 인터셉터 기본 설정 (access 로그보다 앞에 두어 access 로그에도 Request ID 기록)
+	unaryIntercepters = append(unaryIntercepters, grpc_requestid.UnaryServerInterceptor())
+	streamIntercepters = append(streamIntercepters, grpc_requestid.StreamServerInterceptor())
+
 	// access 로그 인터셉터 기본 설정
 	unaryIntercepters = append(unaryIntercepters, grpc_accesslog.UnaryServerInterceptor())
 	streamIntercepters = append(streamIntercepters, grpc_accesslog.StreamServerInterceptor())
 
-	if gConf.Interceptors != nil {
+	// audit 인터셉터 기본 설정 (인증보다 앞에 두어 인증에 실패한 생성, 삭제, 제어 호출도 기록)
+	unaryIntercepters = append(unaryIntercepters, grpc_audit.UnaryServerInterceptor())
 
-		// AuthJWT 인터셉터 설정
-		if gConf.Interceptors.AuthJWT != nil {
-			unaryIntercepters = append(unaryIntercepters, grpc_authjwt.UnaryServerInterceptor(gConf.Interceptors.AuthJWT.JWTKey))
-			streamIntercepters = append(streamIntercepters, grpc_authjwt.StreamServerInterceptor(gConf.Interceptors.AuthJWT.JWTKey))
-		}
+	// AuthJWT 인터셉터 설정, 설정이 없으면 사용자 API 토큰 인터셉터 설정 (사용자가 등록된 경우에 인증)
+	if gConf.Interceptors != nil && gConf.Interceptors.AuthJWT != nil {
+		unaryIntercepters = append(unaryIntercepters, grpc_authjwt.UnaryServerInterceptor(gConf.Interceptors.AuthJWT.JWTKey))
+		streamIntercepters = append(streamIntercepters, grpc_authjwt.StreamServerInterceptor(gConf.Interceptors.AuthJWT.JWTKey))
+	} else {
+		unaryIntercepters = append(unaryIntercepters, grpc_authtoken.UnaryServerInterceptor())
+		streamIntercepters = append(streamIntercepters, grpc_authtoken.StreamServerInterceptor())
+	}
+
+	// 권한 확인 인터셉터 기본 설정 (인증 인터셉터 다음에 두어 인증 방식과 무관하게 role binding 으로 권한 확인)
+	unaryIntercepters = append(unaryIntercepters, grpc_authrbac.UnaryServerInterceptor())
+	streamIntercepters = append(streamIntercepters, grpc_authrbac.StreamServerInterceptor())
+
+	if gConf.Interceptors != nil {
 
 		// Opentracing 인터셉터 설정
 		if gConf.Interceptors.Opentracing != nil {
@@ -120,6 +139,9 @@ func NewCBServer(gConf *config.GrpcServerConfig) (*CBServer, io.Closer, error) {
 
 	}
 
+	// idempotency-key 인터셉터 기본 설정 (recovery 보다 앞에 두어 panic 도 결과로 저장)
+	unaryIntercepters = append(unaryIntercepters, grpc_idempotency.UnaryServerInterceptor())
+
 	// recovery 인터셉터 기본 설정
 	unaryIntercepters = append(unaryIntercepters, grpc_recovery.UnaryServerInterceptor())
 	streamIntercepters = append(streamIntercepters, grpc_recovery.StreamServerInterceptor())
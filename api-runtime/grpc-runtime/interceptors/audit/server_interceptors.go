@@ -0,0 +1,110 @@
+// Audit Trail Interceptor of gRPC Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// 메소드 이름의 동사 부분, ex) CreateVM => Create
+var verbRegexp = regexp.MustCompile(`^[A-Z][a-z]+`)
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// isMutatingMethod - 조회(Get, List) 이외의 생성, 삭제, 제어 호출 여부
+func isMutatingMethod(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	return !strings.HasPrefix(method, "Get") && !strings.HasPrefix(method, "List")
+}
+
+// getResourceType - 메소드 이름의 자원 유형, ex) /cbspider.CCMService/CreateVM => vm
+func getResourceType(fullMethod string) string {
+	return strings.ToLower(verbRegexp.ReplaceAllString(path.Base(fullMethod), ""))
+}
+
+// getSourceIP - 호출한 클라이언트의 IP
+func getSourceIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// ===== [ Public Functions ] =====
+
+// UnaryServerInterceptor - 생성, 삭제, 제어 호출을 audit 기록으로 남기는 Unary 서버 인터셉터
+// (인증 인터셉터보다 앞에 두어 인증에 실패한 호출도 기록)
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		// 인증 인터셉터가 설정하는 principal 을 받기 위하여 미리 설정
+		ctx = cmrt.WithPrincipal(ctx, "")
+
+		resp, err := handler(ctx, req)
+
+		resourceType := getResourceType(info.FullMethod)
+		reqBytes, jsonErr := json.Marshal(req)
+		if jsonErr != nil {
+			reqBytes = nil
+		}
+		reqInfo := cmrt.GetAuditRequestInfo(reqBytes, resourceType)
+
+		auditInfo := cmrt.AuditInfo{
+			RequestID:      cmrt.RequestIDFromContext(ctx),
+			Principal:      cmrt.PrincipalFromContext(ctx),
+			SourceIP:       getSourceIP(ctx),
+			API:            cmrt.AUDIT_API_GRPC,
+			Operation:      info.FullMethod,
+			ConnectionName: reqInfo.ConnectionName,
+			ResourceType:   resourceType,
+			NameId:         reqInfo.NameId,
+			RequestSummary: reqInfo.RequestSummary,
+			StatusCode:     int(codes.OK),
+			Outcome:        cmrt.AUDIT_SUCCESS,
+			ElapsedTime:    time.Since(start).Seconds(),
+		}
+		if err != nil {
+			s, _ := status.FromError(err)
+			auditInfo.StatusCode = int(s.Code())
+			auditInfo.ErrorMSG = s.Message()
+			auditInfo.Outcome = cmrt.AUDIT_FAILURE
+		}
+
+		cmrt.RecordAudit(auditInfo)
+		return resp, err
+	}
+}
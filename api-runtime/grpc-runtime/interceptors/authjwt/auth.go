@@ -20,16 +20,17 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-func validateToken(ctx context.Context) (bool, error) {
+// validateToken - jwt 토큰을 검증하고 principal(sub claim)을 반환
+func validateToken(ctx context.Context) (string, error) {
 
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return false, status.Errorf(codes.InvalidArgument, "Retrieving metadata is failed")
+		return "", status.Errorf(codes.InvalidArgument, "Retrieving metadata is failed")
 	}
 
 	authHeader, ok := md["authorization"]
 	if !ok {
-		return false, status.Errorf(codes.Unauthenticated, "Authorization jwt token is not supplied")
+		return "", status.Errorf(codes.Unauthenticated, "Authorization jwt token is not supplied")
 	}
 
 	tokenStr := authHeader[0]
@@ -40,7 +41,7 @@ func validateToken(ctx context.Context) (bool, error) {
 	})
 
 	if err != nil {
-		return false, status.Errorf(codes.Unauthenticated, "Parsing jwt token is failed")
+		return "", status.Errorf(codes.Unauthenticated, "Parsing jwt token is failed")
 	}
 
 	if token.Valid {
@@ -50,7 +51,7 @@ func validateToken(ctx context.Context) (bool, error) {
 			if key == "expire" {
 
 				if getTokenRemainingValidity(val) < 0 {
-					return false, status.Errorf(codes.Unauthenticated, "token is expired")
+					return "", status.Errorf(codes.Unauthenticated, "token is expired")
 				}
 
 				var timestamp interface{} = val
@@ -68,10 +69,11 @@ func validateToken(ctx context.Context) (bool, error) {
 		tokenInfo = tokenInfo + " }"
 		logger.Debug("token parsing result : ", tokenInfo)
 
-		return true, nil
+		principal, _ := claims["sub"].(string)
+		return principal, nil
 	}
 
-	return false, status.Errorf(codes.Unauthenticated, "Authorization is failed")
+	return "", status.Errorf(codes.Unauthenticated, "Authorization is failed")
 }
 
 func getTokenRemainingValidity(timestamp interface{}) int {
@@ -14,6 +14,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 )
 
 // ===== [ Constants and Variables ] =====
@@ -23,10 +25,39 @@ var (
 
 // ===== [ Types ] =====
 
+// authJWTServerStream - 인증된 사용자가 포함된 context 를 반환하는 ServerStream
+type authJWTServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
 // ===== [ Implementations ] =====
 
+func (s *authJWTServerStream) Context() context.Context {
+	return s.ctx
+}
+
 // ===== [ Private Functions ] =====
 
+// withAuthUser - jwt 토큰의 principal 을 context 에 설정
+// 사용자가 등록된 경우 principal 과 같은 이름의 사용자의 role binding 으로, 없으면 모든 connection 의 admin 으로 권한 확인
+func withAuthUser(ctx context.Context) (context.Context, error) {
+	principal, err := validateToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = cmrt.WithPrincipal(ctx, principal)
+
+	if !cmrt.IsUserAuthEnabled() {
+		return cmrt.WithAuthUser(ctx, cmrt.NewAdminAuthUser(principal)), nil
+	}
+	authUser, err := cmrt.GetAuthUser(principal)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	return cmrt.WithAuthUser(ctx, authUser), nil
+}
+
 // ===== [ Public Functions ] =====
 
 // UnaryServerInterceptor - authentication 을 처리하는 Unary 서버 인터셉터
@@ -38,7 +69,7 @@ func UnaryServerInterceptor(key string) grpc.UnaryServerInterceptor {
 			return nil, status.Errorf(codes.Unauthenticated, "jwt key is not supplied")
 		}
 
-		_, err := validateToken(ctx)
+		ctx, err := withAuthUser(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -55,11 +86,11 @@ func StreamServerInterceptor(key string) grpc.StreamServerInterceptor {
 			return status.Errorf(codes.Unauthenticated, "jwt key is not supplied")
 		}
 
-		_, err := validateToken(stream.Context())
+		ctx, err := withAuthUser(stream.Context())
 		if err != nil {
 			return err
 		}
 
-		return handler(srv, stream)
+		return handler(srv, &authJWTServerStream{ServerStream: stream, ctx: ctx})
 	}
 }
@@ -0,0 +1,89 @@
+// Role Binding Authorization Interceptor of gRPC Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package authrbac
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// 메소드 이름의 동사 부분, ex) CreateVM => Create
+var verbRegexp = regexp.MustCompile(`^[A-Z][a-z]+`)
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// getAuthAction - 메소드의 action, ex) /cbspider.CCMService/StartVM => operate
+// (REST 와 같은 admin 자원 목록으로 확인)
+func getAuthAction(fullMethod string) string {
+	method := path.Base(fullMethod)
+	resource := verbRegexp.ReplaceAllString(method, "")
+	isMutating := !strings.HasPrefix(method, "Get") && !strings.HasPrefix(method, "List")
+	return cmrt.GetAuthAction(resource, isMutating)
+}
+
+// getConnectionNames - 요청 메시지의 ConnectionName, ConnectionNames
+func getConnectionNames(req interface{}) []string {
+	reqBytes, err := json.Marshal(req)
+	if err != nil {
+		return nil
+	}
+	return cmrt.GetRequestConnectionNames(reqBytes)
+}
+
+// authorize - 인증 인터셉터가 context 에 설정한 사용자의 role binding 으로 권한 확인
+// (인증된 사용자가 없으면 거부)
+func authorize(ctx context.Context, fullMethod string, connectionNameList []string) error {
+	authUser := cmrt.AuthUserFromContext(ctx)
+	if authUser == nil {
+		return status.Errorf(codes.Unauthenticated, "The user of the call is not authenticated")
+	}
+	if err := authUser.Authorize(getAuthAction(fullMethod), connectionNameList); err != nil {
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	}
+	return nil
+}
+
+// ===== [ Public Functions ] =====
+
+// UnaryServerInterceptor - 인증된 사용자의 role binding 으로 요청한 connection 마다 권한을 확인하는 Unary 서버 인터셉터
+// (authjwt, authtoken 인증 인터셉터 다음에 설정)
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod, getConnectionNames(req)); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor - 인증된 사용자의 role binding 으로 권한을 확인하는 Stream 서버 인터셉터
+// (요청 메시지를 받기 전이므로 connection 없이 권한 확인, 조회 외의 action 은 모든 connection 의 권한 필요)
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(stream.Context(), info.FullMethod, nil); err != nil {
+			return err
+		}
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,101 @@
+// API Token Authentication Interceptor of gRPC Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package authtoken
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// authTokenServerStream - 인증된 사용자가 포함된 context 를 반환하는 ServerStream
+type authTokenServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// ===== [ Implementations ] =====
+
+func (s *authTokenServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// ===== [ Private Functions ] =====
+
+// authenticate - authorization metadata 의 API 토큰(Bearer)으로 사용자 인증
+func authenticate(ctx context.Context) (*cmrt.AuthUserInfo, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Retrieving metadata is failed")
+	}
+
+	authHeader := md.Get("authorization")
+	if len(authHeader) == 0 {
+		return nil, status.Errorf(codes.Unauthenticated, "Authorization API token is not supplied")
+	}
+
+	scheme, token, _ := strings.Cut(authHeader[0], " ")
+	if !strings.EqualFold(scheme, "bearer") {
+		return nil, status.Errorf(codes.Unauthenticated, "Authorization should be 'Bearer <API token>'")
+	}
+
+	authUser, err := cmrt.AuthenticateApiToken(strings.TrimSpace(token))
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "%v", err)
+	}
+	return authUser, nil
+}
+
+// withAuthUser - 사용자가 등록된 경우 API 토큰으로 인증한 사용자, 등록된 사용자가 없으면 모든 connection 의 admin 을 context 에 설정
+func withAuthUser(ctx context.Context) (context.Context, error) {
+	if !cmrt.IsUserAuthEnabled() {
+		return cmrt.WithAuthUser(ctx, cmrt.NewAdminAuthUser(cmrt.ANONYMOUS_PRINCIPAL)), nil
+	}
+
+	authUser, err := authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = cmrt.WithPrincipal(ctx, authUser.UserName)
+	return cmrt.WithAuthUser(ctx, authUser), nil
+}
+
+// ===== [ Public Functions ] =====
+
+// UnaryServerInterceptor - 사용자가 등록된 경우 API 토큰으로 인증하는 Unary 서버 인터셉터 (권한 확인은 authrbac 인터셉터)
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := withAuthUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor - 사용자가 등록된 경우 API 토큰으로 인증하는 Stream 서버 인터셉터 (권한 확인은 authrbac 인터셉터)
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := withAuthUser(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authTokenServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
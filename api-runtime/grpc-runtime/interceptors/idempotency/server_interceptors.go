@@ -0,0 +1,144 @@
+// Idempotency Key Interceptor of gRPC Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	"github.com/cloud-barista/cb-spider/api-runtime/grpc-runtime/logger"
+)
+
+// ===== [ Constants and Variables ] =====
+
+const (
+	// REST 의 'Idempotency-Key' 헤더에 대응하는 metadata key
+	IdempotencyKeyMetadata = "idempotency-key"
+)
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ===== [ Private Functions ] =====
+
+// isMutatingMethod - 조회(Get, List) 이외의 생성, 삭제, 제어 호출 여부
+func isMutatingMethod(fullMethod string) bool {
+	method := path.Base(fullMethod)
+	return !strings.HasPrefix(method, "Get") && !strings.HasPrefix(method, "List")
+}
+
+func getIdempotencyKey(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(IdempotencyKeyMetadata)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// replay - 저장된 결과로 원 호출의 응답 메시지 또는 에러를 복원
+func replay(info *cmrt.IdempotencyKeyInfo) (interface{}, error) {
+	if info.Status == cmrt.IDEMPOTENCY_RUNNING {
+		return nil, status.Errorf(codes.Aborted, "The call with the idempotency-key '%s' is still running since %v!",
+			info.IdempotencyKey, info.CreatedTime)
+	}
+	if codes.Code(info.ResultCode) != codes.OK {
+		return nil, status.Error(codes.Code(info.ResultCode), info.ResultBody)
+	}
+
+	msgType := proto.MessageType(info.ResultType)
+	if msgType == nil {
+		return nil, status.Errorf(codes.Internal, "unknown response type '%s' of the idempotency-key '%s'",
+			info.ResultType, info.IdempotencyKey)
+	}
+	resp := reflect.New(msgType.Elem()).Interface()
+	if err := json.Unmarshal([]byte(info.ResultBody), resp); err != nil {
+		return nil, status.Errorf(codes.Internal, "%v", err)
+	}
+	return resp, nil
+}
+
+// ===== [ Public Functions ] =====
+
+// UnaryServerInterceptor - idempotency-key metadata 가 있는 생성, 삭제, 제어 호출을 한번만 실행하고
+// 같은 key 의 재호출에는 저장된 결과를 반환하는 Unary 서버 인터셉터
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+
+		key := getIdempotencyKey(ctx)
+		if key == "" || !isMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		reqBytes, err := json.Marshal(req)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "%v", err)
+		}
+		signature := fmt.Sprintf("GRPC:%s:%x", info.FullMethod, sha256.Sum256(reqBytes))
+		principal := cmrt.PrincipalFromContext(ctx)
+
+		keyInfo, isNew, err := cmrt.BeginIdempotentCall(principal, key, signature)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		if !isNew {
+			return replay(keyInfo)
+		}
+
+		done := false
+		defer func() {
+			if !done {
+				cmrt.CancelIdempotentCall(principal, keyInfo.IdempotencyKey)
+			}
+		}()
+
+		resp, callErr := handler(ctx, req)
+		done = true
+
+		var (
+			resultCode int
+			resultType string
+			resultBody string
+		)
+		if callErr != nil {
+			st, _ := status.FromError(callErr)
+			resultCode, resultBody = int(st.Code()), st.Message()
+		} else if msg, ok := resp.(proto.Message); ok {
+			respBytes, err := json.Marshal(resp)
+			if err != nil {
+				cmrt.CancelIdempotentCall(principal, keyInfo.IdempotencyKey)
+				return resp, callErr
+			}
+			resultCode, resultType, resultBody = int(codes.OK), proto.MessageName(msg), string(respBytes)
+		}
+
+		if err := cmrt.EndIdempotentCall(principal, keyInfo.IdempotencyKey, resultCode, resultType, resultBody); err != nil {
+			logger := logger.NewLogger()
+			logger.Error("failed to store the result of the idempotency-key : ", err)
+		}
+
+		return resp, callErr
+	}
+}
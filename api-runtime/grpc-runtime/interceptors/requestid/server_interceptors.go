@@ -0,0 +1,80 @@
+// Request ID Interceptor of gRPC Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package requestid
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// requestIDServerStream - Request ID 가 포함된 context 를 반환하는 ServerStream
+type requestIDServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// ===== [ Implementations ] =====
+
+func (s *requestIDServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// ===== [ Private Functions ] =====
+
+// getRequestID - x-request-id metadata 의 Request ID, 없거나 잘못된 경우 새로 생성
+func getRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		values := md.Get(cmrt.REQUEST_ID_METADATA)
+		if len(values) > 0 && values[0] != "" && cmrt.CheckRequestID(values[0]) == nil {
+			return values[0]
+		}
+	}
+	return cmrt.NewRequestID()
+}
+
+// ===== [ Public Functions ] =====
+
+// UnaryServerInterceptor - 호출마다 Request ID 를 정하여 로그, call-log 에 남기고
+// 에러를 포함한 응답의 x-request-id 헤더로 반환하는 Unary 서버 인터셉터
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := getRequestID(ctx)
+		ctx = cmrt.WithRequestID(ctx, requestID)
+		grpc.SetHeader(ctx, metadata.Pairs(cmrt.REQUEST_ID_METADATA, requestID))
+
+		unbind := cmrt.BindRequestContext(ctx)
+		defer unbind()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor - 호출마다 Request ID 를 정하여 로그, call-log 에 남기고
+// 에러를 포함한 응답의 x-request-id 헤더로 반환하는 Stream 서버 인터셉터
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		requestID := getRequestID(stream.Context())
+		ctx := cmrt.WithRequestID(stream.Context(), requestID)
+		stream.SetHeader(metadata.Pairs(cmrt.REQUEST_ID_METADATA, requestID))
+
+		unbind := cmrt.BindRequestContext(ctx)
+		defer unbind()
+
+		return handler(srv, &requestIDServerStream{ServerStream: stream, ctx: ctx})
+	}
+}
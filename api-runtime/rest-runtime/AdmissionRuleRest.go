@@ -0,0 +1,138 @@
+// Admission Rule Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"net/http"
+
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+//================ Admission Rule Handler
+// ConnectionName: "" or "*" for the global rules applied to all connections
+
+type admissionRuleReq struct {
+	ConnectionName string
+	RuleName       string
+	RuleType       string // AllowedVMSpec | DeniedCIDRPort | MaxDiskSizeGB | AllowedRegion | RequiredTagKeys
+	ValueList      []string
+	Description    string
+}
+
+func SetAdmissionRule(c echo.Context) error {
+	cblog.Info("call SetAdmissionRule()")
+
+	req := admissionRuleReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.SetAdmissionRule(cmrt.AdmissionRuleInfo{
+		ConnectionName: req.ConnectionName,
+		RuleName:       req.RuleName,
+		RuleType:       req.RuleType,
+		ValueList:      req.ValueList,
+		Description:    req.Description,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// ConnectionName: "" for all rules
+func ListAdmissionRule(c echo.Context) error {
+	cblog.Info("call ListAdmissionRule()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ListAdmissionRule(req.ConnectionName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.AdmissionRuleInfo `json:"admissionrule"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func GetAdmissionRule(c echo.Context) error {
+	cblog.Info("call GetAdmissionRule()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.GetAdmissionRule(req.ConnectionName, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func DeleteAdmissionRule(c echo.Context) error {
+	cblog.Info("call DeleteAdmissionRule()")
+
+	var req struct {
+		ConnectionName string
+	}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.ConnectionName == "" {
+		req.ConnectionName = c.QueryParam("ConnectionName")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteAdmissionRule(req.ConnectionName, c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
@@ -0,0 +1,112 @@
+// Audit Trail Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+// AuditMiddleware records the create, delete and control calls in the audit trail.
+// It runs before the authentication to record the rejected calls, too.
+func AuditMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !isMutatingCall(c) {
+			return next(c)
+		}
+
+		start := time.Now()
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+		err = next(c)
+
+		// ex) /spider/vm/:Name => vm
+		resourceType := strings.SplitN(strings.TrimPrefix(c.Path(), "/spider/"), "/", 2)[0]
+		reqInfo := cmrt.GetAuditRequestInfo(body, resourceType)
+		if reqInfo.ConnectionName == "" {
+			reqInfo.ConnectionName = c.QueryParam("ConnectionName")
+		}
+		if name := c.Param("Name"); name != "" {
+			reqInfo.NameId = name
+		}
+
+		info := cmrt.AuditInfo{
+			RequestID:      cmrt.RequestIDFromContext(c.Request().Context()),
+			Principal:      cmrt.PrincipalFromContext(c.Request().Context()),
+			SourceIP:       c.RealIP(),
+			API:            cmrt.AUDIT_API_REST,
+			Operation:      c.Request().Method + " " + c.Request().URL.Path,
+			ConnectionName: reqInfo.ConnectionName,
+			ResourceType:   resourceType,
+			NameId:         reqInfo.NameId,
+			RequestSummary: reqInfo.RequestSummary,
+			ElapsedTime:    time.Since(start).Seconds(),
+		}
+		info.StatusCode, info.ErrorMSG = getResponseStatus(c, err)
+		info.Outcome = cmrt.AUDIT_SUCCESS
+		if info.StatusCode >= http.StatusBadRequest {
+			info.Outcome = cmrt.AUDIT_FAILURE
+		}
+
+		cmrt.RecordAudit(info)
+		return err
+	}
+}
+
+//================ Audit Query
+// ex) curl "$SPIDER/audit?Principal=admin&ResourceType=vm&Outcome=failure&Since=24h"
+//	query params: Principal, ConnectionName, ResourceType, NameId, Outcome(success|failure), RequestID,
+//	              StartTime, EndTime(RFC3339), Since(ex. 30m, 1h, overrides StartTime), Limit
+
+func getAuditQuery(c echo.Context) (cmrt.AuditQueryInfo, error) {
+	query := cmrt.AuditQueryInfo{
+		Principal:      c.QueryParam("Principal"),
+		ConnectionName: c.QueryParam("ConnectionName"),
+		ResourceType:   c.QueryParam("ResourceType"),
+		NameId:         c.QueryParam("NameId"),
+		Outcome:        c.QueryParam("Outcome"),
+		RequestID:      c.QueryParam("RequestID"),
+	}
+
+	var err error
+	query.StartTime, query.EndTime, query.Limit, err = getTimeRangeQuery(c)
+	return query, err
+}
+
+func ListAudit(c echo.Context) error {
+	cblog.Info("call ListAudit()")
+
+	query, err := getAuditQuery(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := cmrt.ListAudit(query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.AuditInfo `json:"audit"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
@@ -0,0 +1,314 @@
+// User, API Token and Role Binding Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ Authentication and Authorization
+// The calls are authenticated with one of
+//	- basic auth of API_USERNAME/API_PASSWORD: admin of all connections
+//	- basic auth of a registered user
+//	- 'Authorization: Bearer <API token>' of a registered user
+//	- 'Authorization: Bearer <JWT>' of an SSO, with the role bindings of the claims
+// and authorized with the role bindings of the user.
+// The authentication is required when API_USERNAME/API_PASSWORD or the JWT authentication is set,
+// or any user is registered.
+
+func AuthMiddleware(apiUsername string, apiPassword string, skipAuthPaths map[string]bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			envAuthEnabled := apiUsername != "" && apiPassword != ""
+			if skipAuthPaths[c.Path()] || (!envAuthEnabled && !cmrt.IsUserAuthEnabled() && !cmrt.IsJWTAuthEnabled()) {
+				return next(c)
+			}
+
+			authUser, err := authenticate(c, apiUsername, apiPassword)
+			if err != nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `basic realm="CB-Spider"`)
+				return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+			}
+			c.SetRequest(c.Request().WithContext(cmrt.WithPrincipal(c.Request().Context(), authUser.UserName)))
+
+			action := getAuthAction(c)
+			connectionNameList, allConnections, err := getRequestConnectionNames(c)
+			if err != nil {
+				if errors.Is(err, errConnectionNameMismatch) {
+					return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+				}
+				return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			}
+			// the call on all connections is an action of the admin
+			if allConnections {
+				action = cmrt.AUTH_ACTION_ADMIN
+			}
+			if err := authUser.Authorize(action, connectionNameList); err != nil {
+				return echo.NewHTTPError(http.StatusForbidden, err.Error())
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func authenticate(c echo.Context, apiUsername string, apiPassword string) (*cmrt.AuthUserInfo, error) {
+	auth := c.Request().Header.Get(echo.HeaderAuthorization)
+	scheme, credential, _ := strings.Cut(auth, " ")
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		username, password, ok := c.Request().BasicAuth()
+		if !ok {
+			return nil, fmt.Errorf("invalid basic auth")
+		}
+		// Be careful to use constant time comparison to prevent timing attacks
+		if apiUsername != "" && apiPassword != "" &&
+			subtle.ConstantTimeCompare([]byte(username), []byte(apiUsername)) == 1 &&
+			subtle.ConstantTimeCompare([]byte(password), []byte(apiPassword)) == 1 {
+			return cmrt.NewAdminAuthUser(username), nil
+		}
+		return cmrt.AuthenticateUser(username, password)
+	case "bearer":
+		token := strings.TrimSpace(credential)
+		if !strings.HasPrefix(token, cmrt.API_TOKEN_PREFIX) && cmrt.IsJWTAuthEnabled() {
+			return cmrt.AuthenticateJWT(token)
+		}
+		return cmrt.AuthenticateApiToken(token)
+	}
+	return nil, fmt.Errorf("authorization is required")
+}
+
+// getAuthAction returns the action of the call, ex) POST /spider/vm => operate
+func getAuthAction(c echo.Context) string {
+	resource := strings.SplitN(strings.TrimPrefix(c.Path(), "/spider/"), "/", 2)[0]
+	return cmrt.GetAuthAction(resource, isMutatingCall(c))
+}
+
+//================ User Handler
+
+type userReq struct {
+	UserName string
+	Password string
+}
+
+func CreateUser(c echo.Context) error {
+	cblog.Info("call CreateUser()")
+
+	req := userReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.CreateUser(req.UserName, req.Password)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func ListUser(c echo.Context) error {
+	cblog.Info("call ListUser()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListUser()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.UserInfo `json:"user"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func GetUser(c echo.Context) error {
+	cblog.Info("call GetUser()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetUser(c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func DeleteUser(c echo.Context) error {
+	cblog.Info("call DeleteUser()")
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteUser(c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+//================ Role Binding Handler
+
+type roleBindingReq struct {
+	Role              string // admin | operator | readonly
+	ConnectionPattern string // ex) aws-dev-*, "" or *: all connections
+}
+
+func AddRoleBinding(c echo.Context) error {
+	cblog.Info("call AddRoleBinding()")
+
+	req := roleBindingReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.AddRoleBinding(cmrt.RoleBindingInfo{
+		UserName:          c.Param("Name"),
+		Role:              req.Role,
+		ConnectionPattern: req.ConnectionPattern,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func ListRoleBinding(c echo.Context) error {
+	cblog.Info("call ListRoleBinding()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListRoleBinding(c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.RoleBindingInfo `json:"rolebinding"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func RemoveRoleBinding(c echo.Context) error {
+	cblog.Info("call RemoveRoleBinding()")
+
+	req := roleBindingReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// To support for Get-Query Param Type API
+	if req.Role == "" {
+		req.Role = c.QueryParam("Role")
+	}
+	if req.ConnectionPattern == "" {
+		req.ConnectionPattern = c.QueryParam("ConnectionPattern")
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.RemoveRoleBinding(c.Param("Name"), req.Role, req.ConnectionPattern)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
+
+//================ API Token Handler
+
+type apiTokenReq struct {
+	Description string
+	Validity    string // ex) 720h, "": no expiration
+}
+
+// the issued token is returned only once
+type apiTokenCreateInfo struct {
+	cmrt.ApiTokenInfo
+	Token string
+}
+
+func CreateApiToken(c echo.Context) error {
+	cblog.Info("call CreateApiToken()")
+
+	req := apiTokenReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var validity time.Duration
+	if req.Validity != "" {
+		var err error
+		if validity, err = time.ParseDuration(req.Validity); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("invalid Validity(%s), ex) 720h", req.Validity))
+		}
+	}
+
+	// Call common-runtime API
+	tokenInfo, token, err := cmrt.CreateApiToken(c.Param("Name"), req.Description, validity)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, &apiTokenCreateInfo{ApiTokenInfo: *tokenInfo, Token: token})
+}
+
+func ListApiToken(c echo.Context) error {
+	cblog.Info("call ListApiToken()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListApiToken(c.Param("Name"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.ApiTokenInfo `json:"token"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func DeleteApiToken(c echo.Context) error {
+	cblog.Info("call DeleteApiToken()")
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteApiToken(c.Param("Name"), c.Param("TokenId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
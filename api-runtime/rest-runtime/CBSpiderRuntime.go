@@ -9,7 +9,6 @@
 package restruntime
 
 import (
-	"crypto/subtle"
 	"fmt"
 	"path/filepath"
 	"strings"
@@ -70,6 +69,7 @@ func init() {
 	// REST SERVICE_ADDRESS for AdminWeb since v0.4.4
 	cr.ServiceIPorName = getServiceIPorName("SERVICE_ADDRESS")
 	cr.ServicePort = getServicePort("SERVICE_ADDRESS")
+	cr.ServiceScheme = getServiceScheme()
 }
 
 // REST API Return struct for boolean type
@@ -196,6 +196,9 @@ func RunServer() {
 		{"GET", "/ping", healthCheck},
 		{"GET", "/readyz", healthCheck},
 
+		//----------Prometheus Metrics
+		{"GET", "/metrics", Metrics},
+
 		//----------CloudOS
 		{"GET", "/cloudos", ListCloudOS},
 
@@ -215,6 +218,10 @@ func RunServer() {
 		{"GET", "/credential/:CredentialName", GetCredential},
 		{"DELETE", "/credential/:CredentialName", UnRegisterCredential},
 
+		//----------EncryptionKey
+		{"GET", "/encryptionkey", GetEncryptionKeyStatus},
+		{"POST", "/encryptionkey/rotate", RotateEncryptionKey},
+
 		//----------RegionInfo
 		{"POST", "/region", RegisterRegion},
 		{"GET", "/region", ListRegion},
@@ -254,6 +261,7 @@ func RunServer() {
 		//----------VMSpec Handler
 		{"GET", "/vmspec", ListVMSpec},
 		{"GET", "/vmspec/:Name", GetVMSpec},
+		{"POST", "/vmspecrecommend", RecommendVMSpec},
 		{"GET", "/vmorgspec", ListOrgVMSpec},
 		{"GET", "/vmorgspec/:Name", GetOrgVMSpec},
 
@@ -292,6 +300,7 @@ func RunServer() {
 		//-- for rule
 		{"POST", "/securitygroup/:SGName/rules", AddRules},
 		{"DELETE", "/securitygroup/:SGName/rules", RemoveRules}, // no force option
+		{"PUT", "/securitygroup/:SGName/rules", ReplaceRules},
 		// no CSP Option, {"DELETE", "/securitygroup/:SGName/csprules", RemoveCSPRules},
 		//-- for management
 		{"GET", "/allsecuritygroup", ListAllSecurity},
@@ -440,6 +449,26 @@ func RunServer() {
 		{"GET", "/tag", ListTag},
 		{"GET", "/tag/:Name", GetTag},
 		{"DELETE", "/tag/:Name", RemoveTag},
+		{"POST", "/tag/search", SearchTag},   // cross-connection tag search
+		{"POST", "/tag/bulk", TagBulkAction}, // tag-based bulk operations
+
+		//----------Tag Policy Handler
+		{"POST", "/tagpolicy", SetTagPolicy},
+		{"GET", "/tagpolicy", ListTagPolicy},
+		{"GET", "/tagpolicy/:ConnectionName", GetTagPolicy},
+		{"DELETE", "/tagpolicy/:ConnectionName", DeleteTagPolicy},
+
+		//----------Admission Rule Handler
+		{"POST", "/admissionrule", SetAdmissionRule},
+		{"GET", "/admissionrule", ListAdmissionRule},
+		{"GET", "/admissionrule/:Name", GetAdmissionRule},
+		{"DELETE", "/admissionrule/:Name", DeleteAdmissionRule},
+
+		//----------Declarative Infrastructure Handler
+		{"POST", "/infra/plan", PlanInfra},
+		{"POST", "/infra/apply", ApplyInfra},
+		{"GET", "/infra/apply", ListInfraApplyJob},
+		{"GET", "/infra/apply/:JobId", GetInfraApplyJob},
 
 		//----------Destory All Resources in a Connection
 		{"DELETE", "/destroy", Destroy},
@@ -458,6 +487,26 @@ func RunServer() {
 		//-------------------------------------------------------------------//
 		//----------SPLock Info
 		{"GET", "/splockinfo", GetAllSPLockInfo},
+		//----------Call Guard Info
+		{"GET", "/calllimit", ListCallLimit},
+		{"GET", "/circuitbreaker", ListCircuitBreaker},
+		{"GET", "/circuitbreaker/:ConnectionName", GetCircuitBreaker},
+		//----------Call-Log Query
+		{"GET", "/calllog", ListCallLog},
+		{"GET", "/calllog/stats", GetCallLogStat},
+		//----------Audit Trail
+		{"GET", "/audit", ListAudit},
+		//----------User, API Token and Role Binding
+		{"POST", "/auth/user", CreateUser},
+		{"GET", "/auth/user", ListUser},
+		{"GET", "/auth/user/:Name", GetUser},
+		{"DELETE", "/auth/user/:Name", DeleteUser},
+		{"POST", "/auth/user/:Name/rolebinding", AddRoleBinding},
+		{"GET", "/auth/user/:Name/rolebinding", ListRoleBinding},
+		{"DELETE", "/auth/user/:Name/rolebinding", RemoveRoleBinding},
+		{"POST", "/auth/user/:Name/token", CreateApiToken},
+		{"GET", "/auth/user/:Name/token", ListApiToken},
+		{"DELETE", "/auth/user/:Name/token/:TokenId", DeleteApiToken},
 		//----------SSH RUN
 		{"POST", "/sshrun", SSHRun},
 
@@ -530,6 +579,19 @@ func ApiServer(routes []route) {
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
 
+	// for 'X-Request-ID' of the logs, the call-logs and the error responses
+	e.Use(RequestIDMiddleware)
+	e.HTTPErrorHandler = requestIDErrorHandler(e)
+
+	// for Prometheus metrics of the REST requests
+	e.Use(MetricsMiddleware)
+
+	// for OpenTelemetry tracing of the REST requests
+	e.Use(TracingMiddleware)
+
+	// for the audit trail of create, delete and control calls, including the rejected ones
+	e.Use(AuditMiddleware)
+
 	cbspiderRoot := os.Getenv("CBSPIDER_ROOT")
 
 	// for HTTP Access Log
@@ -551,24 +613,16 @@ func ApiServer(routes []route) {
 		"/spider/readyz":      true,
 	}
 
-	if API_USERNAME != "" && API_PASSWORD != "" {
+	if (API_USERNAME != "" && API_PASSWORD != "") || cr.IsUserAuthEnabled() || cr.IsJWTAuthEnabled() {
 		cblog.Info("**** Rest Auth Enabled ****")
-		e.Use(middleware.BasicAuthWithConfig(middleware.BasicAuthConfig{
-			Skipper: func(c echo.Context) bool {
-				return SkipAuthPaths[c.Path()]
-			},
-			Validator: func(username, password string, c echo.Context) (bool, error) {
-				// Be careful to use constant time comparison to prevent timing attacks
-				if subtle.ConstantTimeCompare([]byte(username), []byte(API_USERNAME)) == 1 &&
-					subtle.ConstantTimeCompare([]byte(password), []byte(API_PASSWORD)) == 1 {
-					return true, nil
-				}
-				return false, nil
-			},
-		}))
 	} else {
 		cblog.Info("**** Rest Auth Disabled ****")
 	}
+	// the authentication is enabled also when the first user is registered
+	e.Use(AuthMiddleware(API_USERNAME, API_PASSWORD, SkipAuthPaths))
+
+	// for 'Idempotency-Key' header of create, delete and control calls
+	e.Use(IdempotencyMiddleware)
 
 	for _, route := range routes {
 		// /driver => /spider/driver
@@ -597,7 +651,7 @@ func ApiServer(routes []route) {
 
 	spiderBanner()
 
-	if err := e.Start(cr.ServerPort); err != nil {
+	if err := startServer(e); err != nil {
 		cblog.Fatalf("Failed to start the server: %v", err)
 	}
 
@@ -616,11 +670,11 @@ func endpointInfo(c echo.Context) error {
 	cblog.Info("call endpointInfo()")
 
 	endpointInfo := fmt.Sprintf("\n  <CB-Spider> Multi-Cloud Infrastructure Federation Framework\n")
-	adminWebURL := "http://" + cr.ServiceIPorName + cr.ServicePort + "/spider/adminweb"
+	adminWebURL := cr.ServiceScheme + "://" + cr.ServiceIPorName + cr.ServicePort + "/spider/adminweb"
 	endpointInfo += fmt.Sprintf("     - AdminWeb: %s\n", adminWebURL)
-	restEndPoint := "http://" + cr.ServiceIPorName + cr.ServicePort + "/spider"
+	restEndPoint := cr.ServiceScheme + "://" + cr.ServiceIPorName + cr.ServicePort + "/spider"
 	endpointInfo += fmt.Sprintf("     - REST API: %s\n", restEndPoint)
-	// swaggerURL := "http://" + cr.ServiceIPorName + cr.ServicePort + "/spider/swagger/index.html"
+	// swaggerURL := cr.ServiceScheme + "://" + cr.ServiceIPorName + cr.ServicePort + "/spider/swagger/index.html"
 	// endpointInfo += fmt.Sprintf("     - Swagger : %s\n", swaggerURL)
 	// gRPCServer := "grpc://" + cr.ServiceIPorName + cr.GoServicePort
 	// endpointInfo += fmt.Sprintf("     - Go   API: %s\n", gRPCServer)
@@ -643,14 +697,14 @@ func spiderBanner() {
 	fmt.Println("\n  <CB-Spider> Multi-Cloud Infrastructure Federation Framework")
 
 	// AdminWeb
-	adminWebURL := "http://" + cr.ServiceIPorName + cr.ServicePort + "/spider/adminweb"
+	adminWebURL := cr.ServiceScheme + "://" + cr.ServiceIPorName + cr.ServicePort + "/spider/adminweb"
 	fmt.Printf("     - AdminWeb: %s\n", adminWebURL)
 
 	// REST API EndPoint
-	restEndPoint := "http://" + cr.ServiceIPorName + cr.ServicePort + "/spider"
+	restEndPoint := cr.ServiceScheme + "://" + cr.ServiceIPorName + cr.ServicePort + "/spider"
 	fmt.Printf("     - REST API: %s\n", restEndPoint)
 
 	// Swagger
-	// swaggerURL := "http://" + cr.ServiceIPorName + cr.ServicePort + "/spider/swagger/index.html"
+	// swaggerURL := cr.ServiceScheme + "://" + cr.ServiceIPorName + cr.ServicePort + "/spider/swagger/index.html"
 	// fmt.Printf("     - Swagger : %s\n", swaggerURL)
 }
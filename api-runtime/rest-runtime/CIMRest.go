@@ -237,6 +237,30 @@ func UnRegisterCredential(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// ================ Encryption Key Handler
+func GetEncryptionKeyStatus(c echo.Context) error {
+	cblog.Info("call GetEncryptionKeyStatus()")
+
+	status, err := cim.GetEncryptionKeyStatus()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, status)
+}
+
+// re-encrypt all the stored credentials and keys with the active key
+func RotateEncryptionKey(c echo.Context) error {
+	cblog.Info("call RotateEncryptionKey()")
+
+	result, err := cim.RotateEncryptionKey()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 // ================ Region Handler
 func RegisterRegion(c echo.Context) error {
 	cblog.Info("call RegisterRegion()")
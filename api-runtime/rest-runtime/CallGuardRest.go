@@ -0,0 +1,61 @@
+// Call Guard Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+//================ Call Limit and Circuit Breaker Infos
+
+func ListCallLimit(c echo.Context) error {
+	cblog.Info("call ListCallLimit()")
+
+	infoList := cmrt.ListCallLimit()
+
+	var jsonResult struct {
+		Result []cmrt.CallLimitInfo `json:"calllimit"`
+	}
+	if infoList == nil {
+		infoList = []cmrt.CallLimitInfo{}
+	}
+	jsonResult.Result = infoList
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func ListCircuitBreaker(c echo.Context) error {
+	cblog.Info("call ListCircuitBreaker()")
+
+	infoList := cmrt.ListCircuitBreaker()
+
+	var jsonResult struct {
+		Result []cmrt.CircuitBreakerInfo `json:"circuitbreaker"`
+	}
+	if infoList == nil {
+		infoList = []cmrt.CircuitBreakerInfo{}
+	}
+	jsonResult.Result = infoList
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func GetCircuitBreaker(c echo.Context) error {
+	cblog.Info("call GetCircuitBreaker()")
+
+	info, err := cmrt.GetCircuitBreaker(c.Param("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, info)
+}
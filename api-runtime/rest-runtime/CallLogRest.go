@@ -0,0 +1,79 @@
+// Call-Log Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"net/http"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ Call-Log Query
+// ex) curl "$SPIDER/calllog?CloudOS=AWS&ResourceType=VM&Status=error&Since=1h"
+//	query params: CloudOS, Region, ResourceType, ResourceName, CloudOSAPI, Status(success|error), RequestID,
+//	              StartTime, EndTime(RFC3339), Since(ex. 30m, 1h, overrides StartTime), Limit
+
+func getCallLogQuery(c echo.Context) (cmrt.CallLogQueryInfo, error) {
+	query := cmrt.CallLogQueryInfo{
+		CloudOS:      c.QueryParam("CloudOS"),
+		Region:       c.QueryParam("Region"),
+		ResourceType: c.QueryParam("ResourceType"),
+		ResourceName: c.QueryParam("ResourceName"),
+		CloudOSAPI:   c.QueryParam("CloudOSAPI"),
+		Status:       c.QueryParam("Status"),
+		RequestID:    c.QueryParam("RequestID"),
+	}
+
+	var err error
+	query.StartTime, query.EndTime, query.Limit, err = getTimeRangeQuery(c)
+	return query, err
+}
+
+func ListCallLog(c echo.Context) error {
+	cblog.Info("call ListCallLog()")
+
+	query, err := getCallLogQuery(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := cmrt.ListCallLog(query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.CallLogInfo `json:"calllog"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func GetCallLogStat(c echo.Context) error {
+	cblog.Info("call GetCallLogStat()")
+
+	query, err := getCallLogQuery(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	result, err := cmrt.GetCallLogStat(query)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.CallLogStatInfo `json:"calllogstat"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
@@ -168,6 +168,15 @@ func CreateCluster(c echo.Context) error {
 		attachNameSpaceToName(req.NameSpace, &reqInfo)
 	}
 
+	// validate only without creating, ex) POST /...?dryRun=true
+	if isDryRun(c) {
+		result, err := cmrt.DryRunCluster(req.ConnectionName, CLUSTER, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateCluster(req.ConnectionName, CLUSTER, reqInfo, req.IDTransformMode)
 	if err != nil {
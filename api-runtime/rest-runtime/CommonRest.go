@@ -14,9 +14,14 @@ import (
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 
 	// REST API (echo)
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
@@ -37,6 +42,146 @@ const (
 	NODEGROUP string = string(cres.NODEGROUP)
 )
 
+// isDryRun returns true if the create request has the query param 'dryRun=true'
+func isDryRun(c echo.Context) bool {
+	return c.QueryParam("dryRun") == "true"
+}
+
+// query APIs with the POST method
+var readPostPathMap = map[string]bool{
+	"/spider/priceinfo/:ProductFamily/:RegionName": true,
+	"/spider/vmspecrecommend":                      true,
+	"/spider/tag/search":                           true,
+	"/spider/infra/plan":                           true,
+}
+
+// control APIs with the GET method, ex) GET /spider/controlvm/vm-01?action=reboot
+var mutatingGetPathMap = map[string]bool{
+	"/spider/controlvm/:Name": true,
+}
+
+// isMutatingCall returns true if the call creates, changes or deletes resources or infos.
+// It is shared by the authorization, the audit and the idempotency.
+func isMutatingCall(c echo.Context) bool {
+	switch c.Request().Method {
+	case http.MethodPost, http.MethodPut, http.MethodDelete:
+		return !readPostPathMap[c.Path()] && !isDryRun(c)
+	case http.MethodGet:
+		return mutatingGetPathMap[c.Path()]
+	}
+	return false
+}
+
+// getTimeRangeQuery returns the time range and the limit of a record query, ex) call-log, audit
+//
+//	query params: StartTime, EndTime(RFC3339), Since(ex. 30m, 1h, overrides StartTime), Limit
+func getTimeRangeQuery(c echo.Context) (startTime time.Time, endTime time.Time, limit int, err error) {
+	if value := c.QueryParam("StartTime"); value != "" {
+		if startTime, err = time.Parse(time.RFC3339, value); err != nil {
+			return startTime, endTime, limit, fmt.Errorf("invalid StartTime(%s), use RFC3339, ex) 2024-01-02T15:04:05Z", value)
+		}
+	}
+	if value := c.QueryParam("EndTime"); value != "" {
+		if endTime, err = time.Parse(time.RFC3339, value); err != nil {
+			return startTime, endTime, limit, fmt.Errorf("invalid EndTime(%s), use RFC3339, ex) 2024-01-02T15:04:05Z", value)
+		}
+	}
+	if value := c.QueryParam("Since"); value != "" {
+		since, err := time.ParseDuration(value)
+		if err != nil || since <= 0 {
+			return startTime, endTime, limit, fmt.Errorf("invalid Since(%s), ex) 30m, 1h", value)
+		}
+		startTime = time.Now().Add(-since)
+	}
+	if value := c.QueryParam("Limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil {
+			return startTime, endTime, limit, fmt.Errorf("invalid Limit(%s)", value)
+		}
+	}
+	return startTime, endTime, limit, nil
+}
+
+// getResponseStatus returns the status code and the error message of a request in a middleware.
+// The error of the handler is written to the response after the middlewares.
+func getResponseStatus(c echo.Context, err error) (int, string) {
+	if err == nil {
+		return c.Response().Status, ""
+	}
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code, fmt.Sprintf("%v", httpErr.Message)
+	}
+	return http.StatusInternalServerError, err.Error()
+}
+
+// calls of multiple connections, on all connections if the body has no connection names
+var allConnectionsCallMap = map[string]bool{"/spider/nscluster": true, "/spider/tag/search": true, "/spider/tag/bulk": true}
+
+// errConnectionNameMismatch is the error of a call with different ConnectionNames in the query param and the body.
+var errConnectionNameMismatch = errors.New("The ConnectionName of the query param and the body are different!")
+
+// getRequestConnectionName returns the connection of the call from the query param or the body,
+// as the handler gets it. It is shared by the authorization and the tracing.
+func getRequestConnectionName(c echo.Context) (string, error) {
+	queryConnectionName := c.QueryParam("ConnectionName")
+	if queryConnectionName == "" && c.Path() == "/spider/connectionconfig/:Name" {
+		return c.Param("Name"), nil
+	}
+
+	body, err := getRequestBody(c)
+	if err != nil {
+		return "", err
+	}
+	var req struct {
+		ConnectionName string
+	}
+	// not a JSON body has no connection
+	json.Unmarshal(body, &req)
+
+	if queryConnectionName != "" && req.ConnectionName != "" && queryConnectionName != req.ConnectionName {
+		return "", errConnectionNameMismatch
+	}
+	if queryConnectionName != "" {
+		return queryConnectionName, nil
+	}
+	return req.ConnectionName, nil
+}
+
+// getRequestConnectionNames returns all the connections of the call to authorize,
+// with the connections of the body, ex) ConnectionNames, the ConnectionList of an infra spec.
+// allConnections is true if the call is on all connections, ex) tag search without ConnectionNames
+func getRequestConnectionNames(c echo.Context) (connectionNameList []string, allConnections bool, err error) {
+	connectionName, err := getRequestConnectionName(c)
+	if err != nil {
+		return nil, false, err
+	}
+	body, err := getRequestBody(c)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// the ConnectionName of the body is the same as the query param, or empty
+	connectionNameList = cmrt.GetRequestConnectionNames(body)
+	if connectionName != "" && (len(connectionNameList) == 0 || connectionNameList[0] != connectionName) {
+		connectionNameList = append(connectionNameList, connectionName)
+	}
+	return connectionNameList, len(connectionNameList) == 0 && allConnectionsCallMap[c.Path()], nil
+}
+
+// getRequestBody returns the body of the call, and restores it for the handler.
+func getRequestBody(c echo.Context) ([]byte, error) {
+	if c.Request().Body == nil || c.Request().ContentLength == 0 {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, err
+	}
+	c.Request().Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
 //================ Get CSP Resource Name
 
 func GetCSPResourceName(c echo.Context) error {
@@ -108,6 +108,15 @@ func CreateDisk(c echo.Context) error {
 		DiskSize: req.ReqInfo.DiskSize,
 	}
 
+	// validate only without creating, ex) POST /...?dryRun=true
+	if isDryRun(c) {
+		result, err := cmrt.DryRunDisk(req.ConnectionName, DISK, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateDisk(req.ConnectionName, DISK, reqInfo, req.IDTransformMode)
 	if err != nil {
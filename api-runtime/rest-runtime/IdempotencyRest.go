@@ -0,0 +1,101 @@
+// Idempotency Key Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	IDEMPOTENCY_KEY_HEADER      = "Idempotency-Key"
+	IDEMPOTENCY_REPLAYED_HEADER = "Idempotent-Replayed"
+)
+
+// status of the original call, returned to a replay while the original call is still running
+type idempotencyStatusInfo struct {
+	IdempotencyKey string
+	Status         string
+	CreatedTime    time.Time
+}
+
+// idempotencyRecorder copies the response body to store the outcome of the call
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *idempotencyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+// create, delete and control calls with the 'Idempotency-Key' header are executed only once
+// in the key's window, and the replays get the stored outcome of the original call.
+func IdempotencyMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		key := c.Request().Header.Get(IDEMPOTENCY_KEY_HEADER)
+		if key == "" || !isMutatingCall(c) {
+			return next(c)
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		c.Request().Body = io.NopCloser(bytes.NewReader(body))
+		signature := fmt.Sprintf("REST:%s %s:%x", c.Request().Method, c.Request().URL.RequestURI(), sha256.Sum256(body))
+		principal := cmrt.PrincipalFromContext(c.Request().Context())
+
+		info, isNew, err := cmrt.BeginIdempotentCall(principal, key, signature)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error())
+		}
+
+		if !isNew {
+			c.Response().Header().Set(IDEMPOTENCY_REPLAYED_HEADER, "true")
+			if info.Status == cmrt.IDEMPOTENCY_RUNNING {
+				statusInfo := idempotencyStatusInfo{IdempotencyKey: info.IdempotencyKey, Status: info.Status, CreatedTime: info.CreatedTime}
+				return c.JSON(http.StatusAccepted, &statusInfo)
+			}
+			return c.Blob(info.ResultCode, echo.MIMEApplicationJSONCharsetUTF8, []byte(info.ResultBody))
+		}
+
+		done := false
+		defer func() {
+			if !done {
+				cmrt.CancelIdempotentCall(principal, info.IdempotencyKey)
+			}
+		}()
+
+		recorder := &idempotencyRecorder{ResponseWriter: c.Response().Writer}
+		c.Response().Writer = recorder
+
+		// render the error here to record it as the outcome
+		if err := next(c); err != nil {
+			c.Error(err)
+		}
+
+		done = true
+		err = cmrt.EndIdempotentCall(principal, info.IdempotencyKey, c.Response().Status, "", recorder.body.String())
+		if err != nil {
+			cblog.Error(err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+// Infra Spec Plan and Apply Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"io"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+//================ Declarative Infrastructure Handler
+// Request Body: the Infra Spec of YAML or JSON format
+
+func readInfraSpec(c echo.Context) (*cmrt.InfraSpecInfo, error) {
+	data, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return nil, err
+	}
+	return cmrt.ParseInfraSpec(data)
+}
+
+func PlanInfra(c echo.Context) error {
+	cblog.Info("call PlanInfra()")
+
+	spec, err := readInfraSpec(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.PlanInfra(*spec)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.InfraPlanStepInfo `json:"plan"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// returns the job immediately, the progress is checked with GetInfraApplyJob
+func ApplyInfra(c echo.Context) error {
+	cblog.Info("call ApplyInfra()")
+
+	spec, err := readInfraSpec(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ApplyInfra(*spec)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusAccepted, result)
+}
+
+func ListInfraApplyJob(c echo.Context) error {
+	cblog.Info("call ListInfraApplyJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListInfraApplyJob()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.InfraApplyJobInfo `json:"job"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func GetInfraApplyJob(c echo.Context) error {
+	cblog.Info("call GetInfraApplyJob()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetInfraApplyJob(c.Param("JobId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
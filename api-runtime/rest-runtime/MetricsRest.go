@@ -0,0 +1,63 @@
+// Prometheus Metrics Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	httpRequestCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "spider_http_requests_total",
+		Help: "Total number of the REST requests.",
+	}, []string{"method", "route", "code"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "spider_http_request_duration_seconds",
+		Help:    "Latency of the REST requests.",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 300},
+	}, []string{"method", "route"})
+)
+
+func init() {
+	cmrt.RegisterMetricsCollector(httpRequestCounter, httpRequestDuration)
+}
+
+// MetricsMiddleware counts the REST requests by the route pattern, ex) /spider/vm/:Name
+func MetricsMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		start := time.Now()
+		err := next(c)
+
+		route := c.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+		code, _ := getResponseStatus(c, err)
+
+		httpRequestCounter.WithLabelValues(c.Request().Method, route, strconv.Itoa(code)).Inc()
+		httpRequestDuration.WithLabelValues(c.Request().Method, route).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+//================ Prometheus Metrics
+
+func Metrics(c echo.Context) error {
+	cmrt.MetricsHandler().ServeHTTP(c.Response(), c.Request())
+	return nil
+}
@@ -104,6 +104,15 @@ func SnapshotVM(c echo.Context) error {
 		SourceVM: cres.IID{req.ReqInfo.SourceVM, req.ReqInfo.SourceVM},
 	}
 
+	// validate only without creating, ex) POST /...?dryRun=true
+	if isDryRun(c) {
+		result, err := cmrt.DryRunMyImage(req.ConnectionName, MYIMAGE, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.SnapshotVM(req.ConnectionName, MYIMAGE, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -162,6 +162,15 @@ func CreateNLB(c echo.Context) error {
 	}
 	reqInfo.HealthChecker = healthChecker
 
+	// validate only without creating, ex) POST /...?dryRun=true
+	if isDryRun(c) {
+		result, err := cmrt.DryRunNLB(req.ConnectionName, NLB, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateNLB(req.ConnectionName, NLB, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	// REST API (echo)
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
@@ -36,8 +37,25 @@ func ListImage(c echo.Context) error {
 		req.ConnectionName = c.QueryParam("ConnectionName")
 	}
 
-	// Call common-runtime API
-	result, err := cmrt.ListImage(req.ConnectionName, IMAGE)
+	// search with the normalized image attributes
+	//   ex) /spider/vmimage?ConnectionName=aws-config01&os=ubuntu&version=22.04&arch=x86_64
+	filter := cmrt.ImageFilterInfo{
+		OSDistribution: c.QueryParam("os"),
+		OSVersion:      c.QueryParam("version"),
+		OSArchitecture: c.QueryParam("arch"),
+		OSPlatform:     c.QueryParam("platform"),
+	}
+	refresh := strings.EqualFold(c.QueryParam("refresh"), "true")
+
+	var result []*cres.ImageInfo
+	var err error
+	if filter != (cmrt.ImageFilterInfo{}) || refresh {
+		// Call common-runtime API
+		result, err = cmrt.SearchImage(req.ConnectionName, IMAGE, filter, refresh)
+	} else {
+		// Call common-runtime API
+		result, err = cmrt.ListImage(req.ConnectionName, IMAGE)
+	}
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
@@ -0,0 +1,66 @@
+// Request ID Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"net/http"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+// RequestIDMiddleware takes the Request ID from the 'X-Request-ID' header or generates a new one,
+// returns it with the 'X-Request-ID' header, and binds it to the goroutine serving the request
+// for the logs and the call-logs of the common-runtime and the drivers.
+func RequestIDMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		requestID := req.Header.Get(cmrt.REQUEST_ID_HEADER)
+		if requestID == "" || cmrt.CheckRequestID(requestID) != nil {
+			requestID = cmrt.NewRequestID()
+		}
+		c.Response().Header().Set(cmrt.REQUEST_ID_HEADER, requestID)
+
+		ctx := cmrt.WithRequestID(req.Context(), requestID)
+		c.SetRequest(req.WithContext(ctx))
+		unbind := cmrt.BindRequestContext(ctx)
+		defer unbind()
+
+		return next(c)
+	}
+}
+
+// requestIDErrorHandler adds the Request ID to the error responses,
+// ex) {"message": "vm-01 does not exist!", "RequestID": "cs3m8rqkn7pc73d0q0ag"}
+func requestIDErrorHandler(e *echo.Echo) echo.HTTPErrorHandler {
+	return func(err error, c echo.Context) {
+		requestID := cmrt.RequestIDFromContext(c.Request().Context())
+		if requestID == "" {
+			e.DefaultHTTPErrorHandler(err, c)
+			return
+		}
+
+		httpErr, ok := err.(*echo.HTTPError)
+		if !ok {
+			httpErr = echo.NewHTTPError(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)).SetInternal(err)
+		}
+		message, ok := httpErr.Message.(string)
+		if !ok {
+			e.DefaultHTTPErrorHandler(err, c)
+			return
+		}
+		e.DefaultHTTPErrorHandler(&echo.HTTPError{
+			Code:     httpErr.Code,
+			Message:  map[string]string{"message": message, "RequestID": requestID},
+			Internal: httpErr.Internal,
+		}, c)
+	}
+}
@@ -122,6 +122,15 @@ func CreateSecurity(c echo.Context) error {
 		SecurityRules: req.ReqInfo.SecurityRules,
 	}
 
+	// validate only without creating, ex) POST /...?dryRun=true
+	if isDryRun(c) {
+		result, err := cmrt.DryRunSecurity(req.ConnectionName, SG, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateSecurity(req.ConnectionName, SG, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -277,6 +286,12 @@ type ruleControlReq struct {
 			FromPort   string
 			ToPort     string
 			CIDR       string
+
+			PeerSecurityGroupIID *cres.IID // {NameId: Spider's SG Name}, exclusive with CIDR
+
+			ICMPType    string
+			ICMPCode    string
+			Description string
 		}
 	}
 }
@@ -298,7 +313,9 @@ func AddRules(c echo.Context) error {
 	reqRuleInfoList := []cres.SecurityRuleInfo{}
 	for _, info := range req.ReqInfo.RuleInfoList {
 		ruleInfo := cres.SecurityRuleInfo{Direction: info.Direction,
-			IPProtocol: info.IPProtocol, FromPort: info.FromPort, ToPort: info.ToPort, CIDR: info.CIDR}
+			IPProtocol: info.IPProtocol, FromPort: info.FromPort, ToPort: info.ToPort, CIDR: info.CIDR,
+			ICMPType: info.ICMPType, ICMPCode: info.ICMPCode, Description: info.Description,
+			PeerSecurityGroupIID: info.PeerSecurityGroupIID}
 		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
 	}
 
@@ -328,7 +345,9 @@ func RemoveRules(c echo.Context) error {
 	reqRuleInfoList := []cres.SecurityRuleInfo{}
 	for _, info := range req.ReqInfo.RuleInfoList {
 		ruleInfo := cres.SecurityRuleInfo{Direction: info.Direction,
-			IPProtocol: info.IPProtocol, FromPort: info.FromPort, ToPort: info.ToPort, CIDR: info.CIDR}
+			IPProtocol: info.IPProtocol, FromPort: info.FromPort, ToPort: info.ToPort, CIDR: info.CIDR,
+			ICMPType: info.ICMPType, ICMPCode: info.ICMPCode, Description: info.Description,
+			PeerSecurityGroupIID: info.PeerSecurityGroupIID}
 		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
 	}
 
@@ -346,6 +365,38 @@ func RemoveRules(c echo.Context) error {
 	return c.JSON(http.StatusOK, &resultInfo)
 }
 
+// (1) get desired rules info from REST Call
+// (2) call common-runtime API
+// (3) return REST Json Format with added, removed and unchanged rules
+func ReplaceRules(c echo.Context) error {
+	cblog.Info("call ReplaceRules()")
+
+	req := ruleControlReq{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Rest RegInfo => Driver ReqInfo
+	// create RuleInfo List
+	reqRuleInfoList := []cres.SecurityRuleInfo{}
+	for _, info := range req.ReqInfo.RuleInfoList {
+		ruleInfo := cres.SecurityRuleInfo{Direction: info.Direction,
+			IPProtocol: info.IPProtocol, FromPort: info.FromPort, ToPort: info.ToPort, CIDR: info.CIDR,
+			ICMPType: info.ICMPType, ICMPCode: info.ICMPCode, Description: info.Description,
+			PeerSecurityGroupIID: info.PeerSecurityGroupIID}
+		reqRuleInfoList = append(reqRuleInfoList, ruleInfo)
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.ReplaceRules(req.ConnectionName, c.Param("SGName"), reqRuleInfoList)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
 func CountAllSecurityGroups(c echo.Context) error {
 	// Call common-runtime API to get count of SecurityGroups
 	count, err := cmrt.CountAllSecurityGroups()
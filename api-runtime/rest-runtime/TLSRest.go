@@ -0,0 +1,255 @@
+// TLS Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"bytes"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	cr "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	aw "github.com/cloud-barista/cb-spider/api-runtime/rest-runtime/admin-web"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+//================ HTTPS/mTLS Server
+// The REST server serves HTTPS when the certificate and the key are set,
+// and the changed certificate files are reloaded without restarting the server.
+//
+// env)
+//	SPIDER_TLS_CERT             : certificate file(PEM) of the server, ex) $CBSPIDER_ROOT/cert/server.crt
+//	SPIDER_TLS_KEY              : private key file(PEM) of the server
+//	SPIDER_TLS_CLIENT_CA        : CA certificates file(PEM) to verify the client certificates (mTLS)
+//	SPIDER_TLS_CLIENT_AUTH      : require | optional (default: require), optional verifies only the given client certificates
+//	SPIDER_HTTP_REDIRECT_ADDRESS: address of the HTTP server redirecting to HTTPS of the SERVICE_ADDRESS, ex) :80
+//
+// The loopback calls of the AdminWeb trust only the certificate of the server,
+// and give it as the client certificate with mTLS, so it should be issued by the client CA for the client auth.
+
+const tlsReloadInterval = 10 * time.Second
+
+func isTLSEnabled() bool {
+	return os.Getenv("SPIDER_TLS_CERT") != "" && os.Getenv("SPIDER_TLS_KEY") != ""
+}
+
+// ex) http, https
+func getServiceScheme() string {
+	if isTLSEnabled() {
+		return "https"
+	}
+	return "http"
+}
+
+// tlsReloader keeps the certificate and the client CAs loaded from the files,
+// and loads them again when the files are changed.
+type tlsReloader struct {
+	certFile   string
+	keyFile    string
+	caFile     string
+	clientAuth tls.ClientAuthType
+
+	mutex       sync.RWMutex
+	cert        *tls.Certificate
+	caPool      *x509.CertPool
+	fileModTime map[string]time.Time
+}
+
+func newTLSReloader() (*tlsReloader, error) {
+	reloader := &tlsReloader{
+		certFile:    os.ExpandEnv(os.Getenv("SPIDER_TLS_CERT")),
+		keyFile:     os.ExpandEnv(os.Getenv("SPIDER_TLS_KEY")),
+		caFile:      os.ExpandEnv(os.Getenv("SPIDER_TLS_CLIENT_CA")),
+		clientAuth:  tls.NoClientCert,
+		fileModTime: map[string]time.Time{},
+	}
+
+	if reloader.caFile != "" {
+		switch strings.ToLower(os.Getenv("SPIDER_TLS_CLIENT_AUTH")) {
+		case "", "require":
+			reloader.clientAuth = tls.RequireAndVerifyClientCert
+		case "optional":
+			reloader.clientAuth = tls.VerifyClientCertIfGiven
+		default:
+			return nil, fmt.Errorf("invalid SPIDER_TLS_CLIENT_AUTH(%s), use one of require, optional", os.Getenv("SPIDER_TLS_CLIENT_AUTH"))
+		}
+	}
+
+	if err := reloader.load(); err != nil {
+		return nil, err
+	}
+	go reloader.watch()
+	return reloader, nil
+}
+
+func (r *tlsReloader) load() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the TLS certificate: %v", err)
+	}
+
+	var caPool *x509.CertPool
+	if r.caFile != "" {
+		caPEM, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("failed to load the client CA: %v", err)
+		}
+		caPool = x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caPEM) {
+			return fmt.Errorf("failed to load the client CA: no certificate in %s", r.caFile)
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.cert = &cert
+	r.caPool = caPool
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		r.fileModTime[file] = getFileModTime(file)
+	}
+	return nil
+}
+
+// watch loads the files again when any of them is changed, ex) renewed by cert-manager
+func (r *tlsReloader) watch() {
+	ticker := time.NewTicker(tlsReloadInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if !r.isChanged() {
+			continue
+		}
+		if err := r.load(); err != nil {
+			// keep serving with the current certificate, ex) the cert file is renewed before the key file
+			cblog.Error(err)
+			continue
+		}
+		cblog.Info("the TLS certificate is reloaded")
+	}
+}
+
+func (r *tlsReloader) isChanged() bool {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	for _, file := range []string{r.certFile, r.keyFile, r.caFile} {
+		if !getFileModTime(file).Equal(r.fileModTime[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func getFileModTime(file string) time.Time {
+	if file == "" {
+		return time.Time{}
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// tlsConfig returns the server config, the certificate and the client CAs are taken for each connection
+func (r *tlsReloader) tlsConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: []string{"h2", "http/1.1"},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			r.mutex.RLock()
+			defer r.mutex.RUnlock()
+			return &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				NextProtos:   []string{"h2", "http/1.1"},
+				Certificates: []tls.Certificate{*r.cert},
+				ClientAuth:   r.clientAuth,
+				ClientCAs:    r.caPool,
+			}, nil
+		},
+	}
+}
+
+func (r *tlsReloader) getCertificate() *tls.Certificate {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.cert
+}
+
+// loopbackClient returns the client of the loopback calls to the server, ex) AdminWeb => https://localhost:1024/spider
+func (r *tlsReloader) loopbackClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// localhost is not the name of the certificate, so the certificate itself is verified by VerifyConnection
+		InsecureSkipVerify: true,
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 || !bytes.Equal(state.PeerCertificates[0].Raw, r.getCertificate().Certificate[0]) {
+				return fmt.Errorf("the certificate of %s is not the certificate of the server", state.ServerName)
+			}
+			return nil
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.getCertificate(), nil
+		},
+	}
+	return &http.Client{Transport: transport}
+}
+
+// startServer starts the REST server with HTTP, or HTTPS when the certificate is set.
+func startServer(e *echo.Echo) error {
+	if !isTLSEnabled() {
+		return e.Start(cr.ServerPort)
+	}
+
+	reloader, err := newTLSReloader()
+	if err != nil {
+		return err
+	}
+	if reloader.clientAuth != tls.NoClientCert {
+		cblog.Info("**** Rest mTLS Enabled ****")
+	} else {
+		cblog.Info("**** Rest TLS Enabled ****")
+	}
+	aw.SetSpiderClient(reloader.loopbackClient())
+
+	if redirectAddress := os.Getenv("SPIDER_HTTP_REDIRECT_ADDRESS"); redirectAddress != "" {
+		go startRedirectServer(redirectAddress)
+	}
+
+	return e.StartServer(&http.Server{
+		Addr:      cr.ServerPort,
+		TLSConfig: reloader.tlsConfig(),
+	})
+}
+
+// startRedirectServer redirects the HTTP requests to the HTTPS server
+func startRedirectServer(address string) {
+	cblog.Infof("HTTP server on %s redirects to HTTPS", address)
+
+	if err := http.ListenAndServe(address, newRedirectHandler()); err != nil {
+		cblog.Errorf("Failed to start the HTTP redirect server: %v", err)
+	}
+}
+
+// newRedirectHandler redirects to the HTTPS server of the SERVICE_ADDRESS,
+// not to the Host header of the request(open redirect).
+func newRedirectHandler() http.Handler {
+	serviceURL := "https://" + cr.ServiceIPorName + cr.ServicePort
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		http.Redirect(w, req, serviceURL+req.URL.RequestURI(), http.StatusPermanentRedirect)
+	})
+}
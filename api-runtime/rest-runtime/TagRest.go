@@ -21,6 +21,7 @@ import (
 )
 
 //================ Tag Handler
+// ResIID: {NameId: "<Spider NameId>"} or {SystemId: "<CSP ID>"} for the resources not managed by Spider
 
 type tagAddReq struct {
 	ConnectionName string
@@ -161,3 +162,158 @@ func FindTag(c echo.Context) error {
 	jsonResult.Result = result
 	return c.JSON(http.StatusOK, &jsonResult)
 }
+
+// ================ Cross-Connection Tag Search and Tag-based Bulk Operations
+
+type tagSearchReq struct {
+	ConnectionNames []string    // empty: all connection configs
+	ResType         cres.RSType // "" or "all": all resource types
+	Key             string
+	Value           string // "": any value
+}
+
+func SearchTag(c echo.Context) error {
+	cblog.Info("call SearchTag()")
+
+	req := tagSearchReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.SearchTag(cmrt.TagSelectorInfo{
+		ConnectionNames: req.ConnectionNames,
+		ResType:         req.ResType,
+		Key:             req.Key,
+		Value:           req.Value,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.ConnectionTagSearchInfo `json:"connection"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+type tagBulkActionReq struct {
+	Selector tagSearchReq
+	Action   string // controlvm | delete | addtag | removetag
+
+	VMAction string        // for controlvm: suspend | resume | reboot
+	Force    string        // for delete: "true" | "false"
+	Tag      cres.KeyValue // for addtag: {Key, Value}, for removetag: {Key}
+
+	Confirm string // for delete without Selector.ConnectionNames: "true" to delete in all connections
+	DryRun  string // "true": return the target resources without running the action
+}
+
+func TagBulkAction(c echo.Context) error {
+	cblog.Info("call TagBulkAction()")
+
+	req := tagBulkActionReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.TagBulkAction(cmrt.TagBulkActionReqInfo{
+		Selector: cmrt.TagSelectorInfo{
+			ConnectionNames: req.Selector.ConnectionNames,
+			ResType:         req.Selector.ResType,
+			Key:             req.Selector.Key,
+			Value:           req.Selector.Value,
+		},
+		Action:   req.Action,
+		VMAction: req.VMAction,
+		Force:    req.Force,
+		Tag:      req.Tag,
+		Confirm:  req.Confirm,
+		DryRun:   req.DryRun,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.TagBulkActionResultInfo `json:"result"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+//================ Tag Policy Handler
+// ConnectionName: "" or "*" for the global policy applied to all connections
+
+type tagPolicyReq struct {
+	ConnectionName  string
+	DefaultTagList  []cres.KeyValue
+	RequiredKeyList []string
+}
+
+func SetTagPolicy(c echo.Context) error {
+	cblog.Info("call SetTagPolicy()")
+
+	req := tagPolicyReq{}
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	// Call common-runtime API
+	result, err := cmrt.SetTagPolicy(cmrt.TagPolicyInfo{
+		ConnectionName:  req.ConnectionName,
+		DefaultTagList:  req.DefaultTagList,
+		RequiredKeyList: req.RequiredKeyList,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func ListTagPolicy(c echo.Context) error {
+	cblog.Info("call ListTagPolicy()")
+
+	// Call common-runtime API
+	result, err := cmrt.ListTagPolicy()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result []*cmrt.TagPolicyInfo `json:"tagpolicy"`
+	}
+	jsonResult.Result = result
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+func GetTagPolicy(c echo.Context) error {
+	cblog.Info("call GetTagPolicy()")
+
+	// Call common-runtime API
+	result, err := cmrt.GetTagPolicy(c.Param("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	return c.JSON(http.StatusOK, result)
+}
+
+func DeleteTagPolicy(c echo.Context) error {
+	cblog.Info("call DeleteTagPolicy()")
+
+	// Call common-runtime API
+	result, err := cmrt.DeleteTagPolicy(c.Param("ConnectionName"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	resultInfo := BooleanInfo{
+		Result: strconv.FormatBool(result),
+	}
+
+	return c.JSON(http.StatusOK, &resultInfo)
+}
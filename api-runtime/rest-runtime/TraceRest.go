@@ -0,0 +1,68 @@
+// OpenTelemetry Trace Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package restruntime
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+
+	// REST API (echo)
+	"github.com/labstack/echo/v4"
+)
+
+// TracingMiddleware starts a span of each REST request with the trace context of the incoming headers(traceparent),
+// and binds it to the goroutine serving the request for the spans of the common-runtime and the drivers.
+func TracingMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		req := c.Request()
+		route := c.Path()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		// the connection of the body is read before the handler binds the body
+		connectionName, _ := getRequestConnectionName(c)
+
+		ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+		ctx, span := cmrt.Tracer().Start(ctx, req.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("http.method", req.Method),
+				attribute.String("http.route", route),
+				attribute.String("http.target", req.URL.RequestURI()),
+				attribute.String(cmrt.TRACE_CONNECTION_NAME, connectionName),
+				attribute.String(cmrt.TRACE_NAME_ID, c.Param("Name")),
+				attribute.String("spider.request_id", cmrt.RequestIDFromContext(ctx)),
+			))
+		defer span.End()
+
+		c.SetRequest(req.WithContext(ctx))
+		unbind := cmrt.BindRequestContext(ctx)
+		defer unbind()
+
+		err := next(c)
+
+		code, _ := getResponseStatus(c, err)
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.SetAttributes(attribute.Int("http.status_code", code))
+		if code >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(code))
+		}
+		return err
+	}
+}
@@ -170,6 +170,15 @@ func StartVM(c echo.Context) error {
 		VMUserPasswd: req.ReqInfo.VMUserPasswd,
 	}
 
+	// validate only without creating, ex) POST /...?dryRun=true
+	if isDryRun(c) {
+		result, err := cmrt.DryRunVM(req.ConnectionName, VM, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.StartVM(req.ConnectionName, VM, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -10,6 +10,8 @@ package restruntime
 
 import (
 	"encoding/json"
+	"fmt"
+	"strconv"
 
 	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
@@ -134,3 +136,94 @@ func GetOrgVMSpec(c echo.Context) error {
 
 	return c.JSON(http.StatusOK, map[string]interface{}{"VMSpecInfo": resultInterface})
 }
+
+type vmSpecRecommendReq struct {
+	ConnectionNames []string
+	MinVCpu         string // ex) "4"
+	MinMemGB        string // ex) "16"
+	MinGpu          string // ex) "1"
+	MaxPricePerHour string // optional, ex) "1.5"
+	Limit           string // optional, ex) "10"
+
+	MaxPriceCandidates string // optional, max number of specs per connection to look up the price, default: 10, "-1": all
+}
+
+// recommendVMSpec godoc
+// @Summary Recommend VM Specs
+// @Description Recommend VM Specs which meet the resource requirements across the connections, ranked by the price per hour
+// @Tags [CCM] VMSpec management
+// @Accept  json
+// @Produce  json
+// @Param vmSpecRecommendReq body vmSpecRecommendReq true "Request body to recommend VM Specs"
+// @Success 200 {object} []commonruntime.VMSpecRecommendInfo
+// @Failure 400 {object} SimpleMsg
+// @Failure 500 {object} SimpleMsg
+// @Router /vmspecrecommend [post]
+func RecommendVMSpec(c echo.Context) error {
+	cblog.Info("call RecommendVMSpec()")
+
+	req := vmSpecRecommendReq{}
+
+	if err := c.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	reqInfo := cmrt.VMSpecRequirementInfo{ConnectionNames: req.ConnectionNames}
+	var err error
+	if reqInfo.MinVCpu, err = atoiReqValue("MinVCpu", req.MinVCpu); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if reqInfo.MinMemGB, err = parseFloatReqValue("MinMemGB", req.MinMemGB); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if reqInfo.MinGpu, err = atoiReqValue("MinGpu", req.MinGpu); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if reqInfo.MaxPricePerHour, err = parseFloatReqValue("MaxPricePerHour", req.MaxPricePerHour); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if reqInfo.Limit, err = atoiReqValue("Limit", req.Limit); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	if reqInfo.MaxPriceCandidates, err = atoiReqValue("MaxPriceCandidates", req.MaxPriceCandidates); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Call common-runtime API
+	result, errInfoList, err := cmrt.RecommendVMSpec(reqInfo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	}
+
+	var jsonResult struct {
+		Result        []*cmrt.VMSpecRecommendInfo      `json:"recommendation"`
+		ErrorInfoList []*cmrt.VMSpecRecommendErrorInfo `json:"connectionError"`
+	}
+	jsonResult.Result = result
+	jsonResult.ErrorInfoList = errInfoList
+	return c.JSON(http.StatusOK, &jsonResult)
+}
+
+// "" => 0
+func atoiReqValue(name string, value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("%s(%s) should be a non-negative integer!", name, value)
+	}
+	return n, nil
+}
+
+// "" => 0
+func parseFloatReqValue(name string, value string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("%s(%s) should be a non-negative number!", name, value)
+	}
+	return f, nil
+}
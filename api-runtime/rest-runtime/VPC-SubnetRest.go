@@ -140,10 +140,12 @@ type vpcCreateReq struct {
 	ReqInfo         struct {
 		Name           string
 		IPv4_CIDR      string
+		IPv6_CIDR      string // optional, "auto": assigned by CSP
 		SubnetInfoList []struct {
 			Name      string
 			Zone      string
 			IPv4_CIDR string
+			IPv6_CIDR string // optional
 		}
 	}
 }
@@ -172,16 +174,26 @@ func CreateVPC(c echo.Context) error {
 	// (1) create SubnetInfo List
 	subnetInfoList := []cres.SubnetInfo{}
 	for _, info := range req.ReqInfo.SubnetInfoList {
-		subnetInfo := cres.SubnetInfo{IId: cres.IID{info.Name, ""}, IPv4_CIDR: info.IPv4_CIDR, Zone: info.Zone}
+		subnetInfo := cres.SubnetInfo{IId: cres.IID{info.Name, ""}, IPv4_CIDR: info.IPv4_CIDR, IPv6_CIDR: info.IPv6_CIDR, Zone: info.Zone}
 		subnetInfoList = append(subnetInfoList, subnetInfo)
 	}
 	// (2) create VPCReqInfo with SubnetInfo List
 	reqInfo := cres.VPCReqInfo{
 		IId:            cres.IID{req.ReqInfo.Name, ""},
 		IPv4_CIDR:      req.ReqInfo.IPv4_CIDR,
+		IPv6_CIDR:      req.ReqInfo.IPv6_CIDR,
 		SubnetInfoList: subnetInfoList,
 	}
 
+	// validate only without creating, ex) POST /...?dryRun=true
+	if isDryRun(c) {
+		result, err := cmrt.DryRunVPC(req.ConnectionName, VPC, reqInfo)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		}
+		return c.JSON(http.StatusOK, result)
+	}
+
 	// Call common-runtime API
 	result, err := cmrt.CreateVPC(req.ConnectionName, VPC, reqInfo, req.IDTransformMode)
 	if err != nil {
@@ -342,6 +354,7 @@ func AddSubnet(c echo.Context) error {
 			Name      string
 			Zone      string
 			IPv4_CIDR string
+			IPv6_CIDR string // optional
 		}
 	}
 
@@ -350,7 +363,7 @@ func AddSubnet(c echo.Context) error {
 	}
 
 	// Rest RegInfo => Driver ReqInfo
-	reqSubnetInfo := cres.SubnetInfo{IId: cres.IID{req.ReqInfo.Name, ""}, IPv4_CIDR: req.ReqInfo.IPv4_CIDR, Zone: req.ReqInfo.Zone}
+	reqSubnetInfo := cres.SubnetInfo{IId: cres.IID{req.ReqInfo.Name, ""}, IPv4_CIDR: req.ReqInfo.IPv4_CIDR, IPv6_CIDR: req.ReqInfo.IPv6_CIDR, Zone: req.ReqInfo.Zone}
 
 	// Call common-runtime API
 	result, err := cmrt.AddSubnet(req.ConnectionName, SUBNET, c.Param("VPCName"), reqSubnetInfo, req.IDTransformMode)
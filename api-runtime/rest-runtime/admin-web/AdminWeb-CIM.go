@@ -143,7 +143,7 @@ func makePostDriverFunc_js() string {
 
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -172,7 +172,7 @@ func makeDeleteDriverFunc_js() string {
 			location.reload();
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -300,7 +300,7 @@ func genLoggingGETResURL(rsType string) string {
 	   </script>
 	*/
 
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/" + rsType + " -H 'Content-Type: application/json' "
+	url := spiderURL("/"+rsType) + " -H 'Content-Type: application/json' "
 	htmlStr := `
                 <script type="text/javascript">
                 `
@@ -477,7 +477,7 @@ func makePostCredentialFunc_js() string {
 
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -506,7 +506,7 @@ func makeDeleteCredentialFunc_js() string {
 			location.reload();
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -796,7 +796,7 @@ func makePostRegionFunc_js() string {
 
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -825,7 +825,7 @@ func makeDeleteRegionFunc_js() string {
 			location.reload();
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -1102,7 +1102,7 @@ func SpiderInfo(c echo.Context) error {
                 `
 
 	htmlStr = strings.ReplaceAll(htmlStr, "$$STARTTIME$$", cr.StartTime)
-	htmlStr = strings.ReplaceAll(htmlStr, "$$APIENDPOINT$$", "http://"+cr.ServiceIPorName+cr.ServicePort+"/spider") // cr.ServicePort = ":1024"
+	htmlStr = strings.ReplaceAll(htmlStr, "$$APIENDPOINT$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort+"/spider") // cr.ServicePort = ":1024"
 
 	return c.HTML(http.StatusOK, htmlStr)
 }
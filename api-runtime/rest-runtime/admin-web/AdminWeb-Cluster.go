@@ -252,7 +252,7 @@ func makeOpenNodeInfoFunc_js() string {
 					win.document.write(textArea);
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -394,7 +394,7 @@ func makePostClusterFunc_js() string {
             location.reload();
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -436,7 +436,7 @@ func makeDeleteClusterFunc_js() string {
             location.reload();
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -490,7 +490,7 @@ func makePostNodeGroupFunc_js() string {
 						location.reload();
 				}
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -524,7 +524,7 @@ func makeRemoveNodeGroupFunc_js() string {
                         location.reload();
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
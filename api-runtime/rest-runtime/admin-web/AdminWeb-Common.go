@@ -22,6 +22,20 @@ import (
 	"net/http"
 )
 
+// the client of the loopback calls to the REST API of the Spider,
+// replaced with the client trusting the server certificate when the server serves HTTPS
+var spiderClient = &http.Client{}
+
+// SetSpiderClient sets the client of the loopback calls, ex) the client trusting the server certificate
+func SetSpiderClient(client *http.Client) {
+	spiderClient = client
+}
+
+// spiderURL returns the URL of a loopback call with the scheme of the server, ex) https://localhost:1024/spider/vpc
+func spiderURL(path string) string {
+	return cr.ServiceScheme + "://" + "localhost" + cr.ServerPort + "/spider" + path
+}
+
 func makeSelect_html(onchangeFunctionName string, strList []string, id string) string {
 
 	strSelect := `<select name="text_box" id="` + id + `" onchange="` + onchangeFunctionName + `(this)">`
@@ -202,11 +216,10 @@ func myImageList(connConfig string) []string {
 // -------------
 
 func getResourceList_JsonByte(resourceName string) ([]byte, error) {
-	// cr.ServicePort = ":1024"
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/" + resourceName
+	url := spiderURL("/" + resourceName)
 
 	// get object list
-	res, err := http.Get(url)
+	res, err := spiderClient.Get(url)
 	if err != nil {
 		return nil, err
 	}
@@ -219,8 +232,7 @@ func getResourceList_JsonByte(resourceName string) ([]byte, error) {
 }
 
 func getResourceList_with_Connection_JsonByte(connConfig string, resourceName string) ([]byte, error) {
-	// cr.ServicePort = ":1024"
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/" + resourceName
+	url := spiderURL("/" + resourceName)
 	// get object list
 	var reqBody struct {
 		Value string `json:"ConnectionName"`
@@ -234,8 +246,7 @@ func getResourceList_with_Connection_JsonByte(connConfig string, resourceName st
 	}
 	request.Header.Set("Content-Type", "application/json")
 
-	client := http.Client{}
-	resp, err := client.Do(request)
+	resp, err := spiderClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -249,8 +260,7 @@ func getResourceList_with_Connection_JsonByte(connConfig string, resourceName st
 }
 
 func getAllResourceList_with_Connection_JsonByte(connConfig string, resourceName string) ([]byte, error) {
-	// cr.ServicePort = ":1024"
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/all" + resourceName
+	url := spiderURL("/all" + resourceName)
 	// get object list
 	var reqBody struct {
 		Value string `json:"ConnectionName"`
@@ -264,8 +274,7 @@ func getAllResourceList_with_Connection_JsonByte(connConfig string, resourceName
 	}
 	request.Header.Set("Content-Type", "application/json")
 
-	client := http.Client{}
-	resp, err := client.Do(request)
+	resp, err := spiderClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -279,11 +288,10 @@ func getAllResourceList_with_Connection_JsonByte(connConfig string, resourceName
 }
 
 func getResource_JsonByte(resourceName string, name string) ([]byte, error) {
-	// cr.ServicePort = ":1024"
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/" + resourceName + "/" + name
+	url := spiderURL("/" + resourceName + "/" + name)
 
 	// get object list
-	res, err := http.Get(url)
+	res, err := spiderClient.Get(url)
 	if err != nil {
 		return nil, err
 	}
@@ -296,8 +304,7 @@ func getResource_JsonByte(resourceName string, name string) ([]byte, error) {
 }
 
 func getResource_with_Connection_JsonByte(connConfig string, resourceName string, name string) ([]byte, error) {
-	// cr.ServicePort = ":1024"
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/" + resourceName + "/" + name
+	url := spiderURL("/" + resourceName + "/" + name)
 	// get object list
 	var reqBody struct {
 		Value string `json:"ConnectionName"`
@@ -311,8 +318,7 @@ func getResource_with_Connection_JsonByte(connConfig string, resourceName string
 	}
 	request.Header.Set("Content-Type", "application/json")
 
-	client := http.Client{}
-	resp, err := client.Do(request)
+	resp, err := spiderClient.Do(request)
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +332,7 @@ func getResource_with_Connection_JsonByte(connConfig string, resourceName string
 }
 
 func getPriceInfoJsonString(connConfig string, resourceName string, productFamily string, regionName string, filter []cres.KeyValue, target interface{}) error {
-	url := fmt.Sprintf("http://localhost:1024/spider/%s/%s/%s", resourceName, productFamily, regionName)
+	url := spiderURL(fmt.Sprintf("/%s/%s/%s", resourceName, productFamily, regionName))
 
 	reqBody := struct {
 		ConnectionName string          `json:"ConnectionName"`
@@ -347,8 +353,7 @@ func getPriceInfoJsonString(connConfig string, resourceName string, productFamil
 	}
 	request.Header.Set("Content-Type", "application/json")
 
-	client := &http.Client{}
-	resp, err := client.Do(request)
+	resp, err := spiderClient.Do(request)
 	if err != nil {
 		return fmt.Errorf("failed to send request: %w", err)
 	}
@@ -430,7 +435,7 @@ func genLoggingGETURL(connConfig string, rsType string) string {
 	</script>
 	*/
 
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/" + rsType + " -H 'Content-Type: application/json' -d '{\\\"ConnectionName\\\": \\\"" + connConfig + "\\\"}'"
+	url := spiderURL("/"+rsType) + " -H 'Content-Type: application/json' -d '{\\\"ConnectionName\\\": \\\"" + connConfig + "\\\"}'"
 	htmlStr := `
 	<script type="text/javascript">
 		try {
@@ -449,7 +454,7 @@ func genLoggingGETURL2(connConfig string, rsType string) string {
 	/* return example
 	parent.frames["log_frame"].Log("curl -sX GET http://localhost:1024/spider/vpc -H 'Content-Type: application/json' -d '{"ConnectionName": "aws-ohio-config"}'   ");
 	*/
-	url := "http://" + "localhost" + cr.ServerPort + "/spider/" + rsType + " -H 'Content-Type: application/json' -d '{\\\"ConnectionName\\\": \\\"" + connConfig + "\\\"}'"
+	url := spiderURL("/"+rsType) + " -H 'Content-Type: application/json' -d '{\\\"ConnectionName\\\": \\\"" + connConfig + "\\\"}'"
 	htmlStr := `
 <script type="text/javascript">
     try {
@@ -375,7 +375,7 @@ func makePostConnectionConfigFunc_js() string {
 
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -404,7 +404,7 @@ func makeDeleteConnectionConfigFunc_js() string {
 			location.reload();
                 }
         `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -605,7 +605,7 @@ type Drivers struct {
 }
 
 func fetchConnectionConfigs() (map[string][]ConnectionConfig, error) {
-	resp, err := http.Get("http://localhost:1024/spider/connectionconfig")
+	resp, err := spiderClient.Get(spiderURL("/connectionconfig"))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching connection configurations: %v", err)
 	}
@@ -625,7 +625,7 @@ func fetchConnectionConfigs() (map[string][]ConnectionConfig, error) {
 }
 
 func fetchProviders() ([]string, error) {
-	resp, err := http.Get("http://localhost:1024/spider/cloudos")
+	resp, err := spiderClient.Get(spiderURL("/cloudos"))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching providers: %v", err)
 	}
@@ -640,7 +640,7 @@ func fetchProviders() ([]string, error) {
 }
 
 func fetchRegions() (map[string]string, error) {
-	resp, err := http.Get("http://localhost:1024/spider/region")
+	resp, err := spiderClient.Get(spiderURL("/region"))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching regions: %v", err)
 	}
@@ -671,7 +671,7 @@ func fetchRegions() (map[string]string, error) {
 }
 
 func fetchDrivers() (map[string]string, error) {
-	resp, err := http.Get("http://localhost:1024/spider/driver")
+	resp, err := spiderClient.Get(spiderURL("/driver"))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching drivers: %v", err)
 	}
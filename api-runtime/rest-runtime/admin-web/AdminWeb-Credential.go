@@ -39,7 +39,7 @@ type CredentialMetaInfo struct {
 }
 
 func fetchCredentials() (map[string][]CredentialInfo, error) {
-	resp, err := http.Get("http://localhost:1024/spider/credential")
+	resp, err := spiderClient.Get(spiderURL("/credential"))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching credentials: %v", err)
 	}
@@ -59,7 +59,7 @@ func fetchCredentials() (map[string][]CredentialInfo, error) {
 }
 
 func fetchCredentialMetaInfo(provider string) ([]string, error) {
-	resp, err := http.Get(fmt.Sprintf("http://localhost:1024/spider/cloudos/metainfo/%s", provider))
+	resp, err := spiderClient.Get(spiderURL(fmt.Sprintf("/cloudos/metainfo/%s", provider)))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching credential meta info for provider %s: %v", provider, err)
 	}
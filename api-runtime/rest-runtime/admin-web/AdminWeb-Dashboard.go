@@ -71,12 +71,11 @@ func fetchResourceCounts(config ConnectionConfig, provider string, wg *sync.Wait
 	var counts ResourceCounts
 	counts.ConnectionName = config.ConfigName
 
-	baseURL := "http://localhost:1024/spider"
 	resources := []string{"vpc", "subnet", "securitygroup", "vm", "keypair", "disk", "nlb", "cluster", "myimage"}
 
 	for _, resource := range resources {
-		url := fmt.Sprintf("%s/count%s/%s", baseURL, resource, config.ConfigName)
-		resp, err := http.Get(url)
+		url := spiderURL(fmt.Sprintf("/count%s/%s", resource, config.ConfigName))
+		resp, err := spiderClient.Get(url)
 		if err != nil {
 			errorChan <- fmt.Errorf("error fetching %s count for %s: %v", resource, config.ConfigName, err)
 			return
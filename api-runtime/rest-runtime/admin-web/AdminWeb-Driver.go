@@ -77,7 +77,7 @@ func makePostDriverFunc() string {
             location.reload();
         }
     `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -102,7 +102,7 @@ func makeDeleteDriverFunc() string {
             location.reload();
         }
     `
-	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", "http://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
+	strFunc = strings.ReplaceAll(strFunc, "$$SPIDER_SERVER$$", cr.ServiceScheme+"://"+cr.ServiceIPorName+cr.ServicePort) // cr.ServicePort = ":1024"
 	return strFunc
 }
 
@@ -150,7 +150,7 @@ func makeDriverTRListHTML(bgcolor string, height string, fontSize string, infoLi
 }
 
 func fetchDriverInfos() ([]*dim.CloudDriverInfo, error) {
-	resp, err := http.Get("http://localhost:1024/spider/driver")
+	resp, err := spiderClient.Get(spiderURL("/driver"))
 	if err != nil {
 		return nil, fmt.Errorf("error fetching drivers: %v", err)
 	}
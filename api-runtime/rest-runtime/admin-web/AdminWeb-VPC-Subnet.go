@@ -9,7 +9,6 @@ import (
 	"path/filepath"
 	"sort"
 
-	cr "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
 	cres "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 	"github.com/labstack/echo/v4"
 )
@@ -97,14 +96,13 @@ func DeleteVPC(c echo.Context) error {
 	vpcName := c.Param("Name")
 
 	// Make a DELETE request to the spider server
-	url := fmt.Sprintf("http://%s:%s/spider/vpc/%s?connectionName=%s", cr.ServiceIPorName, cr.ServicePort, vpcName, connConfig)
+	url := spiderURL(fmt.Sprintf("/vpc/%s?connectionName=%s", vpcName, connConfig))
 	req, err := http.NewRequest("DELETE", url, nil)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := spiderClient.Do(req)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
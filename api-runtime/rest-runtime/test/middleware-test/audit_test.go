@@ -0,0 +1,85 @@
+// Test for Audit Trail Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package middlewaretest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	restruntime "github.com/cloud-barista/cb-spider/api-runtime/rest-runtime"
+)
+
+// the audit records the mutating calls only
+func TestAuditMiddleware(t *testing.T) {
+	testList := []struct {
+		method string
+		target string
+		path   string
+		want   bool
+	}{
+		{http.MethodPost, "/spider/vm", "/spider/vm", true},
+		{http.MethodPost, "/spider/vm?dryRun=true", "/spider/vm", false},
+		{http.MethodDelete, "/spider/vm/vm-01", "/spider/vm/:Name", true},
+		{http.MethodPost, "/spider/vmspecrecommend", "/spider/vmspecrecommend", false},
+		{http.MethodPost, "/spider/tag/search", "/spider/tag/search", false},
+		{http.MethodPost, "/spider/priceinfo/compute/us-east-1", "/spider/priceinfo/:ProductFamily/:RegionName", false},
+		{http.MethodGet, "/spider/vm/vm-01", "/spider/vm/:Name", false},
+		{http.MethodGet, "/spider/controlvm/vm-01?action=reboot", "/spider/controlvm/:Name", true},
+	}
+	for idx, tc := range testList {
+		// the principal identifies the audit records of the call
+		principal := fmt.Sprintf("test-audit-%d-%s", idx, cmrt.NewRequestID())
+		e := echo.New()
+		e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				c.SetRequest(c.Request().WithContext(cmrt.WithPrincipal(c.Request().Context(), principal)))
+				return next(c)
+			}
+		})
+		e.Use(restruntime.AuditMiddleware)
+		e.Add(tc.method, tc.path, func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(tc.method, tc.target, nil))
+
+		auditList, err := cmrt.ListAudit(cmrt.AuditQueryInfo{Principal: principal})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := len(auditList) == 1; got != tc.want {
+			t.Errorf("%s %s: audit records = %d, want recorded %v", tc.method, tc.target, len(auditList), tc.want)
+		}
+	}
+}
+
+func TestListAuditQuery(t *testing.T) {
+	testList := []struct {
+		target   string
+		wantCode int
+	}{
+		{"/spider/audit?Principal=admin&Outcome=failure&StartTime=2024-01-02T15:04:05Z&EndTime=2024-01-03T15:04:05Z&Limit=10", http.StatusOK},
+		{"/spider/audit?StartTime=2024-01-02T15:04:05Z&Since=1h", http.StatusOK},
+		{"/spider/audit?StartTime=yesterday", http.StatusBadRequest},
+		{"/spider/audit?Since=-1h", http.StatusBadRequest},
+		{"/spider/audit?Limit=ten", http.StatusBadRequest},
+	}
+	for _, tc := range testList {
+		e := echo.New()
+		e.GET("/spider/audit", restruntime.ListAudit)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tc.target, nil))
+		if rec.Code != tc.wantCode {
+			t.Errorf("%s: code = %d, want %d, body: %s", tc.target, rec.Code, tc.wantCode, rec.Body.String())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Test for User, API Token and Role Binding Rest Runtime of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package middlewaretest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	cmrt "github.com/cloud-barista/cb-spider/api-runtime/common-runtime"
+	restruntime "github.com/cloud-barista/cb-spider/api-runtime/rest-runtime"
+)
+
+const testUserPassword = "password-01"
+
+func createTestUser(t *testing.T, userName string) {
+	t.Helper()
+	cmrt.DeleteUser(userName)
+	if _, err := cmrt.CreateUser(userName, testUserPassword); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cmrt.DeleteUser(userName) })
+}
+
+// the connections of the call are authorized, and the body is restored for the handler
+func TestAuthMiddleware(t *testing.T) {
+	// the first user is the admin of all connections
+	if userList, err := cmrt.ListUser(); err != nil || len(userList) == 0 {
+		createTestUser(t, "test-auth-first-user")
+	}
+	const userName = "test-auth-operator"
+	createTestUser(t, userName)
+	_, err := cmrt.AddRoleBinding(cmrt.RoleBindingInfo{UserName: userName, Role: cmrt.ROLE_OPERATOR, ConnectionPattern: "conn-01"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testList := []struct {
+		name     string
+		target   string
+		path     string
+		body     string
+		wantCode int
+	}{
+		{"query", "/spider/vm?ConnectionName=conn-01", "/spider/vm", "", http.StatusOK},
+		{"query and body", "/spider/vm?ConnectionName=conn-01", "/spider/vm", `{"ConnectionName":"conn-01"}`, http.StatusOK},
+		{"different query and body", "/spider/vm?ConnectionName=conn-01", "/spider/vm", `{"ConnectionName":"conn-02"}`, http.StatusBadRequest},
+		{"not permitted connection", "/spider/vm", "/spider/vm", `{"ConnectionName":"conn-02"}`, http.StatusForbidden},
+		{"tag search", "/spider/tag/search", "/spider/tag/search", `{"ConnectionNames":["conn-01"]}`, http.StatusOK},
+		{"tag search with a not permitted connection", "/spider/tag/search", "/spider/tag/search", `{"ConnectionNames":["conn-01","conn-02"]}`, http.StatusForbidden},
+		{"tag search on all connections", "/spider/tag/search", "/spider/tag/search", `{"Tag":{"Key":"env"}}`, http.StatusForbidden},
+		{"tag bulk on all connections", "/spider/tag/bulk", "/spider/tag/bulk", `{"Action":"delete","Selector":{}}`, http.StatusForbidden},
+		{"infra spec", "/spider/infra/apply", "/spider/infra/apply", "connectionlist:\n  - connectionname: conn-01\n", http.StatusOK},
+	}
+	for _, tc := range testList {
+		e := echo.New()
+		e.Use(restruntime.AuthMiddleware("", "", map[string]bool{}))
+		e.POST(tc.path, func(c echo.Context) error {
+			// the body is restored for the handler
+			if body, _ := io.ReadAll(c.Request().Body); string(body) != tc.body {
+				t.Errorf("%s: body = %s, want %s", tc.name, body, tc.body)
+			}
+			return c.NoContent(http.StatusOK)
+		})
+
+		req := httptest.NewRequest(http.MethodPost, tc.target, strings.NewReader(tc.body))
+		req.SetBasicAuth(userName, testUserPassword)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != tc.wantCode {
+			t.Errorf("%s: code = %d, want %d, body: %s", tc.name, rec.Code, tc.wantCode, rec.Body.String())
+		}
+	}
+}
@@ -15,9 +15,11 @@
 package calllog
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -65,6 +67,10 @@ const (
 
 	//=========== PMKS: Provider-Managed K8S
 	CLUSTER RES_TYPE = "CLUSTER"
+
+	//=========== Log Format
+	LOG_FORMAT_TEXT = "text"
+	LOG_FORMAT_JSON = "json"
 )
 
 type CALLLogger struct {
@@ -76,8 +82,9 @@ type CALLLogger struct {
 var (
 	HostIPorName  string
 	callLogger    *CALLLogger
-	callFormatter *calllogformatter.Formatter
+	callFormatter logrus.Formatter
 	calllogConfig CALLLOGCONFIG
+	callLogFormat string // text | json
 )
 
 func init() {
@@ -104,6 +111,7 @@ func GetLogger(loggerName string) *logrus.Logger {
 	}
 	callLogger = new(CALLLogger)
 	callLogger.loggerName = loggerName
+	callLogFormat = strings.ToLower(GetConfigInfos().CALLLOG.LOGFORMAT)
 	callLogger.logrus = &logrus.Logger{
 		Level:     logrus.InfoLevel,
 		Out:       os.Stderr,
@@ -184,11 +192,19 @@ func GetLevel() string {
 	return callLogger.logrus.GetLevel().String()
 }
 
-func getFormatter(loggerName string) *calllogformatter.Formatter {
+func getFormatter(loggerName string) logrus.Formatter {
 
 	if callFormatter != nil {
 		return callFormatter
 	}
+	if callLogFormat == LOG_FORMAT_JSON {
+		callFormatter = &calllogformatter.JSONFormatter{
+			LoggerName:    loggerName,
+			HostName:      HostIPorName,
+			RawMessageKey: "call",
+		}
+		return callFormatter
+	}
 	callFormatter = &calllogformatter.Formatter{
 		TimestampFormat: "2006-01-02 15:04:05",
 		LogFormat:       "[" + loggerName + "].[" + HostIPorName + "] %time% (%weekday%) %func% - %msg%\n",
@@ -205,6 +221,7 @@ type CLOUDLOGSCHEMA struct {
 	CloudOSAPI   string   // ex) CreateKeyPair()
 	ElapsedTime  string   // ex) 2.0201 (sec)
 	ErrorMSG     string   // if success, ""
+	RequestID    string   // ex) cs3m8rqkn7pc73d0q0ag, set by String() if empty
 }
 
 /* TBD or Do not support.
@@ -220,7 +237,62 @@ func Elapsed(start time.Time) string {
 	return fmt.Sprintf("%.4f", time.Since(start).Seconds())
 }
 
+// ElapsedSeconds returns the elapsed time of a call-log in seconds,
+// false if unknown: ElapsedTime, ex) "2.0201", is empty in some error logs.
+func ElapsedSeconds(callInfo CLOUDLOGSCHEMA) (float64, bool) {
+	elapsed, err := strconv.ParseFloat(strings.TrimSpace(callInfo.ElapsedTime), 64)
+	if err != nil {
+		return 0, false
+	}
+	return elapsed, true
+}
+
+// observers of the driver calls, ex) metrics
+var callObserverList []func(CLOUDLOGSCHEMA)
+
+// provider of the Request ID of the current call, ex) Request ID of the REST request
+var requestIDFunc func() string
+
+// SetRequestIDFunc sets the provider of the Request ID added to each call-log.
+// It should be called at the init time.
+func SetRequestIDFunc(fn func() string) {
+	requestIDFunc = fn
+}
+
+// AddCallObserver adds an observer called with each call-log passed to Observe().
+// It should be called at the init time.
+func AddCallObserver(observer func(CLOUDLOGSCHEMA)) {
+	callObserverList = append(callObserverList, observer)
+}
+
+// Observe passes a call-log of a driver call to the observers, ex) metrics, tracing.
+// It is called before logging the call-log.
+//
+//	ex) call.Observe(callLogInfo)
+//	    callogger.Info(call.String(callLogInfo))
+func Observe(callInfo CLOUDLOGSCHEMA) {
+	callInfo = withRequestID(callInfo)
+	for _, observer := range callObserverList {
+		observer(callInfo)
+	}
+}
+
+func withRequestID(callInfo CLOUDLOGSCHEMA) CLOUDLOGSCHEMA {
+	if callInfo.RequestID == "" && requestIDFunc != nil {
+		callInfo.RequestID = requestIDFunc()
+	}
+	return callInfo
+}
+
 func String(logInfo interface{}) string {
+	if callInfo, ok := logInfo.(CLOUDLOGSCHEMA); ok {
+		callInfo = withRequestID(callInfo)
+		logInfo = callInfo
+		if callLogFormat == LOG_FORMAT_JSON {
+			return jsonString(callInfo)
+		}
+	}
+
 	t := reflect.TypeOf(logInfo)
 	v := reflect.ValueOf(logInfo)
 
@@ -237,3 +309,39 @@ func String(logInfo interface{}) string {
 
 	return msg
 }
+
+// JSON form of a call-log with the typed fields, used when the log format is json
+type callLogRecord struct {
+	CloudOS      string   `json:"CloudOS"`
+	RegionZone   string   `json:"RegionZone"`
+	ResourceType string   `json:"ResourceType"`
+	ResourceName string   `json:"ResourceName"`
+	CloudOSAPI   string   `json:"CloudOSAPI"`
+	ElapsedTime  *float64 `json:"ElapsedTime"` // sec, null if unknown
+	ErrorMSG     *string  `json:"ErrorMSG"`    // null if success
+	RequestID    string   `json:"RequestID"`
+}
+
+func jsonString(callInfo CLOUDLOGSCHEMA) string {
+	record := callLogRecord{
+		CloudOS:      string(callInfo.CloudOS),
+		RegionZone:   callInfo.RegionZone,
+		ResourceType: string(callInfo.ResourceType),
+		ResourceName: callInfo.ResourceName,
+		CloudOSAPI:   callInfo.CloudOSAPI,
+		RequestID:    callInfo.RequestID,
+	}
+	if elapsed, err := strconv.ParseFloat(strings.TrimSpace(callInfo.ElapsedTime), 64); err == nil {
+		record.ElapsedTime = &elapsed
+	}
+	if callInfo.ErrorMSG != "" {
+		record.ErrorMSG = &callInfo.ErrorMSG
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		logrus.Error(err)
+		return ""
+	}
+	return string(b)
+}
@@ -29,6 +29,7 @@ type CALLLOGCONFIG struct {
                 LOOPCHECK bool
                 LOGLEVEL string
                 LOGFILE bool
+                LOGFORMAT string // text | json, default: text
         }
 
         LOGFILEINFO struct {
@@ -0,0 +1,97 @@
+// Call-Log: calling logger of Cloud & VM in CB-Spider
+//           Referred to cb-log
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//      * CB-Spider: https://github.com/cloud-barista/cb-spider
+//      * cb-log: https://github.com/cloud-barista/cb-log
+//
+// JSON Lines formatter of the server logger and the call-log
+//
+// by CB-Spider Team, 2024.
+
+package calllogformatter
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// version of the JSON log schema, increased when a field is changed or removed
+const LOG_SCHEMA_VERSION = 1
+
+// JSONFormatter implements logrus.Formatter interface with one JSON object per line, ex)
+//
+//	{"schema_version":1,"time":"2024-01-02T15:04:05.123Z","level":"info","logger":"CLOUD-BARISTA","host":"10.0.0.1",
+//	 "func":"commonruntime.StartVM():360","msg":"call StartVM()","fields":{"RequestID":"cs3m8rqkn7pc73d0q0ag"}}
+type JSONFormatter struct {
+	LoggerName string // ex) CLOUD-BARISTA, HISCALL
+	HostName   string // ex) 10.0.0.1
+	// if set, a message of a JSON object is embedded with this key instead of "msg", ex) "call"
+	RawMessageKey string
+}
+
+type jsonLogRecord struct {
+	SchemaVersion int                    `json:"schema_version"`
+	Time          string                 `json:"time"`
+	Level         string                 `json:"level"`
+	Logger        string                 `json:"logger"`
+	Host          string                 `json:"host,omitempty"`
+	Func          string                 `json:"func,omitempty"`
+	Message       *string                `json:"msg,omitempty"`
+	Fields        map[string]interface{} `json:"fields,omitempty"`
+}
+
+// Format building log message.
+func (f *JSONFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	record := jsonLogRecord{
+		SchemaVersion: LOG_SCHEMA_VERSION,
+		Time:          entry.Time.Format(time.RFC3339Nano),
+		Level:         entry.Level.String(),
+		Logger:        f.LoggerName,
+		Host:          f.HostName,
+	}
+	if entry.HasCaller() {
+		record.Func = fmt.Sprintf("%s():%d", entry.Caller.Function, entry.Caller.Line)
+	}
+	if len(entry.Data) > 0 {
+		record.Fields = make(map[string]interface{}, len(entry.Data))
+		for k, v := range entry.Data {
+			switch value := v.(type) {
+			case error:
+				record.Fields[k] = value.Error()
+			default:
+				if _, err := json.Marshal(value); err != nil {
+					record.Fields[k] = fmt.Sprint(value)
+				} else {
+					record.Fields[k] = value
+				}
+			}
+		}
+	}
+
+	message := entry.Message
+	var rawMessage json.RawMessage
+	if f.RawMessageKey != "" && len(message) > 0 && message[0] == '{' && json.Valid([]byte(message)) {
+		rawMessage = json.RawMessage(message)
+	} else {
+		record.Message = &message
+	}
+
+	b, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	if rawMessage != nil {
+		// {...,"fields":{...}} => {...,"fields":{...},"call":{...}}
+		key, _ := json.Marshal(f.RawMessageKey)
+		b = append(b[:len(b)-1], ',')
+		b = append(b, key...)
+		b = append(b, ':')
+		b = append(b, rawMessage...)
+		b = append(b, '}')
+	}
+	return append(b, '\n'), nil
+}
@@ -0,0 +1,74 @@
+// Call-Log: calling logger of Cloud & VM in CB-Spider
+//           Referred to cb-log
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//      * CB-Spider: https://github.com/cloud-barista/cb-spider
+//      * cb-log: https://github.com/cloud-barista/cb-log
+//
+// Tests of the JSON Lines formatter
+//
+// by CB-Spider Team, 2024.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	calllogformatter "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log/formatter"
+)
+
+func TestJSONFormatterEscapes(t *testing.T) {
+	var buf bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&buf)
+	logger.SetFormatter(&calllogformatter.JSONFormatter{LoggerName: "CLOUD-BARISTA", HostName: "10.0.0.1", RawMessageKey: "call"})
+
+	messageList := []string{
+		`say "hello" and \ back`,
+		"multi\nline\r\nmessage",
+		"tab\tbell\aNUL\x00ESC\x1b[31mred",
+		"invalid UTF-8 \xff\xfe",
+		`{"CloudOS":"AWS","ErrorMSG":"line1\nline2 \"quoted\""}`,
+		`{not a JSON object`,
+	}
+	for _, message := range messageList {
+		logger.WithField("RequestID", "id \"1\"\n").WithField("Error", errors.New("err\x01")).Info(message)
+	}
+
+	lineList := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lineList) != len(messageList) {
+		t.Fatalf("line count = %d, want %d: one JSON object per line\n%s", len(lineList), len(messageList), buf.String())
+	}
+	for idx, line := range lineList {
+		var record map[string]interface{}
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Errorf("invalid JSON line %q: %v", line, err)
+			continue
+		}
+		if record["schema_version"] != float64(calllogformatter.LOG_SCHEMA_VERSION) || record["level"] != "info" || record["logger"] != "CLOUD-BARISTA" {
+			t.Errorf("invalid record header: %v", record)
+		}
+		fields, _ := record["fields"].(map[string]interface{})
+		if fields["RequestID"] != "id \"1\"\n" || fields["Error"] != "err\x01" {
+			t.Errorf("invalid fields: %v", fields)
+		}
+
+		message := messageList[idx]
+		if strings.HasPrefix(message, `{"`) {
+			call, ok := record["call"].(map[string]interface{})
+			if !ok || call["ErrorMSG"] != "line1\nline2 \"quoted\"" {
+				t.Errorf("invalid embedded call: %v", record)
+			}
+			continue
+		}
+		if !strings.HasPrefix(message, "invalid UTF-8") && record["msg"] != message {
+			t.Errorf("msg = %q, want %q", record["msg"], message)
+		}
+	}
+}
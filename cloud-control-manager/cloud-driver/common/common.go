@@ -15,9 +15,11 @@ import (
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/binary"
 	"encoding/pem"
 	"fmt"
 	"io"
+	"net"
 
 	"errors"
 	"regexp"
@@ -102,11 +104,13 @@ func init() {
 	}
 	db.AutoMigrate(&LocalKeyInfo{})
 	infostore.Close(db)
+
+	enc.AddReEncryptor("privatekey", reEncryptKeys, listEncryptedKeys)
 }
 
 func AddKey(providerName string, hashString string, keyPairNameId string, privateKey string) error {
 
-	encPrivateKey, err := enc.Encrypt(enc.SPIDER_KEY, []byte(privateKey))
+	encPrivateKey, err := enc.EncryptString(privateKey)
 	if err != nil {
 		return err
 	}
@@ -132,7 +136,7 @@ func ListKey(providerName string, hashString string) ([]*irs.KeyValue, error) {
 	var keyValueList []*irs.KeyValue
 	for _, iidInfo := range iidInfoList {
 
-		decPrivateKey, err := enc.Decrypt(enc.SPIDER_KEY, []byte(iidInfo.PrivateKey))
+		decPrivateKey, err := enc.DecryptString(iidInfo.PrivateKey)
 		if err != nil {
 			return nil, err
 		}
@@ -157,7 +161,7 @@ func GetKey(providerName string, hashString string, keyPairNameId string) (*irs.
 		return nil, err
 	}
 
-	decPrivateKey, err := enc.Decrypt(enc.SPIDER_KEY, []byte(localKeyInfo.PrivateKey))
+	decPrivateKey, err := enc.DecryptString(localKeyInfo.PrivateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -170,6 +174,60 @@ func GetKey(providerName string, hashString string, keyPairNameId string) (*irs.
 	return keyValue, nil
 }
 
+// listEncryptedKeys returns the encrypted private keys
+func listEncryptedKeys() ([]string, error) {
+
+	var localKeyInfoList []*LocalKeyInfo
+	err := infostore.List(&localKeyInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	keyList := []string{}
+	for _, localKeyInfo := range localKeyInfoList {
+		keyList = append(keyList, localKeyInfo.PrivateKey)
+	}
+	return keyList, nil
+}
+
+// reEncryptKeys re-encrypts the private keys with the active encryption key.
+// A key is updated only when it is not changed during the re-encryption.
+func reEncryptKeys() (int, error) {
+
+	var localKeyInfoList []*LocalKeyInfo
+	err := infostore.List(&localKeyInfoList)
+	if err != nil {
+		return 0, err
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer infostore.Close(db)
+
+	count := 0
+	for _, localKeyInfo := range localKeyInfoList {
+		encPrivateKey, reEncrypted, err := enc.ReEncryptString(localKeyInfo.PrivateKey)
+		if err != nil {
+			return count, fmt.Errorf("failed to re-encrypt the private key '%s': %v", localKeyInfo.NameId, err)
+		}
+		if !reEncrypted {
+			continue
+		}
+
+		ret := db.Model(&LocalKeyInfo{}).
+			Where("provider_name = ? AND hash_string = ? AND name_id = ? AND private_key = ?",
+				localKeyInfo.ProviderName, localKeyInfo.HashString, localKeyInfo.NameId, localKeyInfo.PrivateKey).
+			Update("private_key", encPrivateKey)
+		if ret.Error != nil {
+			return count, ret.Error
+		}
+		count += int(ret.RowsAffected)
+	}
+	return count, nil
+}
+
 func DelKey(providerName string, hashString string, keyPairNameId string) error {
 
 	_, err := infostore.DeleteBy3Conditions(&LocalKeyInfo{}, "provider_name", providerName, "hash_string", hashString, "name_id", keyPairNameId)
@@ -249,3 +307,38 @@ func ValidateWindowsPassword(pw string) error {
 
 	}
 }
+
+// IPv6 subnet size of the CSPs, ex) AWS, GCP, Azure
+const SUBNET_IPV6_PREFIX_LENGTH = 64
+
+// AllocSubnetIPv6CIDR returns the first /64 subnet CIDR of the VPC IPv6 CIDR not in the used list.
+// ex) AllocSubnetIPv6CIDR("2001:db8:1234:ab00::/56", []string{"2001:db8:1234:ab00::/64"}) => "2001:db8:1234:ab01::/64"
+func AllocSubnetIPv6CIDR(vpcIPv6CIDR string, usedCIDRList []string) (string, error) {
+	ip, vpcNet, err := net.ParseCIDR(vpcIPv6CIDR)
+	if err != nil || ip.To4() != nil {
+		return "", fmt.Errorf("invalid VPC IPv6 CIDR '%s'", vpcIPv6CIDR)
+	}
+	prefixLength, _ := vpcNet.Mask.Size()
+	if prefixLength > SUBNET_IPV6_PREFIX_LENGTH {
+		return "", fmt.Errorf("the VPC IPv6 CIDR '%s' is smaller than a /%d subnet", vpcIPv6CIDR, SUBNET_IPV6_PREFIX_LENGTH)
+	}
+
+	usedMap := map[string]bool{}
+	for _, cidr := range usedCIDRList {
+		if _, usedNet, err := net.ParseCIDR(cidr); err == nil {
+			usedMap[usedNet.String()] = true
+		}
+	}
+
+	base := binary.BigEndian.Uint64(vpcNet.IP[:8])
+	count := uint64(1) << uint(SUBNET_IPV6_PREFIX_LENGTH-prefixLength)
+	for i := uint64(0); i < count && i < 65536; i++ {
+		subnetIP := make(net.IP, net.IPv6len)
+		binary.BigEndian.PutUint64(subnetIP[:8], base|i)
+		subnetNet := net.IPNet{IP: subnetIP, Mask: net.CIDRMask(SUBNET_IPV6_PREFIX_LENGTH, 128)}
+		if !usedMap[subnetNet.String()] {
+			return subnetNet.String(), nil
+		}
+	}
+	return "", fmt.Errorf("no available /%d subnet in the VPC IPv6 CIDR '%s'", SUBNET_IPV6_PREFIX_LENGTH, vpcIPv6CIDR)
+}
@@ -0,0 +1,35 @@
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package validatetest
+
+import (
+	"testing"
+
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
+)
+
+func TestAllocSubnetIPv6CIDR(t *testing.T) {
+	testList := []struct {
+		vpcCIDR  string
+		usedList []string
+		want     string
+		wantErr  bool
+	}{
+		{"2001:db8:1234:ab00::/56", nil, "2001:db8:1234:ab00::/64", false},
+		{"2001:db8:1234:ab00::/56", []string{"2001:db8:1234:ab00::/64", "2001:db8:1234:ab02::/64"}, "2001:db8:1234:ab01::/64", false},
+		{"2001:db8:1234:ab00::/64", []string{"2001:db8:1234:ab00::/64"}, "", true},
+		{"2001:db8:1234:ab00::/72", nil, "", true},
+		{"10.0.0.0/16", nil, "", true},
+	}
+	for _, tc := range testList {
+		got, err := cdcom.AllocSubnetIPv6CIDR(tc.vpcCIDR, tc.usedList)
+		if (err != nil) != tc.wantErr || got != tc.want {
+			t.Errorf("AllocSubnetIPv6CIDR(%s, %v) = %s, %v, want %s", tc.vpcCIDR, tc.usedList, got, err, tc.want)
+		}
+	}
+}
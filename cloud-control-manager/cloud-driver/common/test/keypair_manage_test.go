@@ -15,6 +15,9 @@ import (
 
 func TestAddListGetDelete(t *testing.T) {
 
+	// the private keys are encrypted with the key ring loaded at the first use
+	t.Setenv("SPIDER_ENCRYPTION_KEYS", "test=MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+
 	privateKey, _, err := cdcom.GenKeyPair()
 
 	strList:= []string{
@@ -63,6 +66,9 @@ func TestAddListGetDelete(t *testing.T) {
                 log.Fatal("something failed!")
         }
         log.Println(keyValue)
+	if keyValue.Value != string(privateKey) {
+                log.Fatal("The decrypted Key is not the added Key!")
+	}
 
 
 	// (4) delete-1
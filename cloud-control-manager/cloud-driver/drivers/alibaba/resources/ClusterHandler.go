@@ -321,6 +321,7 @@ func (ach *AlibabaClusterHandler) DeleteCluster(clusterIID irs.IID) (bool, error
 		err = fmt.Errorf("Failed to Delete Cluster: %v", err)
 		cblogger.Error(err)
 		hiscallInfo.ErrorMSG = err.Error()
+		call.Observe(hiscallInfo)
 		calllogger.Error(call.String(hiscallInfo))
 		return false, err
 	}
@@ -341,6 +342,7 @@ func (ach *AlibabaClusterHandler) DeleteCluster(clusterIID irs.IID) (bool, error
 		err := fmt.Errorf("Failed to Delete Cluster: %v", err)
 		cblogger.Error(err)
 		hiscallInfo.ErrorMSG = err.Error()
+		call.Observe(hiscallInfo)
 		calllogger.Error(call.String(hiscallInfo))
 		return false, err
 	}
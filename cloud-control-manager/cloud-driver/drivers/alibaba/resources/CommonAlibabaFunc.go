@@ -89,11 +89,13 @@ func InitLog() {
 
 func LoggingError(hiscallInfo call.CLOUDLOGSCHEMA, err error) {
 	hiscallInfo.ErrorMSG = err.Error()
+	call.Observe(hiscallInfo)
 	calllogger.Error(call.String(hiscallInfo))
 }
 
 func LoggingInfo(hiscallInfo call.CLOUDLOGSCHEMA, start time.Time) {
 	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 }
 
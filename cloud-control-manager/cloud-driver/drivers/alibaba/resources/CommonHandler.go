@@ -77,11 +77,13 @@ func DescribeDisks(client *ecs.Client, regionInfo idrv.RegionInfo, instanceIID i
 		//cblogger.Debug(result) //출력 정보가 너무 많아서 생략
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 
 			cblogger.Errorf("Unable to get Disks, %v", err)
 			return resultDiskList, err
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		resultDiskList = append(resultDiskList, result.Disks.Disk...)
@@ -273,11 +275,13 @@ func AttachDisk(client *ecs.Client, regionInfo idrv.RegionInfo, ownerVM irs.IID,
 	cblogger.Debug(result)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to attach Disk: %s, %v.", diskIID.SystemId, err)
 		return err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	return nil
 }
@@ -319,6 +323,7 @@ func DescribeInstances(client *ecs.Client, regionInfo idrv.RegionInfo, vmIIDs []
 	callLogStart := call.Start()
 	response, err := client.DescribeInstances(request)
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return response.Instances.Instance, err
@@ -632,9 +637,11 @@ func DescribeRegions(client *ecs.Client) (*ecs.DescribeRegionsResponse, error) {
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	// request := ecs.CreateDescribeRegionsRequest()
@@ -684,9 +691,11 @@ func DescribeZonesByRegion(client *ecs.Client, regionId string) (*ecs.DescribeZo
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	return result, nil
 }
@@ -863,6 +872,7 @@ func DescribeDescribeEcsTags(client *ecs.Client, regionInfo idrv.RegionInfo, res
 	response, err := client.ProcessCommonRequest(request)
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	if err != nil {
 		cblogger.Error(err.Error())
@@ -1019,6 +1029,7 @@ func DescribeDescribeVpcTags(client *vpc.Client, regionInfo idrv.RegionInfo, res
 	response, err := client.ProcessCommonRequest(request)
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	if err != nil {
 		cblogger.Error(err.Error())
@@ -1072,6 +1083,7 @@ func CallEcsRequest(resType irs.RSType, client *ecs.Client, regionInfo idrv.Regi
 	}
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Debug(response.GetHttpContentString())
@@ -1113,6 +1125,7 @@ func CallVpcRequest(resType irs.RSType, client *vpc.Client, regionInfo idrv.Regi
 	response, err := client.ProcessCommonRequest(request)
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	if err != nil {
 		cblogger.Error(err.Error())
@@ -89,6 +89,7 @@ func (diskHandler *AlibabaDiskHandler) CreateDisk(diskReqInfo irs.DiskInfo) (irs
 		cblogger.Errorf("Unable to create Disk: %s, %v.", diskReqInfo.IId.NameId, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Infof("Created Disk %q %s\n %s\n", result.DiskId, diskReqInfo.IId.NameId, result.RequestId)
@@ -135,6 +136,7 @@ func (diskHandler *AlibabaDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
 		LoggingError(hiscallInfo, err)
 		return nil, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	//regionID := diskHandler.Region.Region
@@ -226,6 +228,7 @@ func (diskHandler *AlibabaDiskHandler) GetDisk(diskIID irs.IID) (irs.DiskInfo, e
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 	//request := ecs.CreateDescribeDisksRequest()
 	//request.Scheme = "https"
@@ -304,6 +307,7 @@ func (diskHandler *AlibabaDiskHandler) ChangeDiskSize(diskIID irs.IID, size stri
 		cblogger.Errorf("Unable to resize Disk: %s, %v.", diskIID.SystemId, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Infof("Successfully resized %q Disk\n", diskIID.SystemId)
@@ -332,6 +336,7 @@ func (diskHandler *AlibabaDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error)
 		cblogger.Errorf("Unable to delete Disk: %s, %v.", diskIID.SystemId, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Infof("Successfully deleted %q Disk\n", diskIID.SystemId)
@@ -396,6 +401,7 @@ func (diskHandler *AlibabaDiskHandler) AttachDisk(diskIID irs.IID, ownerVM irs.I
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Infof("Successfully attached  %q Disk\n", diskIID.SystemId)
@@ -457,6 +463,7 @@ func (diskHandler *AlibabaDiskHandler) DetachDisk(diskIID irs.IID, ownerVM irs.I
 		cblogger.Errorf("Unable to detach Disk: %s, %v.", diskIID.SystemId, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Infof("Successfully detached  %q Disk\n", diskIID.SystemId)
@@ -95,11 +95,13 @@ func (imageHandler *AlibabaImageHandler) CreateImage(imageReqInfo irs.ImageReqIn
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to create Image: %s, %v.", imageReqInfo.IId.NameId, err)
 		return irs.ImageInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("Created Image %q %s\n %s\n", result.ImageId, imageReqInfo.IId.NameId, result.RequestId)
@@ -157,11 +159,13 @@ func (imageHandler *AlibabaImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 		//cblogger.Debug(result) //출력 정보가 너무 많아서 생략
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 
 			cblogger.Errorf("Unable to get Images, %v", err)
 			return nil, err
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		//cnt := 0
@@ -315,11 +319,13 @@ func (imageHandler *AlibabaImageHandler) DeleteImage(imageIID irs.IID) (bool, er
 	cblogger.Debug(result)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to delete Image: %s, %v.", imageIID.SystemId, err)
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("Successfully deleted %q Image\n", imageIID.SystemId)
@@ -61,11 +61,13 @@ func (keyPairHandler *AlibabaKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, erro
 
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 
 			cblogger.Errorf("Unable to get key pairs, %v", err)
 			return keyPairList, err
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Debug(result)
 
@@ -147,11 +149,13 @@ func (keyPairHandler *AlibabaKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPai
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to create key pair: %s, %v.", keyPairReqInfo.IId.NameId, err)
 		return irs.KeyPairInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("Created key pair %q %s\n%s\n", result.KeyPairName, result.KeyPairFingerPrint, result.PrivateKeyBody)
@@ -241,12 +245,14 @@ func (keyPairHandler *AlibabaKeyPairHandler) GetKey(keyIID irs.IID) (irs.KeyPair
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		// if aerr, ok := err.(errors.Error); ok {
 		cblogger.Errorf("Unable to get key pair: %s, %v.", keyIID.SystemId, err)
 		return irs.KeyPairInfo{}, nil
 	}
+	call.Observe(callLogInfo)
 	callogger.Debug(call.String(callLogInfo))
 
 	cblogger.Debug("result : ", result)
@@ -357,11 +363,13 @@ func (keyPairHandler *AlibabaKeyPairHandler) DeleteKey(keyIID irs.IID) (bool, er
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to delete key pair: %s, %v.", keyIID.SystemId, err)
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Debug(result)
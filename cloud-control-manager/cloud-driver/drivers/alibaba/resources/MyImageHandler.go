@@ -86,6 +86,7 @@ func (myImageHandler AlibabaMyImageHandler) SnapshotVM(snapshotReqInfo irs.MyIma
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	imageIID := irs.IID{SystemId: result.ImageId}
@@ -124,6 +125,7 @@ func (myImageHandler AlibabaMyImageHandler) ListMyImage() ([]*irs.MyImageInfo, e
 		LoggingError(hiscallInfo, err)
 		return nil, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	var myImageInfoList []*irs.MyImageInfo
@@ -151,6 +153,7 @@ func (myImageHandler AlibabaMyImageHandler) GetMyImage(myImageIID irs.IID) (irs.
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	myImageInfo, err := ExtractMyImageDescribeInfo(&result)
@@ -206,6 +209,7 @@ func (myImageHandler AlibabaMyImageHandler) DeleteMyImage(myImageIID irs.IID) (b
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	// 이미지가 삭제될 때까지 대기
@@ -357,6 +361,7 @@ func (myImageHandler AlibabaMyImageHandler) CheckWindowsImage(myImageIID irs.IID
 		LoggingError(hiscallInfo, err)
 		return isWindows, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	if osType == "windows" {
@@ -131,6 +131,7 @@ func (NLBHandler *AlibabaNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBI
 	response, err := NLBHandler.Client.CreateLoadBalancer(loadBalancerRequest)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.NLBInfo{}, err
@@ -148,6 +149,7 @@ func (NLBHandler *AlibabaNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBI
 		_, delerr := NLBHandler.Client.DeleteLoadBalancer(deleteRequest)
 		if delerr != nil {
 			callLogInfo.ErrorMSG = delerr.Error()
+			call.Observe(callLogInfo)
 			callogger.Info(call.String(callLogInfo))
 
 			return irs.NLBInfo{}, errors.New(err.Error() + " recalled of resource " + delerr.Error())
@@ -174,6 +176,7 @@ func (NLBHandler *AlibabaNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBI
 		_, delLBerr := NLBHandler.Client.DeleteLoadBalancer(deleteRequest)
 		if delLBerr != nil {
 			callLogInfo.ErrorMSG = delLBerr.Error()
+			call.Observe(callLogInfo)
 			callogger.Info(call.String(callLogInfo))
 
 			return irs.NLBInfo{}, errors.New(" recalled of resource " + delLBerr.Error())
@@ -222,6 +225,7 @@ func (NLBHandler *AlibabaNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
 	//cblogger.Debug(result)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return nil, err
 	}
@@ -587,6 +591,7 @@ func (NLBHandler *AlibabaNLBHandler) startLoadBalancerListener(nlbIID irs.IID, n
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.ListenerInfo{}, err
@@ -671,6 +676,7 @@ func (NLBHandler *AlibabaNLBHandler) addLoadBalancerTcpListener(nlbIID irs.IID,
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.ListenerInfo{}, err
@@ -732,6 +738,7 @@ func (NLBHandler *AlibabaNLBHandler) addLoadBalancerUdpListener(nlbIID irs.IID,
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.ListenerInfo{}, err
@@ -809,6 +816,7 @@ func (NLBHandler *AlibabaNLBHandler) modifyLoadBalancerTcpListener(nlbIID irs.II
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.ListenerInfo{}, err
@@ -851,6 +859,7 @@ func (NLBHandler *AlibabaNLBHandler) modifyLoadBalancerUdpListener(nlbIID irs.II
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.ListenerInfo{}, err
@@ -891,6 +900,7 @@ func (NLBHandler *AlibabaNLBHandler) deleteLoadBalancerListener(nlbIID irs.IID,
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return false, err
@@ -981,6 +991,7 @@ func (NLBHandler *AlibabaNLBHandler) addVMGroupInfo(nlbIID irs.IID, nlbReqInfo i
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.VMGroupInfo{}, err
@@ -1083,6 +1094,7 @@ func (NLBHandler *AlibabaNLBHandler) addBackendServer(nlbIID irs.IID, nlbReqInfo
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.VMGroupInfo{}, err
@@ -1139,6 +1151,7 @@ func (NLBHandler *AlibabaNLBHandler) removeBackendServer(nlbIID irs.IID, nlbReqI
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return false, err
@@ -1209,6 +1222,7 @@ func (NLBHandler *AlibabaNLBHandler) modifyLoadBalancerTcpHealthChecker(nlbIID i
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.HealthCheckerInfo{}, err
@@ -1276,6 +1290,7 @@ func (NLBHandler *AlibabaNLBHandler) modifyLoadBalancerUdpHealthChecker(nlbIID i
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.HealthCheckerInfo{}, err
@@ -87,11 +87,13 @@ func (securityHandler *AlibabaSecurityHandler) CreateSecurity(securityReqInfo ir
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to create security group %q, %v", securityReqInfo.IId.NameId, err)
 		return irs.SecurityInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Infof("[%s] Security group creation complete: SecurityGroupId:[%s]", securityReqInfo.IId.NameId, createRes.SecurityGroupId)
 	//cblogger.Debug(createRes)
@@ -346,11 +348,13 @@ func (securityHandler *AlibabaSecurityHandler) ListSecurity() ([]*irs.SecurityIn
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Debug(call.String(callLogInfo))
 
 	cblogger.Debug(result)
@@ -396,11 +400,13 @@ func (securityHandler *AlibabaSecurityHandler) GetSecurity(securityIID irs.IID)
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
 		return irs.SecurityInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Debug(result)
@@ -521,11 +527,13 @@ func (securityHandler *AlibabaSecurityHandler) DeleteSecurity(securityIID irs.II
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to get descriptions for security groups, %v.", err)
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Debug(response)
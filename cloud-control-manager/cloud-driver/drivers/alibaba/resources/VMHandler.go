@@ -445,10 +445,12 @@ func (vmHandler *AlibabaVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo,
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		cblogger.Error(err.Error())
 		return irs.VMInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	//cblogger.Debug(response)
 
@@ -632,10 +634,12 @@ func (vmHandler *AlibabaVMHandler) ResumeVM(vmIID irs.IID) (irs.VMStatus, error)
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		cblogger.Error(err.Error())
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Debug(call.String(callLogInfo))
 
 	cblogger.Debug(response)
@@ -670,10 +674,12 @@ func (vmHandler *AlibabaVMHandler) SuspendVM(vmIID irs.IID) (irs.VMStatus, error
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		cblogger.Error(err.Error())
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Debug(call.String(callLogInfo))
 	cblogger.Debug(response)
 	return irs.VMStatus("Suspending"), nil
@@ -704,10 +710,12 @@ func (vmHandler *AlibabaVMHandler) RebootVM(vmIID irs.IID) (irs.VMStatus, error)
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		cblogger.Error(err.Error())
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Debug(call.String(callLogInfo))
 	cblogger.Debug(response)
 	return irs.VMStatus("Rebooting"), nil
@@ -778,16 +786,19 @@ func (vmHandler *AlibabaVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, err
 			if strings.Contains(err.Error(), "IncorrectInstanceStatus") {
 				// Loop: IncorrectInstanceStatus error
 				callLogInfo.ErrorMSG = err.Error()
+				call.Observe(callLogInfo)
 				callogger.Error(call.String(callLogInfo))
 				cblogger.Error(err.Error())
 				time.Sleep(time.Second * 3)
 			} else { // general error
 				callLogInfo.ErrorMSG = err.Error()
+				call.Observe(callLogInfo)
 				callogger.Error(call.String(callLogInfo))
 				cblogger.Error(err.Error())
 				return irs.VMStatus("Failed"), err
 			}
 		} else {
+			call.Observe(callLogInfo)
 			callogger.Debug(call.String(callLogInfo))
 			cblogger.Debug(response)
 			break
@@ -1050,11 +1061,13 @@ func (vmHandler *AlibabaVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, err
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err.Error())
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Debug(call.String(callLogInfo))
 
 	cblogger.Debug("Success", response)
@@ -1150,11 +1163,13 @@ func (vmHandler *AlibabaVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err.Error())
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("Success", response)
@@ -105,11 +105,13 @@ func (vmSpecHandler *AlibabaVmSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, erro
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to get ListVMSpec - %v", err)
 		return vMSpecInfoList, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	//cblogger.Debug(resp)
@@ -151,11 +153,13 @@ func (vmSpecHandler *AlibabaVmSpecHandler) GetVMSpec(Name string) (irs.VMSpecInf
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to get GetVMSpec - %v", err)
 		return irs.VMSpecInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("Number of Retrieved Instance Types : ", len(resp.InstanceTypes.InstanceType))
@@ -209,11 +213,13 @@ func (vmSpecHandler *AlibabaVmSpecHandler) ListOrgVMSpec() (string, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to get ListOrgVMSpec - %v", err)
 		return "", err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	//jsonString, errJson := ConvertJsonString(resp.InstanceTypes.InstanceType)
@@ -250,11 +256,13 @@ func (vmSpecHandler *AlibabaVmSpecHandler) GetOrgVMSpec(Name string) (string, er
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Errorf("Unable to get GetVMSpec - %v", err)
 		return "", err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("Number of Retrieved Instance Types : ", len(resp.InstanceTypes.InstanceType))
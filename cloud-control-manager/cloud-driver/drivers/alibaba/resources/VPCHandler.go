@@ -80,10 +80,12 @@ func (VPCHandler *AlibabaVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.V
 	//cblogger.Debug(response)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.VPCInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	//VPC를 생성하면 Pending 상태라서 Subnet을 추가할 수 없기 때문에 Available로 바뀔 때까지 대기함.
@@ -191,10 +193,12 @@ func (VPCHandler *AlibabaVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo ir
 	cblogger.Info(response)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		cblogger.Error(err.Error())
 		return irs.SubnetInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	//cblogger.Debug(response)
 
@@ -232,9 +236,11 @@ func (VPCHandler *AlibabaVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
 	//cblogger.Debug(result)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var vpcInfoList []*irs.VPCInfo
@@ -348,9 +354,11 @@ func (VPCHandler *AlibabaVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error)
 	cblogger.Debug(result)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VPCInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("VPC Count : ", len(result.Vpcs.Vpc))
@@ -448,11 +456,13 @@ func (VPCHandler *AlibabaVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
 	cblogger.Info(response)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Infof("[%s] VPC Delete fail", vpcIID.SystemId)
 		cblogger.Error(err.Error())
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	return true, nil
 }
@@ -481,11 +491,13 @@ func (VPCHandler *AlibabaVPCHandler) DeleteSubnet(subnetIID irs.IID) (bool, erro
 	cblogger.Info(response)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Infof("[%s] VSwitch Delete fail", subnetIID.SystemId)
 		cblogger.Error(err.Error())
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	return true, nil
 }
@@ -516,10 +528,12 @@ func (VPCHandler *AlibabaVPCHandler) GetSubnet(reqSubnetId string) (irs.SubnetIn
 	//cblogger.Info(result)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.SubnetInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if result.TotalCount < 1 {
@@ -585,10 +599,12 @@ func (VPCHandler *AlibabaVPCHandler) AddSubnet(vpcIID irs.IID, subnetInfo irs.Su
 
 	if errSubnet != nil {
 		callLogInfo.ErrorMSG = errSubnet.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(errSubnet)
 		return irs.VPCInfo{}, errSubnet
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Info(resSubnet)
 
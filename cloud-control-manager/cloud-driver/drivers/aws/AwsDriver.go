@@ -62,6 +62,9 @@ func (AwsDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.PriceInfoHandler = true
 	drvCapabilityInfo.TagHandler = true
 
+	drvCapabilityInfo.SG_PEER_SECURITY_GROUP = true
+	drvCapabilityInfo.IPV6_DUAL_STACK = true
+
 	return drvCapabilityInfo
 }
 
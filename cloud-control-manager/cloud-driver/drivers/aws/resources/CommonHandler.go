@@ -45,6 +45,7 @@ func DescribeInstances(svc *ec2.EC2, vmIIDs []irs.IID) (*ec2.DescribeInstancesOu
 	result, err := svc.DescribeInstances(input)
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return result, err
@@ -226,6 +227,7 @@ func DescribeVolumnes(svc *ec2.EC2, volumeIdList []*string) (*ec2.DescribeVolume
 	result, err := svc.DescribeVolumes(input)
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if err != nil {
@@ -313,6 +315,7 @@ func DescribeVolumnesBySnapshot(svc *ec2.EC2, snapShotIIDs []string) (*ec2.Descr
 	cblogger.Debug(input)
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if err != nil {
@@ -351,6 +354,7 @@ func AttachVolume(svc *ec2.EC2, deviceName string, instanceId string, volumeId s
 	result, err := svc.AttachVolume(input)
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if err != nil {
@@ -483,6 +487,7 @@ func DescribeImages(svc *ec2.EC2, imageIIDs []*irs.IID, owners []*string) (*ec2.
 		}
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return result, err
@@ -724,6 +729,7 @@ func DescribeRegions(client *ec2.EC2, AllRegionsBool bool, regionName string) (*
 	callLogStart := call.Start()
 	resp, err := client.DescribeRegions(RegionsInput)
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	callogger.Info("########################")
 	callogger.Info(resp.Regions)
@@ -755,6 +761,7 @@ func DescribeAvailabilityZones(client *ec2.EC2, AllRegionsBool bool) (*ec2.Descr
 	callLogStart := call.Start()
 	respZones, err := client.DescribeAvailabilityZones(nil) //ZonesInput
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	callogger.Info(respZones.AvailabilityZones)
 
@@ -130,6 +130,7 @@ func (DiskHandler *AwsDiskHandler) CreateDisk(diskReqInfo irs.DiskInfo) (irs.Dis
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	newVolume := irs.IID{}
@@ -165,6 +166,7 @@ func (DiskHandler *AwsDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
 		LoggingError(hiscallInfo, err)
 		return nil, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	var returnDiskInfoList []*irs.DiskInfo
@@ -195,6 +197,7 @@ func (DiskHandler *AwsDiskHandler) GetDisk(diskIID irs.IID) (irs.DiskInfo, error
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	diskInfo, _ := DiskHandler.convertVolumeInfoToDiskInfo(result.Volumes[0])
@@ -253,6 +256,7 @@ func (DiskHandler *AwsDiskHandler) ChangeDiskSize(diskIID irs.IID, size string)
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Debug("originalSize : " + strconv.Itoa(int(*result.VolumeModification.OriginalSize)))
@@ -289,6 +293,7 @@ func (DiskHandler *AwsDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Debug(result)
@@ -410,6 +415,7 @@ func (DiskHandler *AwsDiskHandler) AttachDisk(diskIID irs.IID, ownerVM irs.IID)
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Debug(result)
@@ -462,6 +468,7 @@ func (DiskHandler *AwsDiskHandler) DetachDisk(diskIID irs.IID, ownerVM irs.IID)
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Debug(result)
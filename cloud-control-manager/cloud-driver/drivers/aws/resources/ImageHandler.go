@@ -48,9 +48,10 @@ func (imageHandler *AwsImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo)
 	imageReqInfo.IId.SystemId = imageReqInfo.IId.NameId
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
-	return irs.ImageInfo{imageReqInfo.IId, "", "", nil, nil}, nil
+	return irs.ImageInfo{IId: imageReqInfo.IId}, nil
 }
 
 // @TODO : 목록이 너무 많기 때문에 amazon 계정으로 공유된 퍼블릭 이미지중 AMI만 조회 함.
@@ -100,6 +101,7 @@ func (imageHandler *AwsImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -114,6 +116,7 @@ func (imageHandler *AwsImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 		}
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cnt := 0
@@ -206,6 +209,26 @@ func ExtractImageDescribeInfo(image *ec2.Image) irs.ImageInfo {
 
 	imageInfo.KeyValueList = keyValueList
 
+	// 이미지 검색을 위한 정규화 정보
+	if !reflect.ValueOf(image.Architecture).IsNil() {
+		imageInfo.OSArchitecture = *image.Architecture // i386, x86_64, arm64
+	}
+	if strings.HasPrefix(imageInfo.GuestOS, "Windows") || strings.EqualFold(imageInfo.GuestOS, "windows") {
+		imageInfo.OSPlatform = "Windows"
+		imageInfo.OSDistribution = "windows"
+	} else if imageInfo.GuestOS != "" {
+		imageInfo.OSPlatform = "Linux/UNIX"
+	}
+	if !reflect.ValueOf(image.RootDeviceName).IsNil() {
+		for _, blockDevice := range image.BlockDeviceMappings {
+			if blockDevice.DeviceName != nil && *blockDevice.DeviceName == *image.RootDeviceName &&
+				blockDevice.Ebs != nil && blockDevice.Ebs.VolumeSize != nil {
+				imageInfo.RootDiskMinSizeGB = strconv.FormatInt(*blockDevice.Ebs.VolumeSize, 10)
+				break
+			}
+		}
+	}
+
 	return imageInfo
 }
 
@@ -239,6 +262,7 @@ func (imageHandler *AwsImageHandler) GetAmiImage(imageIID irs.IID) (*ec2.Image,
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -251,6 +275,7 @@ func (imageHandler *AwsImageHandler) GetAmiImage(imageIID irs.IID) (*ec2.Image,
 		}
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if len(result.Images) > 0 {
@@ -306,6 +331,7 @@ func (imageHandler *AwsImageHandler) DeleteImage(imageIID irs.IID) (bool, error)
 	callLogStart := call.Start()
 
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return false, nil
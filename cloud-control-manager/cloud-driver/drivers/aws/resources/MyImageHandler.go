@@ -341,6 +341,7 @@ func (ImageHandler *AwsMyImageHandler) SnapshotVM(snapshotReqInfo irs.MyImageInf
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	createdImageId := result.ImageId
@@ -372,6 +373,7 @@ func (ImageHandler *AwsMyImageHandler) ListMyImage() ([]*irs.MyImageInfo, error)
 		LoggingError(hiscallInfo, err)
 		return nil, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Debug(result)
@@ -399,6 +401,7 @@ func (ImageHandler *AwsMyImageHandler) GetMyImage(myImageIID irs.IID) (irs.MyIma
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	returnMyImage, err := convertAWSImageToMyImageInfo(resultImage)
@@ -445,6 +448,7 @@ func (ImageHandler *AwsMyImageHandler) DeleteMyImage(myImageIID irs.IID) (bool,
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Debug(result)
@@ -609,6 +613,7 @@ func (ImageHandler *AwsMyImageHandler) CheckWindowsImage(myImageIID irs.IID) (bo
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	// image에서 OsType 추출
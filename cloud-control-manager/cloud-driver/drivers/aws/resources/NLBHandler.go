@@ -507,6 +507,10 @@ func (NLBHandler *AwsNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBInfo,
 
 	if nlbReqInfo.Listener.IP == "" {
 		input.Subnets = vmSubnets
+		// dual-stack 서브넷이면 IPv6 주소도 할당 함.
+		if hasSubnetsIPv6CIDR(NLBHandler.VMClient, vmSubnets) {
+			input.IpAddressType = aws.String(elbv2.IpAddressTypeDualstack)
+		}
 	} else {
 		// NLB 단독으로 대표 IP를 지정할 수 없으며 VPC의 AZ별 1개의 서브넷마다 EIP를 지정해야 하는데
 		// 현재의 CB는 AZ별 Subnet 정보를 전달 받지 않기 때문에 고정 IP를 할당할 수 없음.
@@ -538,6 +542,7 @@ func (NLBHandler *AwsNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBInfo,
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -579,6 +584,7 @@ func (NLBHandler *AwsNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBInfo,
 
 		return irs.NLBInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("[%s] NLB creation completed - LoadBalancerArn: [%s]", nlbReqInfo.IId.NameId, *result.LoadBalancers[0].LoadBalancerArn)
@@ -696,6 +702,7 @@ func (NLBHandler *AwsNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -712,6 +719,7 @@ func (NLBHandler *AwsNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
 		}
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var results []*irs.NLBInfo
@@ -790,6 +798,7 @@ func (NLBHandler *AwsNLBHandler) GetNLB(nlbIID irs.IID) (irs.NLBInfo, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -806,6 +815,7 @@ func (NLBHandler *AwsNLBHandler) GetNLB(nlbIID irs.IID) (irs.NLBInfo, error) {
 		}
 		return irs.NLBInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if len(result.LoadBalancers) > 0 {
@@ -1042,17 +1052,33 @@ func (NLBHandler *AwsNLBHandler) ExtractNLBInfo(nlbResInfo *elbv2.LoadBalancer)
 	//=================
 	// NLB의 AZ별 고정IP 주소가 할당된 경우 추출해서 리스너의 IP에 세팅 함.
 	eips := ""
+	ipv6s := ""
 	for _, curSubnet := range nlbResInfo.AvailabilityZones {
 		cblogger.Debug(curSubnet)
-		if len(curSubnet.LoadBalancerAddresses) > 0 {
-			if eips == "" {
-				eips = *curSubnet.LoadBalancerAddresses[0].IpAddress
-			} else {
-				eips = eips + "," + *curSubnet.LoadBalancerAddresses[0].IpAddress
+		for _, address := range curSubnet.LoadBalancerAddresses {
+			if address.IpAddress != nil {
+				if eips == "" {
+					eips = *address.IpAddress
+				} else {
+					eips = eips + "," + *address.IpAddress
+				}
+				break
+			}
+		}
+		// dual-stack NLB의 AZ별 IPv6 주소
+		for _, address := range curSubnet.LoadBalancerAddresses {
+			if address.IPv6Address != nil {
+				if ipv6s == "" {
+					ipv6s = *address.IPv6Address
+				} else {
+					ipv6s = ipv6s + "," + *address.IPv6Address
+				}
+				break
 			}
 		}
 	}
 	retNLBInfo.Listener.IP = eips
+	retNLBInfo.Listener.IPv6 = ipv6s
 
 	return retNLBInfo, nil
 }
@@ -1185,6 +1211,7 @@ func (NLBHandler *AwsNLBHandler) DeleteNLB(nlbIID irs.IID) (bool, error) {
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		cblogger.Errorf("Failed to delete NLB [%s]", nlbIID.SystemId)
@@ -1206,6 +1233,7 @@ func (NLBHandler *AwsNLBHandler) DeleteNLB(nlbIID irs.IID) (bool, error) {
 		}
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("NLB [%s] deleted successfully", nlbIID.SystemId)
@@ -1282,6 +1310,7 @@ func (NLBHandler *AwsNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Lis
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -1327,6 +1356,7 @@ func (NLBHandler *AwsNLBHandler) ChangeListener(nlbIID irs.IID, listener irs.Lis
 			// Message from an error.
 			cblogger.Error(err.Error())
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return irs.ListenerInfo{}, err
@@ -1370,6 +1400,7 @@ func (NLBHandler *AwsNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup irs.V
 	}
 	callLogStart := call.Start()
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return irs.VMGroupInfo{}, awserr.New(CUSTOM_ERR_CODE_METHOD_NOT_ALLOWED, "Changing VMGroup information is not supported.", nil)
@@ -1441,6 +1472,7 @@ func (NLBHandler *AwsNLBHandler) AddVMs(nlbIID irs.IID, vmIIDs *[]irs.IID) (irs.
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -1462,6 +1494,7 @@ func (NLBHandler *AwsNLBHandler) AddVMs(nlbIID irs.IID, vmIIDs *[]irs.IID) (irs.
 		}
 		return irs.VMGroupInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("Instances added to VM group (%s) successfully", retTargetGroupInfo.VMGroup.CspID)
@@ -1532,6 +1565,7 @@ func (NLBHandler *AwsNLBHandler) RemoveVMs(nlbIID irs.IID, vmIIDs *[]irs.IID) (b
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -1548,6 +1582,7 @@ func (NLBHandler *AwsNLBHandler) RemoveVMs(nlbIID irs.IID, vmIIDs *[]irs.IID) (b
 		}
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("Instances successfully removed from VM group (%s)", retTargetGroupInfo.VMGroup.CspID)
@@ -1648,9 +1683,11 @@ func (NLBHandler *AwsNLBHandler) GetVMGroupHealthInfo(nlbIID irs.IID) (irs.Healt
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.HealthInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return result, nil
@@ -1739,6 +1776,7 @@ func (NLBHandler *AwsNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthC
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -1756,6 +1794,7 @@ func (NLBHandler *AwsNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, healthC
 		}
 		return irs.HealthCheckerInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("Health information modification completed")
@@ -89,6 +89,7 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		if aerr, ok := err.(awserr.Error); ok {
 			switch aerr.Code() {
@@ -103,6 +104,7 @@ func (securityHandler *AwsSecurityHandler) CreateSecurity(securityReqInfo irs.Se
 		cblogger.Errorf("Unable to create security group %q, %v", securityReqInfo.IId.NameId, err)
 		return irs.SecurityInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Infof("[%s] Security group creation completed", aws.StringValue(createRes.GroupId))
 	cblogger.Debug(createRes)
@@ -324,6 +326,7 @@ func (securityHandler *AwsSecurityHandler) ListSecurity() ([]*irs.SecurityInfo,
 	cblogger.Info("result : ", result)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		cblogger.Info("err : ", err)
@@ -339,6 +342,7 @@ func (securityHandler *AwsSecurityHandler) ListSecurity() ([]*irs.SecurityInfo,
 		}
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var results []*irs.SecurityInfo
@@ -393,6 +397,7 @@ func (securityHandler *AwsSecurityHandler) GetSecurity(securityIID irs.IID) (irs
 	cblogger.Debug("err : ", err)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -407,6 +412,7 @@ func (securityHandler *AwsSecurityHandler) GetSecurity(securityIID irs.IID) (irs
 		}
 		return irs.SecurityInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if len(result.SecurityGroups) > 0 {
@@ -483,6 +489,15 @@ func ExtractIpPermissionCommon(ip *ec2.IpPermission, securityRuleInfo *irs.Secur
 		securityRuleInfo.FromPort = "-1"
 		securityRuleInfo.ToPort = "-1"
 	}
+
+	// ICMP: FromPort is the type, ToPort is the code, the ports are kept as they are
+	if strings.EqualFold(securityRuleInfo.IPProtocol, "icmp") &&
+		securityRuleInfo.FromPort != "-1" && securityRuleInfo.FromPort != "" {
+		securityRuleInfo.ICMPType = securityRuleInfo.FromPort
+		if securityRuleInfo.ToPort != "-1" {
+			securityRuleInfo.ICMPCode = securityRuleInfo.ToPort
+		}
+	}
 }
 
 func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) []irs.SecurityRuleInfo {
@@ -494,8 +509,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		for _, ipv4 := range ip.IpRanges {
 			cblogger.Debug("Inbound/Outbound information retrieval: ", *ip.IpProtocol)
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				CIDR:      *ipv4.CidrIp,
+				Direction:   direction, // "inbound | outbound"
+				CIDR:        *ipv4.CidrIp,
+				Description: aws.StringValue(ipv4.Description),
 			}
 			cblogger.Debug(*ipv4.CidrIp)
 
@@ -506,8 +522,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		//ipv6 처리
 		for _, ipv6 := range ip.Ipv6Ranges {
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				CIDR:      *ipv6.CidrIpv6,
+				Direction:   direction, // "inbound | outbound"
+				CIDR:        *ipv6.CidrIpv6,
+				Description: aws.StringValue(ipv6.Description),
 			}
 			cblogger.Debug(*ipv6.CidrIpv6)
 
@@ -518,8 +535,9 @@ func ExtractIpPermissions(ipPermissions []*ec2.IpPermission, direction string) [
 		//ELB나 보안그룹 참조 방식 처리
 		for _, userIdGroup := range ip.UserIdGroupPairs {
 			securityRuleInfo := irs.SecurityRuleInfo{
-				Direction: direction, // "inbound | outbound"
-				CIDR:      *userIdGroup.GroupId,
+				Direction:            direction, // "inbound | outbound"
+				PeerSecurityGroupIID: &irs.IID{SystemId: *userIdGroup.GroupId},
+				Description:          aws.StringValue(userIdGroup.Description),
 			}
 			cblogger.Debug(*userIdGroup.UserId)
 
@@ -585,6 +603,7 @@ func (securityHandler *AwsSecurityHandler) DeleteSecurity(securityIID irs.IID) (
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		if aerr, ok := err.(awserr.Error); ok {
@@ -599,6 +618,7 @@ func (securityHandler *AwsSecurityHandler) DeleteSecurity(securityIID irs.IID) (
 		cblogger.Errorf("Unable to get descriptions for security groups, %v.", err)
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("Successfully delete security group %q.", securityID)
@@ -689,11 +709,9 @@ func (securityHandler *AwsSecurityHandler) ProcessAddRules(newGroupId *string, s
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionICMP(ipPermission, ip)
+		setIpPermissionSource(ipPermission, ip)
+		setIpPermissionDescription(ipPermission, ip.Description)
 		// cblogger.Debug("===>변환완료")
 		// cblogger.Debug(ipPermission)
 
@@ -756,11 +774,9 @@ func (securityHandler *AwsSecurityHandler) ProcessAddRules(newGroupId *string, s
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionICMP(ipPermission, ip)
+		setIpPermissionSource(ipPermission, ip)
+		setIpPermissionDescription(ipPermission, ip.Description)
 		//ipPermissions = append(ipPermissions, ipPermission)
 		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 	}
@@ -848,11 +864,8 @@ func (securityHandler *AwsSecurityHandler) ProcessRemoveRules(newGroupId *string
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionICMP(ipPermission, ip)
+		setIpPermissionSource(ipPermission, ip)
 		// cblogger.Debug("===>변환완료")
 		// cblogger.Debug(ipPermission)
 
@@ -915,11 +928,8 @@ func (securityHandler *AwsSecurityHandler) ProcessRemoveRules(newGroupId *string
 			//ipPermission.SetToPort(0)
 		}
 
-		ipPermission.SetIpRanges([]*ec2.IpRange{
-			(&ec2.IpRange{}).
-				SetCidrIp(ip.CIDR),
-			//SetCidrIp("0.0.0.0/0"),
-		})
+		setIpPermissionICMP(ipPermission, ip)
+		setIpPermissionSource(ipPermission, ip)
 		//ipPermissions = append(ipPermissions, ipPermission)
 		ipPermissionsEgress = append(ipPermissionsEgress, ipPermission)
 	}
@@ -944,3 +954,61 @@ func (securityHandler *AwsSecurityHandler) ProcessRemoveRules(newGroupId *string
 
 	return securityHandler.GetSecurity(irs.IID{SystemId: *newGroupId})
 }
+
+// set the CIDR or the peer security group(UserIdGroupPairs) of an IpPermission
+func setIpPermissionSource(ipPermission *ec2.IpPermission, ruleInfo irs.SecurityRuleInfo) {
+	if ruleInfo.PeerSecurityGroupIID != nil {
+		ipPermission.SetUserIdGroupPairs([]*ec2.UserIdGroupPair{
+			(&ec2.UserIdGroupPair{}).
+				SetGroupId(ruleInfo.PeerSecurityGroupIID.SystemId),
+		})
+		return
+	}
+
+	if strings.Contains(ruleInfo.CIDR, ":") { // IPv6 CIDR
+		ipPermission.SetIpv6Ranges([]*ec2.Ipv6Range{
+			(&ec2.Ipv6Range{}).
+				SetCidrIpv6(ruleInfo.CIDR),
+		})
+		return
+	}
+
+	ipPermission.SetIpRanges([]*ec2.IpRange{
+		(&ec2.IpRange{}).
+			SetCidrIp(ruleInfo.CIDR),
+	})
+}
+
+// set the ICMP type and code of an IpPermission, AWS uses FromPort as the type and ToPort as the code.
+func setIpPermissionICMP(ipPermission *ec2.IpPermission, ruleInfo irs.SecurityRuleInfo) {
+	if !strings.EqualFold(ruleInfo.IPProtocol, "icmp") || ruleInfo.ICMPType == "" {
+		return
+	}
+
+	if n, err := strconv.ParseInt(ruleInfo.ICMPType, 10, 64); err == nil {
+		ipPermission.SetFromPort(n)
+	}
+	ipPermission.SetToPort(-1)
+	if ruleInfo.ICMPCode != "" {
+		if n, err := strconv.ParseInt(ruleInfo.ICMPCode, 10, 64); err == nil {
+			ipPermission.SetToPort(n)
+		}
+	}
+}
+
+// set the description of the CIDR or the peer security group of an IpPermission
+func setIpPermissionDescription(ipPermission *ec2.IpPermission, description string) {
+	if description == "" {
+		return
+	}
+
+	for _, ipRange := range ipPermission.IpRanges {
+		ipRange.SetDescription(description)
+	}
+	for _, ipv6Range := range ipPermission.Ipv6Ranges {
+		ipv6Range.SetDescription(description)
+	}
+	for _, userIdGroupPair := range ipPermission.UserIdGroupPairs {
+		userIdGroupPair.SetDescription(description)
+	}
+}
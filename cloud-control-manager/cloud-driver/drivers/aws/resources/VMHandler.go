@@ -378,6 +378,11 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 		TagSpecifications: tagSpecifications,
 	}
 
+	// dual-stack 서브넷이면 IPv6 주소를 1개 할당 함.
+	if vmHandler.hasSubnetIPv6CIDR(subnetID) {
+		input.NetworkInterfaces[0].Ipv6AddressCount = aws.Int64(1)
+	}
+
 	//=============================
 	// SystemDisk 처리 - 이슈 #348에 의해 RootDisk 기능 지원
 	//=============================
@@ -462,10 +467,12 @@ func (vmHandler *AwsVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	cblogger.Debug(runResult)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Errorf("EC2 instance creation failed: ", err)
 		return irs.VMInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if len(runResult.Instances) < 1 {
@@ -605,6 +612,92 @@ func WaitForRun(svc *ec2.EC2, instanceID string) {
 	cblogger.Info("=========WaitForRun() ended")
 }
 
+// check whether the subnet has an IPv6 CIDR block
+func (vmHandler *AwsVMHandler) hasSubnetIPv6CIDR(subnetID string) bool {
+	return hasSubnetsIPv6CIDR(vmHandler.Client, []*string{aws.String(subnetID)})
+}
+
+// check whether all the subnets have an IPv6 CIDR block
+func hasSubnetsIPv6CIDR(client *ec2.EC2, subnetIDs []*string) bool {
+	if len(subnetIDs) == 0 {
+		return false
+	}
+	result, err := client.DescribeSubnets(&ec2.DescribeSubnetsInput{
+		SubnetIds: subnetIDs,
+	})
+	if err != nil || len(result.Subnets) != len(subnetIDs) {
+		return false
+	}
+	for _, subnet := range result.Subnets {
+		hasIPv6 := false
+		for _, ipv6Assoc := range subnet.Ipv6CidrBlockAssociationSet {
+			if ipv6Assoc.Ipv6CidrBlock != nil && *ipv6Assoc.Ipv6CidrBlock != "" {
+				hasIPv6 = true
+				break
+			}
+		}
+		if !hasIPv6 {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateVM validates the request with RunInstances(DryRun) without creating the VM.
+// The image, instance type, subnet, security groups, key pair, root disk and the permission are checked by AWS.
+func (vmHandler *AwsVMHandler) ValidateVM(vmReqInfo irs.VMReqInfo) error {
+	cblogger.Infof("Dry-run VM : [%s]", vmReqInfo.IId.NameId)
+
+	var securityGroupIds []string
+	for _, sgIID := range vmReqInfo.SecurityGroupIIDs {
+		securityGroupIds = append(securityGroupIds, sgIID.SystemId)
+	}
+
+	input := &ec2.RunInstancesInput{
+		DryRun:       aws.Bool(true),
+		ImageId:      aws.String(vmReqInfo.ImageIID.SystemId),
+		InstanceType: aws.String(vmReqInfo.VMSpecName),
+		MinCount:     aws.Int64(1),
+		MaxCount:     aws.Int64(1),
+		NetworkInterfaces: []*ec2.InstanceNetworkInterfaceSpecification{
+			{AssociatePublicIpAddress: aws.Bool(true),
+				DeviceIndex: aws.Int64(0),
+				Groups:      aws.StringSlice(securityGroupIds),
+				SubnetId:    aws.String(vmReqInfo.SubnetIID.SystemId),
+			},
+		},
+	}
+	if vmReqInfo.KeyPairIID.SystemId != "" {
+		input.KeyName = aws.String(vmReqInfo.KeyPairIID.SystemId)
+	}
+
+	if vmReqInfo.RootDiskType != "" || vmReqInfo.RootDiskSize != "" {
+		ebs := &ec2.EbsBlockDevice{}
+		if vmReqInfo.RootDiskType != "" && !strings.EqualFold(vmReqInfo.RootDiskType, "default") {
+			ebs.VolumeType = aws.String(vmReqInfo.RootDiskType)
+		}
+		if vmReqInfo.RootDiskSize != "" && !strings.EqualFold(vmReqInfo.RootDiskSize, "default") {
+			iDiskSize, err := strconv.ParseInt(vmReqInfo.RootDiskSize, 10, 64)
+			if err != nil {
+				return err
+			}
+			ebs.VolumeSize = aws.Int64(iDiskSize)
+		}
+		input.SetBlockDeviceMappings([]*ec2.BlockDeviceMapping{{DeviceName: aws.String("/dev/sda1"), Ebs: ebs}})
+	}
+
+	_, err := vmHandler.Client.RunInstances(input)
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == "DryRunOperation" {
+		// the request would have succeeded
+		return nil
+	}
+	if err != nil {
+		cblogger.Error(err)
+		return err
+	}
+	return nil
+}
+
 // func (vmHandler *AwsVMHandler) ResumeVM(vmNameId string) (irs.VMStatus, error) {
 func (vmHandler *AwsVMHandler) ResumeVM(vmIID irs.IID) (irs.VMStatus, error) {
 	cblogger.Infof("vmNameId : [%s]", vmIID.SystemId)
@@ -657,6 +750,7 @@ func (vmHandler *AwsVMHandler) ResumeVM(vmIID irs.IID) (irs.VMStatus, error) {
 		if err != nil {
 			cblogger.Error(err)
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Info(call.String(callLogInfo))
 			return irs.VMStatus("Failed"), err
 		} else {
@@ -665,9 +759,11 @@ func (vmHandler *AwsVMHandler) ResumeVM(vmIID irs.IID) (irs.VMStatus, error) {
 	} else { // This could be due to a lack of permissions
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return irs.VMStatus("Resuming"), nil
@@ -718,6 +814,7 @@ func (vmHandler *AwsVMHandler) SuspendVM(vmIID irs.IID) (irs.VMStatus, error) {
 		}
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Info(call.String(callLogInfo))
 			cblogger.Error(err)
 			return irs.VMStatus("Failed"), err
@@ -726,10 +823,12 @@ func (vmHandler *AwsVMHandler) SuspendVM(vmIID irs.IID) (irs.VMStatus, error) {
 		}
 	} else {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error("Error", err)
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return irs.VMStatus("Suspending"), nil
@@ -790,6 +889,7 @@ func (vmHandler *AwsVMHandler) RebootVM(vmIID irs.IID) (irs.VMStatus, error) {
 		cblogger.Info("err value : ", err)
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Info(call.String(callLogInfo))
 			cblogger.Error("Error", err)
 			return irs.VMStatus("Failed"), err
@@ -800,9 +900,11 @@ func (vmHandler *AwsVMHandler) RebootVM(vmIID irs.IID) (irs.VMStatus, error) {
 		cblogger.Info("no permission to reboot.")
 		cblogger.Error("Error", err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	return irs.VMStatus("Rebooting"), nil
 }
@@ -841,12 +943,14 @@ func (vmHandler *AwsVMHandler) TerminateVM(vmIID irs.IID) (irs.VMStatus, error)
 	}
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error("Could not termiate instances", err)
 		return irs.VMStatus("Failed"), err
 	} else {
 		cblogger.Info("Success")
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	return irs.VMStatus("Terminating"), nil
 }
@@ -884,10 +988,12 @@ func (vmHandler *AwsVMHandler) GetPasswordData(vmIID irs.IID) (string, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return "", err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return *result.PasswordData, nil
@@ -1125,6 +1231,15 @@ func (vmHandler *AwsVMHandler) ExtractDescribeInstanceToVmInfo(instance *ec2.Ins
 			vmInfo.NetworkInterface = *instance.NetworkInterfaces[0].Attachment.AttachmentId
 		}
 
+		// AWS IPv6 addresses are globally unique, so the address of the interface is the private and the public IPv6.
+		for _, ipv6 := range instance.NetworkInterfaces[0].Ipv6Addresses {
+			if ipv6.Ipv6Address != nil {
+				vmInfo.PrivateIPv6 = *ipv6.Ipv6Address
+				vmInfo.PublicIPv6 = *ipv6.Ipv6Address
+				break
+			}
+		}
+
 		for _, security := range instance.NetworkInterfaces[0].Groups {
 			//vmInfo.SecurityGroupIds = append(vmInfo.SecurityGroupIds, *security.GroupId)
 			vmInfo.SecurityGroupIIds = append(vmInfo.SecurityGroupIIds, irs.IID{*security.GroupName, *security.GroupId})
@@ -1282,6 +1397,15 @@ func (vmHandler *AwsVMHandler) ExtractDescribeInstances(reservation *ec2.Reserva
 			vmInfo.NetworkInterface = *reservation.Instances[0].NetworkInterfaces[0].Attachment.AttachmentId
 		}
 
+		// AWS IPv6 addresses are globally unique, so the address of the interface is the private and the public IPv6.
+		for _, ipv6 := range reservation.Instances[0].NetworkInterfaces[0].Ipv6Addresses {
+			if ipv6.Ipv6Address != nil {
+				vmInfo.PrivateIPv6 = *ipv6.Ipv6Address
+				vmInfo.PublicIPv6 = *ipv6.Ipv6Address
+				break
+			}
+		}
+
 		for _, security := range reservation.Instances[0].NetworkInterfaces[0].Groups {
 			//vmInfo.SecurityGroupIds = append(vmInfo.SecurityGroupIds, *security.GroupId)
 			vmInfo.SecurityGroupIIds = append(vmInfo.SecurityGroupIIds, irs.IID{*security.GroupName, *security.GroupId})
@@ -1442,9 +1566,11 @@ func (vmHandler *AwsVMHandler) ListVM() ([]*irs.VMInfo, error) {
 			cblogger.Error(err.Error())
 		}
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("Success")
@@ -1551,9 +1677,11 @@ func (vmHandler *AwsVMHandler) GetVMStatus(vmIID irs.IID) (irs.VMStatus, error)
 			cblogger.Error(err.Error())
 		}
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("Success", result)
@@ -1605,9 +1733,11 @@ func (vmHandler *AwsVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 			cblogger.Error(err.Error())
 		}
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("Success")
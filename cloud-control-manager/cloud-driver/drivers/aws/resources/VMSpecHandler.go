@@ -150,10 +150,12 @@ func (vmSpecHandler *AwsVmSpecHandler) ListVMSpecAZ(ZoneName string) (map[string
 
 	if err != nil { // resp is now filled
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("===> Total Check AZ Spec Count : [%d]", totCnt)
@@ -253,10 +255,12 @@ func (vmSpecHandler *AwsVmSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, error) {
 
 	if err != nil { // resp is now filled
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return vMSpecInfoList, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	//cblogger.Debug(vMSpecInfoList)
 
@@ -301,9 +305,11 @@ func (vmSpecHandler *AwsVmSpecHandler) GetVMSpec(Name string) (irs.VMSpecInfo, e
 	if err != nil { // resp is now filled
 		cblogger.Errorf("Unable to get GetVMSpec - %v", err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VMSpecInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	//cblogger.Info(resp)
@@ -410,10 +416,12 @@ func (vmSpecHandler *AwsVmSpecHandler) ListOrgVMSpec() (string, error) {
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil { // resp is now filled
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return "", err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	//cblogger.Debug(vMSpecInfoList)
 
@@ -483,9 +491,11 @@ func (vmSpecHandler *AwsVmSpecHandler) GetOrgVMSpec(Name string) (string, error)
 	if err != nil { // resp is now filled
 		cblogger.Errorf("Unable to get GetVMSpec - %v", err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return "", err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	//cblogger.Info(resp)
@@ -15,11 +15,14 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	call "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/call-log"
+	cdcom "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/common"
 	idrv "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces"
 	irs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
 )
@@ -52,6 +55,16 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 		TagSpecifications: tagSpecifications,
 	}
 
+	// AWS supports only the Amazon-provided IPv6 CIDR block(/56) without BYOIP pools.
+	if vpcReqInfo.IPv6_CIDR != "" {
+		if !strings.EqualFold(vpcReqInfo.IPv6_CIDR, "auto") {
+			errMsg := "AWS supports only an Amazon-provided IPv6 CIDR block. Use 'auto' for the VPC IPv6_CIDR, not '" + vpcReqInfo.IPv6_CIDR + "'."
+			cblogger.Error(errMsg)
+			return irs.VPCInfo{}, errors.New(errMsg)
+		}
+		input.AmazonProvidedIpv6CidrBlock = aws.Bool(true)
+	}
+
 	//cblogger.Debug(input)
 	// logger for HisCall
 	callogger := call.GetLogger("HISCALL")
@@ -81,9 +94,11 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VPCInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info(result)
@@ -160,6 +175,21 @@ func (VPCHandler *AwsVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCIn
 		return retVpcInfo, errRoute
 	}
 
+	// IPv6 라우팅 정보 추가 (::/0)
+	if vpcReqInfo.IPv6_CIDR != "" {
+		errRoute = VPCHandler.CreateRouteIGWIPv6(retVpcInfo.IId.SystemId, *resultIGW.InternetGateway.InternetGatewayId)
+		if errRoute != nil {
+			return retVpcInfo, errRoute
+		}
+
+		// Amazon-provided IPv6 CIDR is assigned asynchronously, the subnets need it.
+		ipv6CIDR, errVpc := VPCHandler.waitForVPCIPv6CIDR(retVpcInfo.IId.SystemId)
+		if errVpc != nil {
+			return retVpcInfo, errVpc
+		}
+		retVpcInfo.IPv6_CIDR = ipv6CIDR
+	}
+
 	//==========================
 	// Subnet 생성
 	//==========================
@@ -227,10 +257,12 @@ func (VPCHandler *AwsVPCHandler) CreateRouteIGW(vpcId string, igwId string) erro
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return err
 	}
 	cblogger.Infof("Added routing information for IGW [%s] to RouteTable [%s] for destination (0.0.0.0/0) successfully.", routeTableId, igwId)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info(result)
@@ -238,6 +270,87 @@ func (VPCHandler *AwsVPCHandler) CreateRouteIGW(vpcId string, igwId string) erro
 	return nil
 }
 
+// 생성된 VPC의 라우팅 테이블에 IGW(Internet Gateway) IPv6 라우팅 정보(::/0)를 생성함
+func (VPCHandler *AwsVPCHandler) CreateRouteIGWIPv6(vpcId string, igwId string) error {
+	cblogger.Infof("VPC ID : [%s] / IGW ID : [%s]", vpcId, igwId)
+	routeTableId, errRoute := VPCHandler.GetDefaultRouteTable(vpcId)
+	if errRoute != nil {
+		return errRoute
+	}
+
+	input := &ec2.CreateRouteInput{
+		DestinationIpv6CidrBlock: aws.String("::/0"),
+		GatewayId:                aws.String(igwId),
+		RouteTableId:             aws.String(routeTableId),
+	}
+
+	// logger for HisCall
+	callogger := call.GetLogger("HISCALL")
+
+	callLogInfo := call.CLOUDLOGSCHEMA{
+		CloudOS:      call.AWS,
+		RegionZone:   VPCHandler.Region.Zone,
+		ResourceType: call.VPCSUBNET,
+		ResourceName: igwId,
+		CloudOSAPI:   "CreateRoute()",
+		ElapsedTime:  "",
+		ErrorMSG:     "",
+	}
+	callLogStart := call.Start()
+
+	_, err := VPCHandler.Client.CreateRoute(input)
+	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	if err != nil {
+		cblogger.Errorf("Failed to add routing information for IGW [%s] to RouteTable [%s] for destination (::/0).", igwId, routeTableId)
+		cblogger.Error(err.Error())
+		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
+		callogger.Info(call.String(callLogInfo))
+		return err
+	}
+	call.Observe(callLogInfo)
+	callogger.Info(call.String(callLogInfo))
+	return nil
+}
+
+// Amazon-provided IPv6 CIDR가 할당될 때까지 대기 함. (최대 30초)
+func (VPCHandler *AwsVPCHandler) waitForVPCIPv6CIDR(vpcId string) (string, error) {
+	for i := 0; i < 30; i++ {
+		vpcInfo, err := VPCHandler.GetVPC(irs.IID{SystemId: vpcId})
+		if err != nil {
+			return "", err
+		}
+		if vpcInfo.IPv6_CIDR != "" {
+			return vpcInfo.IPv6_CIDR, nil
+		}
+		time.Sleep(1 * time.Second)
+	}
+	return "", errors.New("Timeout: the IPv6 CIDR of the VPC '" + vpcId + "' is not assigned.")
+}
+
+// 서브넷의 IPv6 CIDR을 리턴 함. "auto"이면 VPC의 IPv6 CIDR에서 사용되지 않은 /64 대역을 할당 함.
+func (VPCHandler *AwsVPCHandler) getSubnetIPv6CIDR(vpcId string, reqIPv6CIDR string) (string, error) {
+	if !strings.EqualFold(reqIPv6CIDR, "auto") {
+		return reqIPv6CIDR, nil
+	}
+
+	vpcInfo, err := VPCHandler.GetVPC(irs.IID{SystemId: vpcId})
+	if err != nil {
+		return "", err
+	}
+	if vpcInfo.IPv6_CIDR == "" {
+		return "", errors.New("The VPC '" + vpcId + "' has no IPv6 CIDR for the subnet IPv6 CIDR 'auto'.")
+	}
+
+	var usedList []string
+	for _, subnetInfo := range vpcInfo.SubnetInfoList {
+		if subnetInfo.IPv6_CIDR != "" {
+			usedList = append(usedList, subnetInfo.IPv6_CIDR)
+		}
+	}
+	return cdcom.AllocSubnetIPv6CIDR(vpcInfo.IPv6_CIDR, usedList)
+}
+
 // https://docs.aws.amazon.com/ko_kr/vpc/latest/userguide/VPC_Route_Tables.html
 // https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_DescribeRouteTables.html
 // 자동 생성된 VPC의 기본 라우팅 테이블 정보를 찾음
@@ -318,6 +431,14 @@ func (VPCHandler *AwsVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.Su
 		AvailabilityZone:  aws.String(zoneId),
 		TagSpecifications: tagSpecifications,
 	}
+	if reqSubnetInfo.IPv6_CIDR != "" {
+		ipv6CIDR, errIPv6 := VPCHandler.getSubnetIPv6CIDR(vpcId, reqSubnetInfo.IPv6_CIDR)
+		if errIPv6 != nil {
+			cblogger.Error(errIPv6)
+			return irs.SubnetInfo{}, errIPv6
+		}
+		input.Ipv6CidrBlock = aws.String(ipv6CIDR)
+	}
 
 	// logger for HisCall
 	callogger := call.GetLogger("HISCALL")
@@ -349,9 +470,11 @@ func (VPCHandler *AwsVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.Su
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.SubnetInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Info(result)
 	//cblogger.Debug(result)
@@ -422,10 +545,12 @@ func (VPCHandler *AwsVPCHandler) AssociateRouteTable(vpcId string, subnetId stri
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return err
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Info(result)
 	//cblogger.Debug(result)
@@ -461,9 +586,11 @@ func (VPCHandler *AwsVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
 			cblogger.Error(err.Error())
 		}
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var vNetworkInfoList []*irs.VPCInfo
@@ -517,9 +644,11 @@ func (VPCHandler *AwsVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error) {
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VPCInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info(result)
@@ -558,6 +687,13 @@ func ExtractVpcDescribeInfo(vpcInfo *ec2.Vpc) irs.VPCInfo {
 		//State:     *vpcInfo.State,
 	}
 
+	for _, ipv6Assoc := range vpcInfo.Ipv6CidrBlockAssociationSet {
+		if ipv6Assoc.Ipv6CidrBlock != nil && *ipv6Assoc.Ipv6CidrBlock != "" {
+			awsVpcInfo.IPv6_CIDR = *ipv6Assoc.Ipv6CidrBlock
+			break
+		}
+	}
+
 	//Name은 Tag의 "Name" 속성에만 저장됨
 	//NameId는 전달할 필요가 없음.
 
@@ -608,10 +744,12 @@ func (VPCHandler *AwsVPCHandler) DeleteSubnet(subnetIID irs.IID) (bool, error) {
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return false, err
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	return true, nil
 }
@@ -700,9 +838,11 @@ func (VPCHandler *AwsVPCHandler) DeleteVPC(vpcIID irs.IID) (bool, error) {
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info(result)
@@ -986,9 +1126,11 @@ func (VPCHandler *AwsVPCHandler) ListSubnet(vpcId string) ([]irs.SubnetInfo, err
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	//cblogger.Debug(result)
@@ -1051,9 +1193,11 @@ func (VPCHandler *AwsVPCHandler) GetSubnet(reqSubnetId string) (irs.SubnetInfo,
 			cblogger.Error(err.Error())
 			callLogInfo.ErrorMSG = err.Error()
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.SubnetInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if !reflect.ValueOf(result.Subnets).IsNil() {
@@ -1081,6 +1225,13 @@ func ExtractSubnetDescribeInfo(subnetInfo *ec2.Subnet) irs.SubnetInfo {
 		Zone: *subnetInfo.AvailabilityZone,
 	}
 
+	for _, ipv6Assoc := range subnetInfo.Ipv6CidrBlockAssociationSet {
+		if ipv6Assoc.Ipv6CidrBlock != nil && *ipv6Assoc.Ipv6CidrBlock != "" {
+			vNetworkInfo.IPv6_CIDR = *ipv6Assoc.Ipv6CidrBlock
+			break
+		}
+	}
+
 	/*
 		cblogger.Debug("Name Tag 찾기")
 		for _, t := range subnetInfo.Tags {
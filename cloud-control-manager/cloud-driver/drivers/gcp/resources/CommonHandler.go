@@ -75,11 +75,13 @@ func InitLog() {
 
 func LoggingError(hiscallInfo call.CLOUDLOGSCHEMA, err error) {
 	hiscallInfo.ErrorMSG = err.Error()
+	call.Observe(hiscallInfo)
 	calllogger.Error(call.String(hiscallInfo))
 }
 
 func LoggingInfo(hiscallInfo call.CLOUDLOGSCHEMA, start time.Time) {
 	hiscallInfo.ElapsedTime = call.Elapsed(start)
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 }
 
@@ -626,6 +628,7 @@ func ListRegion(client *compute.Service, projectId string) (*compute.RegionList,
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return nil, err
@@ -661,6 +664,7 @@ func GetRegion(client *compute.Service, projectId string, regionName string) (*c
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return nil, err
@@ -87,6 +87,7 @@ func (DiskHandler *GCPDiskHandler) CreateDisk(diskReqInfo irs.DiskInfo) (irs.Dis
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	// Disk 생성 대기
@@ -138,6 +139,7 @@ func (DiskHandler *GCPDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
 				LoggingError(hiscallInfo, err)
 				return nil, err
 			}
+			call.Observe(hiscallInfo)
 			calllogger.Info(call.String(hiscallInfo))
 
 			for _, disk := range diskList.Items {
@@ -166,6 +168,7 @@ func (DiskHandler *GCPDiskHandler) GetDisk(diskIID irs.IID) (irs.DiskInfo, error
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	diskInfo, errDiskInfo := convertDiskInfo(diskResp)
@@ -214,6 +217,7 @@ func (DiskHandler *GCPDiskHandler) ChangeDiskSize(diskIID irs.IID, size string)
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	WaitOperationComplete(DiskHandler.Client, projectID, region, zone, op.Name, 3)
@@ -244,6 +248,7 @@ func (DiskHandler *GCPDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error) {
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	WaitOperationComplete(DiskHandler.Client, projectID, region, zone, op.Name, 3)
@@ -305,6 +310,7 @@ func (DiskHandler *GCPDiskHandler) AttachDisk(diskIID irs.IID, ownerVM irs.IID)
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	WaitOperationComplete(DiskHandler.Client, projectID, region, zone, op.Name, 3)
@@ -358,6 +364,7 @@ func (DiskHandler *GCPDiskHandler) DetachDisk(diskIID irs.IID, ownerVM irs.IID)
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	WaitOperationComplete(DiskHandler.Client, projectID, region, zone, op.Name, 3)
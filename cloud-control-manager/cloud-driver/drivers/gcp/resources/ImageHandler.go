@@ -126,6 +126,7 @@ func (imageHandler *GCPImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 		if err != nil {
 			callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Info(call.String(callLogInfo))
 			cblogger.Errorf("Failed to retrieve image list owned by [%s] project!", projectId)
 			cblogger.Error(err)
@@ -157,6 +158,7 @@ func (imageHandler *GCPImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 		} // for : 멀티 페이지 처리
 	}
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return imageList, nil
@@ -251,10 +253,12 @@ func (imageHandler *GCPImageHandler) GetImage(imageIID irs.IID) (irs.ImageInfo,
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.ImageInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	imageInfo := mappingImageInfo(image)
 	return imageInfo, nil
@@ -322,10 +326,12 @@ func (imageHandler *GCPImageHandler) DeleteImage(imageIID irs.IID) (bool, error)
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return false, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Debug(res)
 	return true, err
@@ -42,6 +42,7 @@ func (MyImageHandler *GCPMyImageHandler) SnapshotVM(snapshotReqInfo irs.MyImageI
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	WaitUntilComplete(MyImageHandler.Client, projectID, "", op.Name, true)
@@ -70,6 +71,7 @@ func (MyImageHandler *GCPMyImageHandler) ListMyImage() ([]*irs.MyImageInfo, erro
 		LoggingError(hiscallInfo, err)
 		return nil, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	for _, myImage := range myImageList.Items {
@@ -97,6 +99,7 @@ func (MyImageHandler *GCPMyImageHandler) GetMyImage(myImageIID irs.IID) (irs.MyI
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	myImageInfo, errMyImage := MyImageHandler.convertMyImageInfo(myImageResp)
@@ -122,6 +125,7 @@ func (MyImageHandler *GCPMyImageHandler) DeleteMyImage(myImageIID irs.IID) (bool
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	WaitUntilComplete(MyImageHandler.Client, projectID, "", op.Name, true)
@@ -181,6 +185,7 @@ func (MyImageHandler *GCPMyImageHandler) CheckWindowsImage(myImageIID irs.IID) (
 		LoggingError(hiscallInfo, err)
 		return isWindows, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	ip := machineImage.InstanceProperties
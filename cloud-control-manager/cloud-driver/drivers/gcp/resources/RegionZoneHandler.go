@@ -187,10 +187,12 @@ func (regionZoneHandler *GCPRegionZoneHandler) ListOrgRegion() (string, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return "", err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	j, _ := resp.MarshalJSON()
 
@@ -217,10 +219,12 @@ func (regionZoneHandler *GCPRegionZoneHandler) ListOrgZone() (string, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return "", err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	j, _ := resp.MarshalJSON()
 
@@ -1869,10 +1869,12 @@ func (securityHandler *GCPSecurityHandler) firewallInsert(firewallInfo compute.F
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		cblogger.Error(err)
 		return compute.Firewall{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Debug("create default firewall rule result : ", res)
 
@@ -1920,10 +1922,12 @@ func (securityHandler *GCPSecurityHandler) firewallDelete(securityGroupTag strin
 		callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 			cblogger.Error(err)
 			return false, err
 		}
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Info("remove result : ", resourceID, res)
 
@@ -1960,10 +1964,12 @@ func (securityHandler *GCPSecurityHandler) firewallList(tag string) ([]compute.F
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	firewallList := extractFirewallList(*result, tag) // 그룹으로 묶기
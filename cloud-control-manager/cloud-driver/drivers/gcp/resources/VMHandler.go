@@ -459,6 +459,7 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 
 			if err1 != nil {
 				callLogInfo.ErrorMSG = err1.Error()
+				call.Observe(callLogInfo)
 				callogger.Error(call.String(callLogInfo))
 				cblogger.Error("fail to create vm which does not support live migration")
 				cblogger.Error(err1)
@@ -466,6 +467,7 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 			}
 		} else {
 			callLogInfo.ErrorMSG = err1.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 			cblogger.Error("failed to create vm")
 			cblogger.Error(err1)
@@ -477,6 +479,7 @@ func (vmHandler *GCPVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, err
 	cblogger.Info("VM creation request call completed.")
 	cblogger.Debug(op)
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	// check operation status, wait until operation is completed
@@ -610,10 +613,12 @@ func (vmHandler *GCPVMHandler) SuspendVM(vmID irs.IID) (irs.VMStatus, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("instance stop status :", inst.Status)
@@ -654,10 +659,12 @@ func (vmHandler *GCPVMHandler) ResumeVM(vmID irs.IID) (irs.VMStatus, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("instance resume status :", inst.Status)
@@ -698,6 +705,7 @@ func (vmHandler *GCPVMHandler) RebootVM(vmID irs.IID) (irs.VMStatus, error) {
 		if err != nil {
 			callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Info(call.String(callLogInfo))
 			callogger.Info(operation)
 			return irs.VMStatus("Failed"), err
@@ -799,10 +807,12 @@ func (vmHandler *GCPVMHandler) TerminateVM(vmID irs.IID) (irs.VMStatus, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("instance status :", inst.Status)
@@ -831,10 +841,12 @@ func (vmHandler *GCPVMHandler) ListVMStatus() ([]*irs.VMStatusInfo, error) {
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var vmStatusList []*irs.VMStatusInfo
@@ -906,6 +918,7 @@ func (vmHandler *GCPVMHandler) GetVMStatus(vmID irs.IID) (irs.VMStatus, error) {
 		// It will print out many error messages in the log.
 		if !strings.Contains(err.Error(), "not found") {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 		}
 		if !strings.Contains(err.Error(), "not found") {
@@ -913,6 +926,7 @@ func (vmHandler *GCPVMHandler) GetVMStatus(vmID irs.IID) (irs.VMStatus, error) {
 		}
 		return irs.VMStatus("Failed"), err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	// Get powerState, provisioningState
@@ -943,11 +957,13 @@ func (vmHandler *GCPVMHandler) ListVM() ([]*irs.VMInfo, error) {
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		cblogger.Infof("There are no VM lists created in that zone.")
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var vmList []*irs.VMInfo
@@ -982,6 +998,7 @@ func (vmHandler *GCPVMHandler) GetVM(vmID irs.IID) (irs.VMInfo, error) {
 		// It will print out many error messages in the log.
 		if !strings.Contains(err.Error(), "not found") {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 		}
 		if !strings.Contains(err.Error(), "not found") {
@@ -989,6 +1006,7 @@ func (vmHandler *GCPVMHandler) GetVM(vmID irs.IID) (irs.VMInfo, error) {
 		}
 		return irs.VMInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Debug(vm)
 
@@ -1023,10 +1041,12 @@ func (vmHandler *GCPVMHandler) GetVmById(vmID irs.IID) (irs.VMInfo, error) {
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.VMInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	foundVm := false
 	for _, item := range instanceListByzone.Items {
@@ -92,9 +92,11 @@ func (vVPCHandler *GCPVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCI
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
 
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.VPCInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("[%s] VPC is being created successfully - Resource ID: [%d]", name, req.Id)
@@ -377,10 +379,12 @@ func (vVPCHandler *GCPVPCHandler) ListVPC() ([]*irs.VPCInfo, error) {
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
 
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var vpcInfo []*irs.VPCInfo
@@ -435,10 +439,12 @@ func (vVPCHandler *GCPVPCHandler) GetVPC(vpcIID irs.IID) (irs.VPCInfo, error) {
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
 
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(err)
 		return irs.VPCInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Debug(infoVPC)
 	if infoVPC.Subnetworks != nil {
@@ -565,6 +571,7 @@ func (vVPCHandler *GCPVPCHandler) DeleteVPC(vpcID irs.IID) (bool, error) {
 	if err != nil {
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return false, err
 	}
@@ -579,9 +586,11 @@ func (vVPCHandler *GCPVPCHandler) DeleteVPC(vpcID irs.IID) (bool, error) {
 
 	cblogger.Infof("Waiting for [%s] VPC to be finally deleted - Resource ID: [%d]", name)
 	errChkVpcStatus := vVPCHandler.WaitUntilComplete(strconv.FormatUint(info.Id, 10), true)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	if errChkVpcStatus != nil {
 		callLogInfo.ErrorMSG = errChkVpcStatus.Error()
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Errorf("[%s] Subnet deletion completion wait failed", name)
 		cblogger.Error(errChkVpcStatus)
@@ -635,9 +644,11 @@ func (vVPCHandler *GCPVPCHandler) CreateSubnet(vpcId string, reqSubnetInfo irs.S
 	if err == nil {
 		callLogInfo.ErrorMSG = err.Error()
 		cblogger.Errorf("[%s] Subnet already exists ", subnetName)
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		return irs.SubnetInfo{}, errors.New("Already Exist - " + subnetName + " Subnet is exist")
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Info("Subnet info : ", checkInfo)
 
@@ -699,10 +710,12 @@ func (vVPCHandler *GCPVPCHandler) RemoveSubnet(vpcIID irs.IID, subnetIID irs.IID
 	if infoSubErr != nil {
 		callLogInfo.ErrorMSG = infoSubErr.Error()
 
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 		cblogger.Error(infoSubErr)
 		return false, infoSubErr
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	cblogger.Info("Delete subnet result :", infoSubnet)
 
@@ -46,6 +46,9 @@ func (MockDriver) GetDriverCapability() idrv.DriverCapabilityInfo {
 	drvCapabilityInfo.VMSpecHandler = true
 	drvCapabilityInfo.TagHandler = true // Add this line to indicate that TagHandler is supported
 
+	drvCapabilityInfo.SG_PEER_SECURITY_GROUP = true
+	drvCapabilityInfo.IPV6_DUAL_STACK = true
+
 	return drvCapabilityInfo
 }
 
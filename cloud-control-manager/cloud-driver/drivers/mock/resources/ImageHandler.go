@@ -41,11 +41,16 @@ func PrepareVMImage(mockName string) {
 	}
 
 	PrepareImageInfoList = []*irs.ImageInfo{
-		{irs.IID{"mock-vmimage-01", "mock-vmimage-01"}, "TestGuestOS", "AVAILABLE", nil, nil},
-		{irs.IID{"mock-vmimage-02", "mock-vmimage-02"}, "TestGuestOS", "AVAILABLE", nil, nil},
-		{irs.IID{"mock-vmimage-03", "mock-vmimage-03"}, "TestGuestOS", "AVAILABLE", nil, nil},
-		{irs.IID{"mock-vmimage-04", "mock-vmimage-04"}, "TestGuestOS", "AVAILABLE", nil, nil},
-		{irs.IID{"mock-vmimage-05", "mock-vmimage-05"}, "TestGuestOS", "AVAILABLE", nil, nil},
+		{IId: irs.IID{"mock-vmimage-01", "mock-vmimage-01"}, GuestOS: "TestGuestOS", Status: "AVAILABLE",
+			OSDistribution: "ubuntu", OSVersion: "22.04", OSArchitecture: "x86_64", OSPlatform: "Linux/UNIX", RootDiskMinSizeGB: "8"},
+		{IId: irs.IID{"mock-vmimage-02", "mock-vmimage-02"}, GuestOS: "TestGuestOS", Status: "AVAILABLE",
+			OSDistribution: "ubuntu", OSVersion: "22.04", OSArchitecture: "arm64", OSPlatform: "Linux/UNIX", RootDiskMinSizeGB: "8"},
+		{IId: irs.IID{"mock-vmimage-03", "mock-vmimage-03"}, GuestOS: "TestGuestOS", Status: "AVAILABLE",
+			OSDistribution: "ubuntu", OSVersion: "20.04", OSArchitecture: "x86_64", OSPlatform: "Linux/UNIX", RootDiskMinSizeGB: "50", GPUReady: true},
+		{IId: irs.IID{"mock-vmimage-04", "mock-vmimage-04"}, GuestOS: "TestGuestOS", Status: "AVAILABLE",
+			OSDistribution: "centos", OSVersion: "7", OSArchitecture: "x86_64", OSPlatform: "Linux/UNIX", RootDiskMinSizeGB: "10"},
+		{IId: irs.IID{"mock-vmimage-05", "mock-vmimage-05"}, GuestOS: "TestGuestOS", Status: "AVAILABLE",
+			OSDistribution: "windows", OSVersion: "2019", OSArchitecture: "x86_64", OSPlatform: "Windows", RootDiskMinSizeGB: "30"},
 	}
 	imgInfoMap[mockName] = PrepareImageInfoList
 }
@@ -60,7 +65,7 @@ func (imageHandler *MockImageHandler) CreateImage(imageReqInfo irs.ImageReqInfo)
 	imageReqInfo.IId.SystemId = imageReqInfo.IId.NameId
 
 	// (1) create imageInfo object
-	imageInfo := irs.ImageInfo{irs.IID{imageReqInfo.IId.NameId, imageReqInfo.IId.SystemId}, "TestGuestOS", "TestStatus", nil, nil}
+	imageInfo := irs.ImageInfo{IId: irs.IID{imageReqInfo.IId.NameId, imageReqInfo.IId.SystemId}, GuestOS: "TestGuestOS", Status: "TestStatus"}
 
 	// (2) insert ImageInfo into global Map
 	imgInfoList, _ := imgInfoMap[mockName]
@@ -49,6 +49,10 @@ func (nlbHandler *MockNLBHandler) CreateNLB(nlbInfo irs.NLBInfo) (irs.NLBInfo, e
 	nlbInfo.CreatedTime = time.Now()
 	nlbInfo.Listener.IP = "1.2.3.4"
 	nlbInfo.Listener.DNSName = ""
+	vpcInfo, err := (&MockVPCHandler{MockName: mockName}).GetVPC(nlbInfo.VpcIID)
+	if err == nil && vpcInfo.IPv6_CIDR != "" { // dual-stack VPC
+		nlbInfo.Listener.IPv6 = "2001:db8::1:2:3:4"
+	}
 	nlbInfo.Listener.CspID = nlbInfo.IId.NameId + "-Listener-" + xid.New().String()
 	nlbInfo.VMGroup.CspID = nlbInfo.IId.NameId + "-VMGroup-" + xid.New().String()
 	nlbInfo.HealthChecker.CspID = nlbInfo.IId.NameId + "-HealthChecker-" + xid.New().String()
@@ -299,6 +303,7 @@ func CloneListenerInfo(srcInfo irs.ListenerInfo) irs.ListenerInfo {
 	clonedInfo := irs.ListenerInfo{
 		Protocol:     srcInfo.Protocol,
 		IP:           srcInfo.IP,
+		IPv6:         srcInfo.IPv6,
 		Port:         srcInfo.Port,
 		DNSName:      srcInfo.DNSName,
 		CspID:        srcInfo.CspID,
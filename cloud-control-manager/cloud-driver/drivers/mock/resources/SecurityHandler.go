@@ -247,6 +247,13 @@ func isEqualRule(a *irs.SecurityRuleInfo, b *irs.SecurityRuleInfo) bool {
 		FromPort   string
 		ToPort     string
 		CIDR       string
+
+		PeerSecurityGroupIID *IID
+
+		ICMPType string
+		ICMPCode string
+
+		Description string
 	}
 	-------------------------------*/
 
@@ -265,6 +272,18 @@ func isEqualRule(a *irs.SecurityRuleInfo, b *irs.SecurityRuleInfo) bool {
 	if a.CIDR != b.CIDR {
 		return false
 	}
+	if a.ICMPType != b.ICMPType {
+		return false
+	}
+	if a.ICMPCode != b.ICMPCode {
+		return false
+	}
+	if (a.PeerSecurityGroupIID == nil) != (b.PeerSecurityGroupIID == nil) {
+		return false
+	}
+	if a.PeerSecurityGroupIID != nil && a.PeerSecurityGroupIID.SystemId != b.PeerSecurityGroupIID.SystemId {
+		return false
+	}
 
 	return true
 }
@@ -42,6 +42,104 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	mockName := vmHandler.MockName
 	vmReqInfo.IId.SystemId = vmReqInfo.IId.NameId
 
+	validated, err := vmHandler.validateVMReqInfo(vmReqInfo)
+	if err != nil {
+		cblogger.Error(err)
+		return irs.VMInfo{}, err
+	}
+
+	// vm creation
+	vmInfo := irs.VMInfo{
+		IId:       vmReqInfo.IId,
+		StartTime: time.Now(),
+
+		Region:            irs.RegionInfo{vmHandler.Region.Region, vmHandler.Region.Zone},
+		ImageIId:          validated.imageIID,
+		VMSpecName:        validated.specInfo.Name,
+		VpcIID:            validated.vpcInfo.IId,
+		SubnetIID:         validated.subnetInfo.IId,
+		SecurityGroupIIds: validated.sgIIDs,
+
+		KeyPairIId: validated.keyPairInfo.IId,
+
+		VMUserId:     vmReqInfo.VMUserId,
+		VMUserPasswd: vmReqInfo.VMUserPasswd,
+
+		NetworkInterface: "mockni0",
+		PublicIP:         "4.3.2.1",
+		PublicDNS:        vmReqInfo.IId.NameId + ".spider.barista.com",
+		PrivateIP:        "1.2.3.4",
+		PrivateDNS:       vmReqInfo.IId.NameId + ".spider.barista.com",
+
+		VMBootDisk:  "/dev/sda1",
+		VMBlockDisk: "/dev/sda1",
+
+		RootDiskType:   "SSD",
+		RootDiskSize:   "32",
+		RootDeviceName: "/dev/sda1",
+
+		DataDiskIIDs: validated.diskIIDs,
+
+		TagList:      vmReqInfo.TagList,
+		KeyValueList: nil,
+	}
+
+	// dual-stack subnet
+	if validated.subnetInfo.IPv6_CIDR != "" {
+		vmInfo.PublicIPv6 = "2001:db8::4:3:2:1"
+		vmInfo.PrivateIPv6 = "fd00::1:2:3:4"
+	}
+
+	// attach disks
+	for _, diskIID := range validated.diskIIDs {
+		_, err := justAttachDisk(mockName, diskIID, vmReqInfo.IId)
+		if err != nil {
+			cblogger.Error(err)
+			return irs.VMInfo{}, err
+		}
+	}
+
+	vmMapLock.Lock()
+	defer vmMapLock.Unlock()
+
+	infoList, _ := vmInfoMap[mockName]
+	infoList = append(infoList, &vmInfo)
+	vmInfoMap[mockName] = infoList
+
+	// vm status creation
+	vmStatusInfo := irs.VMStatusInfo{vmReqInfo.IId, irs.Running}
+
+	statusInfoList, _ := vmStatusInfoMap[mockName]
+	statusInfoList = append(statusInfoList, &vmStatusInfo)
+	vmStatusInfoMap[mockName] = statusInfoList
+
+	return vmInfo, nil
+}
+
+type validatedVMReqInfo struct {
+	imageIID    irs.IID
+	specInfo    irs.VMSpecInfo
+	vpcInfo     irs.VPCInfo
+	subnetInfo  *irs.SubnetInfo
+	sgIIDs      []irs.IID
+	diskIIDs    []irs.IID
+	keyPairInfo irs.KeyPairInfo
+}
+
+// ValidateVM validates the request without creating the VM.
+func (vmHandler *MockVMHandler) ValidateVM(vmReqInfo irs.VMReqInfo) error {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+	cblogger.Info("Mock Driver: called ValidateVM()!")
+
+	_, err := vmHandler.validateVMReqInfo(vmReqInfo)
+	return err
+}
+
+func (vmHandler *MockVMHandler) validateVMReqInfo(vmReqInfo irs.VMReqInfo) (*validatedVMReqInfo, error) {
+	cblogger := cblog.GetLogger("CB-SPIDER")
+
+	mockName := vmHandler.MockName
+
 	validatedImageIID := irs.IID{}
 	// public image validation
 	if vmReqInfo.ImageType == irs.PublicImage {
@@ -49,7 +147,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 		validatedImgInfo, err := imageHandler.GetImage(vmReqInfo.ImageIID)
 		if err != nil {
 			cblogger.Error(err)
-			return irs.VMInfo{}, err
+			return nil, err
 		}
 		validatedImageIID = validatedImgInfo.IId
 	}
@@ -60,7 +158,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 		validatedMyImgInfo, err := myImageHandler.GetMyImage(vmReqInfo.ImageIID)
 		if err != nil {
 			cblogger.Error(err)
-			return irs.VMInfo{}, err
+			return nil, err
 		}
 		validatedImageIID = validatedMyImgInfo.IId
 	}
@@ -70,7 +168,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	validatedSpecInfo, err := vmSpecHandler.GetVMSpec(vmReqInfo.VMSpecName)
 	if err != nil {
 		cblogger.Error(err)
-		return irs.VMInfo{}, err
+		return nil, err
 	}
 
 	// vpc validation
@@ -78,7 +176,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	validatedVPCInfo, err := vpcHandler.GetVPC(vmReqInfo.VpcIID)
 	if err != nil {
 		cblogger.Error(err)
-		return irs.VMInfo{}, err
+		return nil, err
 	}
 
 	// subnet validation
@@ -92,7 +190,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	if validatedSubnetInfo == nil {
 		errMSG := vmReqInfo.SubnetIID.NameId + " subnet iid does not exist!!"
 		cblogger.Error(errMSG)
-		return irs.VMInfo{}, fmt.Errorf(errMSG)
+		return nil, fmt.Errorf(errMSG)
 	}
 
 	// sg validation
@@ -100,7 +198,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	sgInfoList, err := securityHandler.ListSecurity()
 	if err != nil {
 		cblogger.Error(err)
-		return irs.VMInfo{}, err
+		return nil, err
 	}
 	validatedSgIIDs := []irs.IID{}
 	for _, info1 := range vmReqInfo.SecurityGroupIIDs {
@@ -115,7 +213,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 		if !flg {
 			errMSG := info1.NameId + " security group iid does not exist!!"
 			cblogger.Error(errMSG)
-			return irs.VMInfo{}, fmt.Errorf(errMSG)
+			return nil, fmt.Errorf(errMSG)
 		}
 	}
 
@@ -124,7 +222,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	diskInfoList, err := diskHandler.ListDisk()
 	if err != nil {
 		cblogger.Error(err)
-		return irs.VMInfo{}, err
+		return nil, err
 	}
 	validatedDiskIIDs := []irs.IID{}
 	for _, info1 := range vmReqInfo.DataDiskIIDs {
@@ -138,7 +236,7 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 		if !flg {
 			errMSG := info1.NameId + " Data Disk iid does not exist!!"
 			cblogger.Error(errMSG)
-			return irs.VMInfo{}, fmt.Errorf(errMSG)
+			return nil, fmt.Errorf(errMSG)
 		}
 	}
 
@@ -147,69 +245,18 @@ func (vmHandler *MockVMHandler) StartVM(vmReqInfo irs.VMReqInfo) (irs.VMInfo, er
 	validatedKeyPairInfo, err := keyPairHandler.GetKey(vmReqInfo.KeyPairIID)
 	if err != nil {
 		cblogger.Error(err)
-		return irs.VMInfo{}, err
-	}
-
-	// vm creation
-	vmInfo := irs.VMInfo{
-		IId:       vmReqInfo.IId,
-		StartTime: time.Now(),
-
-		Region:            irs.RegionInfo{vmHandler.Region.Region, vmHandler.Region.Zone},
-		ImageIId:          validatedImageIID,
-		VMSpecName:        validatedSpecInfo.Name,
-		VpcIID:            validatedVPCInfo.IId,
-		SubnetIID:         validatedSubnetInfo.IId,
-		SecurityGroupIIds: validatedSgIIDs,
-
-		KeyPairIId: validatedKeyPairInfo.IId,
-
-		VMUserId:     vmReqInfo.VMUserId,
-		VMUserPasswd: vmReqInfo.VMUserPasswd,
-
-		NetworkInterface: "mockni0",
-		PublicIP:         "4.3.2.1",
-		PublicDNS:        vmReqInfo.IId.NameId + ".spider.barista.com",
-		PrivateIP:        "1.2.3.4",
-		PrivateDNS:       vmReqInfo.IId.NameId + ".spider.barista.com",
-
-		VMBootDisk:  "/dev/sda1",
-		VMBlockDisk: "/dev/sda1",
-
-		RootDiskType:   "SSD",
-		RootDiskSize:   "32",
-		RootDeviceName: "/dev/sda1",
-
-		DataDiskIIDs: validatedDiskIIDs,
-
-		TagList:      vmReqInfo.TagList,
-		KeyValueList: nil,
-	}
-
-	// attach disks
-	for _, diskIID := range validatedDiskIIDs {
-		_, err := justAttachDisk(mockName, diskIID, vmReqInfo.IId)
-		if err != nil {
-			cblogger.Error(err)
-			return irs.VMInfo{}, err
-		}
-	}
-
-	vmMapLock.Lock()
-	defer vmMapLock.Unlock()
-
-	infoList, _ := vmInfoMap[mockName]
-	infoList = append(infoList, &vmInfo)
-	vmInfoMap[mockName] = infoList
-
-	// vm status creation
-	vmStatusInfo := irs.VMStatusInfo{vmReqInfo.IId, irs.Running}
-
-	statusInfoList, _ := vmStatusInfoMap[mockName]
-	statusInfoList = append(statusInfoList, &vmStatusInfo)
-	vmStatusInfoMap[mockName] = statusInfoList
-
-	return vmInfo, nil
+		return nil, err
+	}
+
+	return &validatedVMReqInfo{
+		imageIID:    validatedImageIID,
+		specInfo:    validatedSpecInfo,
+		vpcInfo:     validatedVPCInfo,
+		subnetInfo:  validatedSubnetInfo,
+		sgIIDs:      validatedSgIIDs,
+		diskIIDs:    validatedDiskIIDs,
+		keyPairInfo: validatedKeyPairInfo,
+	}, nil
 }
 
 func (vmHandler *MockVMHandler) SuspendVM(iid irs.IID) (irs.VMStatus, error) {
@@ -524,6 +571,8 @@ func CloneVMInfo(srcInfo irs.VMInfo) irs.VMInfo {
 		PublicDNS:        srcInfo.PublicDNS,
 		PrivateIP:        srcInfo.PrivateIP,
 		PrivateDNS:       srcInfo.PrivateDNS,
+		PublicIPv6:       srcInfo.PublicIPv6,
+		PrivateIPv6:      srcInfo.PrivateIPv6,
 
 		SSHAccessPoint: srcInfo.SSHAccessPoint,
 
@@ -11,7 +11,9 @@
 package resources
 
 import (
+	"encoding/binary"
 	"fmt"
+	"net"
 	"sync"
 
 	cblog "github.com/cloud-barista/cb-log"
@@ -47,10 +49,21 @@ func (vpcHandler *MockVPCHandler) CreateVPC(vpcReqInfo irs.VPCReqInfo) (irs.VPCI
 		vpcReqInfo.SubnetInfoList[i] = subnetInfo
 	}
 
+	// "auto": assigned by Mock
+	if vpcReqInfo.IPv6_CIDR == "auto" {
+		vpcReqInfo.IPv6_CIDR = "fd00:1234:5678::/56"
+	}
+	for i, subnetInfo := range vpcReqInfo.SubnetInfoList {
+		if subnetInfo.IPv6_CIDR == "auto" {
+			vpcReqInfo.SubnetInfoList[i].IPv6_CIDR = allocMockSubnetIPv6CIDR(vpcReqInfo.IPv6_CIDR, vpcReqInfo.SubnetInfoList)
+		}
+	}
+
 	// (1) create vpcInfo object
 	vpcInfo := irs.VPCInfo{
 		IId:            vpcReqInfo.IId,
 		IPv4_CIDR:      vpcReqInfo.IPv4_CIDR,
+		IPv6_CIDR:      vpcReqInfo.IPv6_CIDR,
 		SubnetInfoList: vpcReqInfo.SubnetInfoList,
 		TagList:        vpcReqInfo.TagList,
 		KeyValueList:   nil,
@@ -96,6 +109,7 @@ func CloneVPCInfo(srcInfo irs.VPCInfo) irs.VPCInfo {
 		type VPCInfo struct {
 			IId            IID // {NameId, SystemId}
 			IPv4_CIDR      string
+			IPv6_CIDR      string
 			SubnetInfoList []SubnetInfo
 
 			TagList      []KeyValue
@@ -107,6 +121,7 @@ func CloneVPCInfo(srcInfo irs.VPCInfo) irs.VPCInfo {
 	clonedInfo := irs.VPCInfo{
 		IId:            irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
 		IPv4_CIDR:      srcInfo.IPv4_CIDR,
+		IPv6_CIDR:      srcInfo.IPv6_CIDR,
 		SubnetInfoList: CloneSubnetInfoList(srcInfo.SubnetInfoList),
 		TagList:        srcInfo.TagList, // clone TagList
 		KeyValueList:   srcInfo.KeyValueList,
@@ -130,6 +145,7 @@ func CloneSubnetInfo(srcInfo irs.SubnetInfo) irs.SubnetInfo {
 			IId       IID    // {NameId, SystemId}
 			Zone      string // Target Zone Name
 			IPv4_CIDR string
+			IPv6_CIDR string
 
 			TagList      []KeyValue
 			KeyValueList []KeyValue
@@ -141,6 +157,7 @@ func CloneSubnetInfo(srcInfo irs.SubnetInfo) irs.SubnetInfo {
 		IId:          irs.IID{srcInfo.IId.NameId, srcInfo.IId.SystemId},
 		Zone:         srcInfo.Zone,
 		IPv4_CIDR:    srcInfo.IPv4_CIDR,
+		IPv6_CIDR:    srcInfo.IPv6_CIDR,
 		TagList:      srcInfo.TagList, // clone TagList
 		KeyValueList: srcInfo.KeyValueList,
 	}
@@ -208,6 +225,9 @@ func (vpcHandler *MockVPCHandler) AddSubnet(iid irs.IID, subnetInfo irs.SubnetIn
 	subnetInfo.IId.SystemId = subnetInfo.IId.NameId
 	for _, info := range infoList {
 		if info.IId.NameId == iid.NameId {
+			if subnetInfo.IPv6_CIDR == "auto" {
+				subnetInfo.IPv6_CIDR = allocMockSubnetIPv6CIDR(info.IPv6_CIDR, info.SubnetInfoList)
+			}
 			info.SubnetInfoList = append(info.SubnetInfoList, subnetInfo)
 
 			return CloneVPCInfo(*info), nil
@@ -217,6 +237,29 @@ func (vpcHandler *MockVPCHandler) AddSubnet(iid irs.IID, subnetInfo irs.SubnetIn
 	return irs.VPCInfo{}, fmt.Errorf("%s VPC does not exist!!", iid.NameId)
 }
 
+// returns the first /64 subnet CIDR of the VPC IPv6 CIDR not used by the subnets
+func allocMockSubnetIPv6CIDR(vpcIPv6CIDR string, subnetInfoList []irs.SubnetInfo) string {
+	_, vpcNet, err := net.ParseCIDR(vpcIPv6CIDR)
+	if err != nil {
+		return ""
+	}
+	usedMap := map[string]bool{}
+	for _, subnetInfo := range subnetInfoList {
+		usedMap[subnetInfo.IPv6_CIDR] = true
+	}
+
+	base := binary.BigEndian.Uint64(vpcNet.IP[:8])
+	for i := uint64(0); i < 256; i++ {
+		subnetIP := make(net.IP, net.IPv6len)
+		binary.BigEndian.PutUint64(subnetIP[:8], base|i)
+		cidr := (&net.IPNet{IP: subnetIP, Mask: net.CIDRMask(64, 128)}).String()
+		if !usedMap[cidr] {
+			return cidr
+		}
+	}
+	return ""
+}
+
 func (vpcHandler *MockVPCHandler) RemoveSubnet(iid irs.IID, subnetIID irs.IID) (bool, error) {
 	cblogger := cblog.GetLogger("CB-SPIDER")
 	cblogger.Info("Mock Driver: called RemoveSubnet()!")
@@ -198,3 +198,56 @@ func TestSecurityAddRules(t *testing.T) {
 	// pritn 0 Rule
 	// fmt.Printf("\n\t%#v\n", *info4.SecurityRules)
 }
+
+func TestSecurityPeerSGRules(t *testing.T) {
+	infoList, err := securityHandler.ListSecurity()
+	if err != nil {
+		t.Error(err.Error())
+	}
+	if len(infoList) < 2 {
+		t.Fatalf("The number of Infos is less than %d. It is %d.", 2, len(infoList))
+	}
+
+	//---- Add a Rule with a peer SG => 1 Rule
+	peerIID := infoList[1].IId
+	SecurityRules := &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "5432", ToPort: "5432", PeerSecurityGroupIID: &peerIID},
+	}
+	info, err := securityHandler.AddRules(infoList[0].IId, SecurityRules)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	found := false
+	for _, rule := range *info.SecurityRules {
+		if rule.PeerSecurityGroupIID != nil && rule.PeerSecurityGroupIID.SystemId == peerIID.SystemId {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("The rule with the peer SG %s does not exist.", peerIID.SystemId)
+	}
+
+	//---- The same port range with a CIDR is a different rule
+	SecurityRules2 := &[]irs.SecurityRuleInfo{
+		{Direction: "inbound", IPProtocol: "tcp", FromPort: "5432", ToPort: "5432", CIDR: "10.0.0.0/16"},
+	}
+	_, err = securityHandler.AddRules(infoList[0].IId, SecurityRules2)
+	if err != nil {
+		t.Error(err.Error())
+	}
+
+	//---- Remove the Rule with a peer SG
+	result, err := securityHandler.RemoveRules(infoList[0].IId, SecurityRules)
+	if result != true {
+		t.Error(err.Error())
+	}
+	info2, err := securityHandler.GetSecurity(infoList[0].IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	for _, rule := range *info2.SecurityRules {
+		if rule.PeerSecurityGroupIID != nil {
+			t.Errorf("The rule with the peer SG %s is not removed.", rule.PeerSecurityGroupIID.SystemId)
+		}
+	}
+}
@@ -182,3 +182,28 @@ func TestVPCDeleteGet(t *testing.T) {
 		t.Errorf("The number of Infos is not %d. It is %d.", 0, len(infoList))
 	}
 }
+
+func TestVPCIPv6DualStack(t *testing.T) {
+	reqInfo := irs.VPCReqInfo{
+		IId:            irs.IID{"mock-vpc-ipv6", ""},
+		IPv4_CIDR:      "10.0.0.0/16",
+		IPv6_CIDR:      "auto",
+		SubnetInfoList: []irs.SubnetInfo{{IId: irs.IID{"mock-subnet-ipv6", ""}, IPv4_CIDR: "10.0.1.0/24", IPv6_CIDR: "fd00:1234:5678:1::/64"}},
+	}
+	info, err := vpcTestHandler.CreateVPC(reqInfo)
+	if err != nil {
+		t.Error(err.Error())
+	}
+	// "auto" is assigned by the driver
+	if info.IPv6_CIDR == "" || info.IPv6_CIDR == "auto" {
+		t.Errorf("VPC IPv6_CIDR is not assigned: %s", info.IPv6_CIDR)
+	}
+	if info.SubnetInfoList[0].IPv6_CIDR != reqInfo.SubnetInfoList[0].IPv6_CIDR {
+		t.Errorf("Subnet IPv6_CIDR %s is not same %s", info.SubnetInfoList[0].IPv6_CIDR, reqInfo.SubnetInfoList[0].IPv6_CIDR)
+	}
+
+	_, err = vpcTestHandler.DeleteVPC(info.IId)
+	if err != nil {
+		t.Error(err.Error())
+	}
+}
@@ -63,6 +63,7 @@ func (clusterHandler *TencentClusterHandler) CreateCluster(clusterReqInfo irs.Cl
 		err = fmt.Errorf("Failed to Create Cluster :  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.ClusterInfo{}, err
 	}
@@ -80,9 +81,11 @@ func (clusterHandler *TencentClusterHandler) CreateCluster(clusterReqInfo irs.Cl
 		err := fmt.Errorf("Failed to Create Cluster :  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.ClusterInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	// NodeGroup 생성 정보가 있는경우 생성을 시도한다.
@@ -120,9 +123,11 @@ func (clusterHandler *TencentClusterHandler) ListCluster() ([]*irs.ClusterInfo,
 		err := fmt.Errorf("Failed to Get Clusters :  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	cluster_info_list := make([]*irs.ClusterInfo, *res.Response.TotalCount)
@@ -149,9 +154,11 @@ func (clusterHandler *TencentClusterHandler) GetCluster(clusterIID irs.IID) (irs
 		err := fmt.Errorf("Failed to Get ClusterInfo :  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.ClusterInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	return *cluster_info, nil
@@ -168,10 +175,12 @@ func (clusterHandler *TencentClusterHandler) DeleteCluster(clusterIID irs.IID) (
 		err := fmt.Errorf("Failed to Delete Cluster :  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return false, err
 	}
 	cblogger.Info("DeleteCluster(): ", res)
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	return true, nil
@@ -191,6 +200,7 @@ func (clusterHandler *TencentClusterHandler) AddNodeGroup(clusterIID irs.IID, no
 		err := fmt.Errorf("Failed to Add Node Group :  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.NodeGroupInfo{}, err
 	}
@@ -209,9 +219,11 @@ func (clusterHandler *TencentClusterHandler) AddNodeGroup(clusterIID irs.IID, no
 		err := fmt.Errorf("Failed to Add Node Group :  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.NodeGroupInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	node_group_info, err := getNodeGroupInfo(clusterHandler.CredentialInfo.ClientId, clusterHandler.CredentialInfo.ClientSecret, clusterHandler.RegionInfo.Region, clusterIID.SystemId, *response.Response.NodePoolId)
@@ -284,10 +296,12 @@ func (clusterHandler *TencentClusterHandler) SetNodeGroupAutoScaling(clusterIID
 		err := fmt.Errorf("Failed to Set Node Group AutoScaling:  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return false, err
 	}
 	cblogger.Debug(temp.ToJsonString())
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	return true, nil
@@ -307,6 +321,7 @@ func (clusterHandler *TencentClusterHandler) ChangeNodeGroupScaling(clusterIID i
 		err := fmt.Errorf("Failed to Change Node Group Scaling:  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.NodeGroupInfo{}, err
 	}
@@ -323,10 +338,12 @@ func (clusterHandler *TencentClusterHandler) ChangeNodeGroupScaling(clusterIID i
 		err := fmt.Errorf("Failed to Change Node Group Scaling:  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.NodeGroupInfo{}, err
 	}
 	cblogger.Debug(temp.ToJsonString())
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	node_group_info, err := getNodeGroupInfo(clusterHandler.CredentialInfo.ClientId, clusterHandler.CredentialInfo.ClientSecret, clusterHandler.RegionInfo.Region, clusterIID.SystemId, nodeGroupIID.SystemId)
@@ -350,10 +367,12 @@ func (clusterHandler *TencentClusterHandler) RemoveNodeGroup(clusterIID irs.IID,
 		err := fmt.Errorf("Failed to Delete NodeGroup:  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return false, err
 	}
 	cblogger.Debug(res.ToJsonString())
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	return true, nil
@@ -370,10 +389,12 @@ func (clusterHandler *TencentClusterHandler) UpgradeCluster(clusterIID irs.IID,
 		err := fmt.Errorf("Failed to Upgrade Cluster:  %v", err)
 		cblogger.Error(err)
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		calllogger.Error(call.String(callLogInfo))
 		return irs.ClusterInfo{}, err
 	}
 	cblogger.Debug(res.ToJsonString())
+	call.Observe(callLogInfo)
 	calllogger.Info(call.String(callLogInfo))
 
 	clusterInfo, err := getClusterInfo(clusterHandler.CredentialInfo.ClientId, clusterHandler.CredentialInfo.ClientSecret, clusterHandler.RegionInfo.Region, clusterIID.SystemId)
@@ -246,6 +246,7 @@ func DescribeRegions(client *cvm.Client) (*cvm.DescribeRegionsResponse, error) {
 	callLogStart := call.Start()
 	responseRegions, err := client.DescribeRegions(inputRegions)
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if _, ok := err.(*tencentError.TencentCloudSDKError); ok {
@@ -275,6 +276,7 @@ func DescribeZones(client *cvm.Client) (*cvm.DescribeZonesResponse, error) {
 	callLogStart := call.Start()
 	responseZones, err := client.DescribeZones(inputZones)
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if _, ok := err.(*tencentError.TencentCloudSDKError); ok {
@@ -313,6 +315,7 @@ func ListSubnet(client *vpc.Client, reqVpcId string) ([]irs.SubnetInfo, error){
 	callLogStart := call.Start()
 	response, err := client.DescribeSubnets(request)
 	callLogInfo.ElapsedTime = call.Elapsed(callLogStart)
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 	if err != nil {
 		cblogger.Error(err)
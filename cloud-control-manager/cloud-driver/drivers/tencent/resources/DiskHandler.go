@@ -83,6 +83,7 @@ func (DiskHandler *TencentDiskHandler) CreateDisk(diskReqInfo irs.DiskInfo) (irs
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	newDiskId := *response.Response.DiskIdSet[0]
@@ -110,6 +111,7 @@ func (DiskHandler *TencentDiskHandler) ListDisk() ([]*irs.DiskInfo, error) {
 		LoggingError(hiscallInfo, err)
 		return nil, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	for _, disk := range diskSet {
@@ -136,6 +138,7 @@ func (DiskHandler *TencentDiskHandler) GetDisk(diskIID irs.IID) (irs.DiskInfo, e
 		LoggingError(hiscallInfo, err)
 		return irs.DiskInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	diskInfo, diskInfoErr := convertDiskInfo(&targetDisk)
@@ -179,6 +182,7 @@ func (DiskHandler *TencentDiskHandler) ChangeDiskSize(diskIID irs.IID, size stri
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	return true, nil
@@ -199,6 +203,7 @@ func (DiskHandler *TencentDiskHandler) DeleteDisk(diskIID irs.IID) (bool, error)
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	return true, nil
@@ -215,6 +220,7 @@ func (DiskHandler *TencentDiskHandler) AttachDisk(diskIID irs.IID, ownerVM irs.I
 		LoggingError(hiscallInfo, attachErr)
 		return irs.DiskInfo{}, attachErr
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	_, statusErr := WaitForDone(DiskHandler.Client, irs.IID{SystemId: diskIID.SystemId}, Disk_Status_Attached)
@@ -246,6 +252,7 @@ func (DiskHandler *TencentDiskHandler) DetachDisk(diskIID irs.IID, ownerVM irs.I
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	_, statusErr := WaitForDone(DiskHandler.Client, irs.IID{SystemId: diskIID.SystemId}, Disk_Status_Unattached)
@@ -60,6 +60,7 @@ func (imageHandler *TencentImageHandler) CreateImage(imageReqInfo irs.ImageReqIn
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -67,6 +68,7 @@ func (imageHandler *TencentImageHandler) CreateImage(imageReqInfo irs.ImageReqIn
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	// imageInfo := irs.ImageInfo{
@@ -107,6 +109,7 @@ func (imageHandler *TencentImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -114,6 +117,7 @@ func (imageHandler *TencentImageHandler) ListImage() ([]*irs.ImageInfo, error) {
 	}
 	//cblogger.Debug(response)
 	//cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	//cnt := 0
@@ -175,6 +179,7 @@ func (imageHandler *TencentImageHandler) GetImage(imageIID irs.IID) (irs.ImageIn
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -183,6 +188,7 @@ func (imageHandler *TencentImageHandler) GetImage(imageIID irs.IID) (irs.ImageIn
 
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if *response.Response.TotalCount > 0 {
@@ -217,6 +223,7 @@ func (imageHandler *TencentImageHandler) DeleteImage(imageIID irs.IID) (bool, er
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -224,6 +231,7 @@ func (imageHandler *TencentImageHandler) DeleteImage(imageIID irs.IID) (bool, er
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return true, nil
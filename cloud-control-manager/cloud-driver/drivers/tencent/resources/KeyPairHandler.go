@@ -40,6 +40,7 @@ func (keyPairHandler *TencentKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, erro
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -47,6 +48,7 @@ func (keyPairHandler *TencentKeyPairHandler) ListKey() ([]*irs.KeyPairInfo, erro
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	for _, pair := range response.Response.KeyPairSet {
@@ -184,6 +186,7 @@ func (keyPairHandler *TencentKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPai
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -191,6 +194,7 @@ func (keyPairHandler *TencentKeyPairHandler) CreateKey(keyPairReqInfo irs.KeyPai
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Infof("Created [%s]key pair", *response.Response.KeyPair.KeyName)
@@ -297,6 +301,7 @@ func (keyPairHandler *TencentKeyPairHandler) GetKey(keyIID irs.IID) (irs.KeyPair
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -304,6 +309,7 @@ func (keyPairHandler *TencentKeyPairHandler) GetKey(keyIID irs.IID) (irs.KeyPair
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if *response.Response.TotalCount > 0 {
@@ -372,6 +378,7 @@ func (keyPairHandler *TencentKeyPairHandler) DeleteKey(keyIID irs.IID) (bool, er
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -379,6 +386,7 @@ func (keyPairHandler *TencentKeyPairHandler) DeleteKey(keyIID irs.IID) (bool, er
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	/* 2021-10-27 이슈#480에 의해 Local Key 로직 제거
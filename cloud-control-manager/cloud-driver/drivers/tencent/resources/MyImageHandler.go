@@ -120,6 +120,7 @@ func (myImageHandler *TencentMyImageHandler) SnapshotVM(snapshotReqInfo irs.MyIm
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	cblogger.Debug(response)
@@ -149,6 +150,7 @@ func (myImageHandler *TencentMyImageHandler) ListMyImage() ([]*irs.MyImageInfo,
 		LoggingError(hiscallInfo, err)
 		return nil, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	myImageInfoList := []*irs.MyImageInfo{}
@@ -175,6 +177,7 @@ func (myImageHandler *TencentMyImageHandler) GetMyImage(myImageIID irs.IID) (irs
 		LoggingError(hiscallInfo, err)
 		return irs.MyImageInfo{}, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	myImageInfo, myImageInfoErr := convertImageSetToMyImageInfo(&targetImage)
@@ -237,6 +240,7 @@ func (myImageHandler *TencentMyImageHandler) DeleteMyImage(myImageIID irs.IID) (
 		LoggingError(hiscallInfo, err)
 		return false, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	requestId := response.Response.RequestId
@@ -352,6 +356,7 @@ func (myImageHandler *TencentMyImageHandler) CheckWindowsImage(myImageIID irs.II
 		LoggingError(hiscallInfo, err)
 		return isWindow, err
 	}
+	call.Observe(hiscallInfo)
 	calllogger.Info(call.String(hiscallInfo))
 
 	platform := GetOsType(resultImg)
@@ -244,6 +244,7 @@ func (NLBHandler *TencentNLBHandler) CreateNLB(nlbReqInfo irs.NLBInfo) (irs.NLBI
 		}
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("%s", nlbResponse.ToJsonString())
@@ -278,10 +279,12 @@ func (NLBHandler *TencentNLBHandler) ListNLB() ([]*irs.NLBInfo, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		cblogger.Error(err)
 		return nil, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Info("NLB 개수 : ", *response.Response.TotalCount)
@@ -332,9 +335,11 @@ func (NLBHandler *TencentNLBHandler) GetNLB(nlbIID irs.IID) (irs.NLBInfo, error)
 	if err != nil {
 		cblogger.Errorf("An API error has returned: %s", err.Error())
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		return irs.NLBInfo{}, err
 	}
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Debug("NLB Count : ", *response.Response.TotalCount)
@@ -391,10 +396,12 @@ func (NLBHandler *TencentNLBHandler) DeleteNLB(nlbIID irs.IID) (bool, error) {
 	if err != nil {
 		cblogger.Errorf("An API error has returned: %s", err.Error())
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		return false, err
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return true, nil
@@ -463,6 +470,7 @@ func (NLBHandler *TencentNLBHandler) ChangeVMGroupInfo(nlbIID irs.IID, vmGroup i
 		return irs.VMGroupInfo{}, modifyTargetErr
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	targetStatus, targetStatErr := NLBHandler.WaitForDone(*modifyTargetResponse.Response.RequestId)
@@ -542,6 +550,7 @@ func (NLBHandler *TencentNLBHandler) AddVMs(nlbIID irs.IID, vmIIDs *[]irs.IID) (
 	}
 	cblogger.Info("%s", targetResponse.ToJsonString())
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	// VM 연결되길 기다림
@@ -618,6 +627,7 @@ func (NLBHandler *TencentNLBHandler) RemoveVMs(nlbIID irs.IID, vmIIDs *[]irs.IID
 	}
 	cblogger.Info("%s", targetResponse.ToJsonString())
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return true, nil
@@ -645,10 +655,12 @@ func (NLBHandler *TencentNLBHandler) GetVMGroupHealthInfo(nlbIID irs.IID) (irs.H
 	if err != nil {
 		cblogger.Errorf("An API error has returned: %s", err.Error())
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 		return irs.HealthInfo{}, err
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	vmGroup := response.Response.LoadBalancers[0].Listeners[0].Rules[0].Targets
@@ -752,6 +764,7 @@ func (NLBHandler *TencentNLBHandler) ChangeHealthCheckerInfo(nlbIID irs.IID, hea
 		return irs.HealthCheckerInfo{}, err
 	}
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	// Listener 변경을 기다림
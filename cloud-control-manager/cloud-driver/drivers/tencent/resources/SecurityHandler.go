@@ -98,6 +98,7 @@ func (securityHandler *TencentSecurityHandler) CreateSecurity(securityReqInfo ir
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -107,6 +108,7 @@ func (securityHandler *TencentSecurityHandler) CreateSecurity(securityReqInfo ir
 
 	//cblogger.Debug(defaultEgressResponse)
 	cblogger.Debug(defaultEgressResponse.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	cblogger.Debug("Security Policy Processing")
@@ -147,6 +149,7 @@ func (securityHandler *TencentSecurityHandler) CreateSecurity(securityReqInfo ir
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -156,6 +159,7 @@ func (securityHandler *TencentSecurityHandler) CreateSecurity(securityReqInfo ir
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
 
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	securityInfo, errSecurity := securityHandler.GetSecurity(irs.IID{SystemId: *defaultEgressResponse.Response.SecurityGroup.SecurityGroupId})
@@ -221,6 +225,7 @@ func (securityHandler *TencentSecurityHandler) ListSecurity() ([]*irs.SecurityIn
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -228,6 +233,7 @@ func (securityHandler *TencentSecurityHandler) ListSecurity() ([]*irs.SecurityIn
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var results []*irs.SecurityInfo
@@ -292,6 +298,7 @@ func (securityHandler *TencentSecurityHandler) GetSecurity(securityIID irs.IID)
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -299,6 +306,7 @@ func (securityHandler *TencentSecurityHandler) GetSecurity(securityIID irs.IID)
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if *response.Response.TotalCount > 0 {
@@ -429,6 +437,7 @@ func (securityHandler *TencentSecurityHandler) DeleteSecurity(securityIID irs.II
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -436,6 +445,7 @@ func (securityHandler *TencentSecurityHandler) DeleteSecurity(securityIID irs.II
 	}
 	//cblogger.Debug(response)
 	cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	return true, nil
@@ -526,6 +536,7 @@ func (securityHandler *TencentSecurityHandler) AddRules(securityIID irs.IID, req
 
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 
 			cblogger.Error(err)
@@ -533,6 +544,7 @@ func (securityHandler *TencentSecurityHandler) AddRules(securityIID irs.IID, req
 		}
 		//cblogger.Debug(response)
 		cblogger.Debug(ingressResponse.ToJsonString())
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 	}
 
@@ -548,6 +560,7 @@ func (securityHandler *TencentSecurityHandler) AddRules(securityIID irs.IID, req
 
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 
 			cblogger.Error(err)
@@ -555,6 +568,7 @@ func (securityHandler *TencentSecurityHandler) AddRules(securityIID irs.IID, req
 		}
 		//cblogger.Debug(response)
 		cblogger.Debug(egressResponse.ToJsonString())
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 	}
 
@@ -637,6 +651,7 @@ func (securityHandler *TencentSecurityHandler) RemoveRules(securityIID irs.IID,
 
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 
 			cblogger.Error(err)
@@ -644,6 +659,7 @@ func (securityHandler *TencentSecurityHandler) RemoveRules(securityIID irs.IID,
 		}
 		//cblogger.Debug(response)
 		cblogger.Debug(ingressResponse.ToJsonString())
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 	}
 
@@ -658,6 +674,7 @@ func (securityHandler *TencentSecurityHandler) RemoveRules(securityIID irs.IID,
 
 		if err != nil {
 			callLogInfo.ErrorMSG = err.Error()
+			call.Observe(callLogInfo)
 			callogger.Error(call.String(callLogInfo))
 
 			cblogger.Error(err)
@@ -665,6 +682,7 @@ func (securityHandler *TencentSecurityHandler) RemoveRules(securityIID irs.IID,
 		}
 		//cblogger.Debug(response)
 		cblogger.Debug(egressResponse.ToJsonString())
+		call.Observe(callLogInfo)
 		callogger.Info(call.String(callLogInfo))
 	}
 
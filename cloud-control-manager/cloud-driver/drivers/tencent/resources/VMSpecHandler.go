@@ -53,6 +53,7 @@ func (vmSpecHandler *TencentVmSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, erro
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -61,6 +62,7 @@ func (vmSpecHandler *TencentVmSpecHandler) ListVMSpec() ([]*irs.VMSpecInfo, erro
 
 	//cblogger.Debug(response)
 	//cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	var vmSpecInfoList []*irs.VMSpecInfo
@@ -116,6 +118,7 @@ func (vmSpecHandler *TencentVmSpecHandler) GetVMSpec(Name string) (irs.VMSpecInf
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -124,6 +127,7 @@ func (vmSpecHandler *TencentVmSpecHandler) GetVMSpec(Name string) (irs.VMSpecInf
 
 	//cblogger.Debug(response)
 	//cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if len(response.Response.InstanceTypeConfigSet) > 0 {
@@ -170,6 +174,7 @@ func (vmSpecHandler *TencentVmSpecHandler) ListOrgVMSpec() (string, error) {
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -178,6 +183,7 @@ func (vmSpecHandler *TencentVmSpecHandler) ListOrgVMSpec() (string, error) {
 
 	//cblogger.Debug(response)
 	// cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	jsonString, errJson := ConvertJsonString(response.Response.InstanceTypeConfigSet)
@@ -229,6 +235,7 @@ func (vmSpecHandler *TencentVmSpecHandler) GetOrgVMSpec(Name string) (string, er
 
 	if err != nil {
 		callLogInfo.ErrorMSG = err.Error()
+		call.Observe(callLogInfo)
 		callogger.Error(call.String(callLogInfo))
 
 		cblogger.Error(err)
@@ -237,6 +244,7 @@ func (vmSpecHandler *TencentVmSpecHandler) GetOrgVMSpec(Name string) (string, er
 
 	//cblogger.Debug(response)
 	//cblogger.Debug(response.ToJsonString())
+	call.Observe(callLogInfo)
 	callogger.Info(call.String(callLogInfo))
 
 	if len(response.Response.InstanceTypeConfigSet) > 0 {
@@ -37,6 +37,9 @@ type DriverCapabilityInfo struct {
 	VPC_CIDR          bool // support: true, do not support: false
 	SINGLE_VPC        bool // support: true, do not support: false
 	FIXED_SUBNET_CIDR bool // support: true, do not support: false
+
+	SG_PEER_SECURITY_GROUP bool // support: true, do not support: false, SG rules with a source/target SG
+	IPV6_DUAL_STACK        bool // support: true, do not support: false, IPv6 CIDR of VPC/Subnet/SG rules and IPv6 addresses
 }
 
 type CredentialInfo struct {
@@ -21,6 +21,14 @@ type ImageInfo struct {
 	GuestOS string // Windows7, Ubuntu etc.
 	Status  string // available, unavailable
 
+	// normalized attributes for image search, "" if unknown
+	OSDistribution    string // lower case, ex) ubuntu, centos, rhel, rocky, debian, amazon, windows
+	OSVersion         string // ex) 22.04, 8, 2019
+	OSArchitecture    string // x86_64, arm64, i386
+	OSPlatform        string // Linux/UNIX, Windows
+	RootDiskMinSizeGB string // ex) 8
+	GPUReady          bool   // true if GPU drivers are pre-installed
+
 	TagList      []KeyValue
 	KeyValueList []KeyValue
 }
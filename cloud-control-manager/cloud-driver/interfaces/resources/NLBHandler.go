@@ -35,6 +35,7 @@ type NLBInfo struct {
 type ListenerInfo struct {
 	Protocol string // TCP|UDP
 	IP       string // Auto Generated and attached
+	IPv6     string // Optional, Auto Generated and attached for dual-stack NLB
 	Port     string // 1-65535
 	DNSName  string // Optional, Auto Generated and attached
 
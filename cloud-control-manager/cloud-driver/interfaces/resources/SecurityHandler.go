@@ -28,6 +28,16 @@ type SecurityRuleInfo struct {
 	FromPort   string
 	ToPort     string
 	CIDR       string
+
+	// Peer Security Group: source SG for inbound, target SG for outbound.
+	// Exclusive with CIDR. Spider passes {NameId, SystemId} of CSP's SG to the driver.
+	PeerSecurityGroupIID *IID `json:",omitempty"`
+
+	// only for ICMP, ex) ICMPType: "8", ICMPCode: "0" => echo request; "" or "-1" => all
+	ICMPType string `json:",omitempty"`
+	ICMPCode string `json:",omitempty"`
+
+	Description string `json:",omitempty"`
 }
 
 type SecurityInfo struct {
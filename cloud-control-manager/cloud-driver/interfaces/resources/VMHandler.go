@@ -114,6 +114,8 @@ type VMInfo struct {
 	PublicDNS        string
 	PrivateIP        string
 	PrivateDNS       string
+	PublicIPv6       string // "" if no IPv6 address
+	PrivateIPv6      string // "" if no IPv6 address
 
 	Platform Platform // LINUX | WINDOWS
 
@@ -138,3 +140,9 @@ type VMHandler interface {
 	ListVM() ([]*VMInfo, error)
 	GetVM(vmIID IID) (VMInfo, error)
 }
+
+// VMReqValidator is an optional interface of VMHandler for the dry-run.
+// The driver validates the request with the CSP without creating the VM, ex) AWS RunInstances(DryRun).
+type VMReqValidator interface {
+	ValidateVM(vmReqInfo VMReqInfo) error
+}
@@ -15,6 +15,7 @@ package resources
 type VPCReqInfo struct {
 	IId            IID // {NameId, SystemId}
 	IPv4_CIDR      string
+	IPv6_CIDR      string // Optional, "": IPv4 only, "auto": assigned by CSP, ex) "2001:db8:1234::/56"
 	SubnetInfoList []SubnetInfo
 
 	TagList []KeyValue
@@ -23,6 +24,7 @@ type VPCReqInfo struct {
 type VPCInfo struct {
 	IId            IID // {NameId, SystemId}
 	IPv4_CIDR      string
+	IPv6_CIDR      string // "" if IPv4 only
 	SubnetInfoList []SubnetInfo
 
 	TagList []KeyValue
@@ -33,6 +35,7 @@ type SubnetInfo struct {
 	IId       IID    // {NameId, SystemId}
 	Zone      string // Target Zone Name
 	IPv4_CIDR string
+	IPv6_CIDR string // Optional, "": IPv4 only, "auto": a /64 of the VPC IPv6 CIDR, ex) "2001:db8:1234:1a00::/64"
 
 	TagList []KeyValue
 	KeyValueList []KeyValue
@@ -11,6 +11,7 @@ package connectionconfiginfomanager
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	cblogger "github.com/cloud-barista/cb-log"
 	"github.com/sirupsen/logrus"
@@ -33,6 +34,14 @@ type ConnectionConfigInfo struct {
 
 var cblog *logrus.Logger
 
+// ChangeHandlerFunc is called with the config name after a connection config is created, updated or deleted.
+type ChangeHandlerFunc func(configName string)
+
+var (
+	changeHandlerMutex sync.Mutex
+	changeHandlerList  []ChangeHandlerFunc
+)
+
 func init() {
 	cblog = cblogger.GetLogger("CLOUD-BARISTA")
 
@@ -72,6 +81,8 @@ func CreateConnectionConfigInfo(configInfo ConnectionConfigInfo) (*ConnectionCon
 		return nil, err
 	}
 
+	notifyChange(configInfo.ConfigName)
+
 	return &configInfo, nil
 }
 
@@ -125,10 +136,29 @@ func DeleteConnectionConfig(configName string) (bool, error) {
 		cblog.Error(err)
 		return false, err
 	}
+	notifyChange(configName)
 
 	return result, nil
 }
 
+// AddChangeHandler adds a handler of the connection config changes,
+// ex) to drop the state cached by the connection.
+func AddChangeHandler(handler ChangeHandlerFunc) {
+	changeHandlerMutex.Lock()
+	defer changeHandlerMutex.Unlock()
+	changeHandlerList = append(changeHandlerList, handler)
+}
+
+func notifyChange(configName string) {
+	changeHandlerMutex.Lock()
+	handlerList := changeHandlerList
+	changeHandlerMutex.Unlock()
+
+	for _, handler := range handlerList {
+		handler(configName)
+	}
+}
+
 //----------------
 
 func checkParams(configName string, providerName string, driverName string, credentialName string, regionName string) error {
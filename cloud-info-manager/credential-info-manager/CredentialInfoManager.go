@@ -279,15 +279,11 @@ func checkParams(credentialName string, providerName string, keyValueInfoList []
 	return nil
 }
 
-// #######################################################################
-// @todo get from Env file. by powerkim, 2020.06.01.
-var SPIDER_KEY = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
-//#######################################################################
-
+// encrypted with the active key of the key ring, ref) EncryptionKeyManager.go
 func encryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 
 	for i, kv := range keyValueInfoList {
-		encString, err := Encrypt(SPIDER_KEY, []byte(kv.Value))
+		encString, err := EncryptString(kv.Value)
 		if err != nil {
 			return err
 		}
@@ -300,7 +296,7 @@ func encryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 func decryptKeyValueList(keyValueInfoList []icdrs.KeyValue) error {
 
 	for i, kv := range keyValueInfoList {
-		decString, err := Decrypt(SPIDER_KEY, []byte(kv.Value))
+		decString, err := DecryptString(kv.Value)
 		if err != nil {
 			return err
 		}
@@ -337,6 +333,9 @@ func Decrypt(spider_key, contents []byte) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if len(ciphertext) < aes.BlockSize {
+		return "", fmt.Errorf("the encrypted value is too short")
+	}
 
 	block, err := aes.NewCipher(spider_key)
 	if err != nil {
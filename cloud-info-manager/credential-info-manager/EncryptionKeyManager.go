@@ -0,0 +1,522 @@
+// Credential Encryption Key Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package credentialinfomanager
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	icdrs "github.com/cloud-barista/cb-spider/cloud-control-manager/cloud-driver/interfaces/resources"
+	infostore "github.com/cloud-barista/cb-spider/info-store"
+)
+
+//================ Encryption Key Management
+// The credentials are encrypted with the active key of the key ring, and each encrypted value
+// is tagged with the ID of its key, ex) $v2$<base64 of iv+ciphertext>.
+// The untagged values are the ones encrypted with the legacy built-in key(SPIDER_KEY).
+// The keys are loaded by InitEncryptionKeys() at the server start, and the server does not start
+// without a configured key unless the legacy built-in key is allowed explicitly.
+// The server creates the key file with a new key(v1) when the file does not exist, and the values
+// encrypted by the previous versions are still decrypted with the legacy key until the rotation.
+// Outside the server, ex) tests and tools, the keys are loaded at the first use,
+// and the legacy built-in key is used when no key is configured.
+//
+// env)
+//	SPIDER_ENCRYPTION_KEY_FILE : key file with a '<key ID>=<base64 of 32 bytes key>' per line, '#' for comments
+//	                             ex) $CBSPIDER_ROOT/meta_db/encryption.key, reloaded when changed
+//	SPIDER_ENCRYPTION_KEYS     : keys with the same format separated by ',', ex) v1=xxx,v2=yyy
+//	SPIDER_ENCRYPTION_KEY_ID   : ID of the active key (default: the last key of the file or the env)
+//	SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY : true to encrypt with the legacy built-in key when no key is configured (default: false)
+//
+// Rotation)
+//	(1) append a new key to the key file, ex) v2=$(head -c 32 /dev/urandom | base64)
+//	(2) call RotateEncryptionKey(), ex) POST /spider/encryptionkey/rotate
+//	    all the stored values are re-encrypted with the new active key while the server is running.
+//	(3) remove the old key from the key file after the rotation.
+
+const (
+	LEGACY_KEY_ID           = "legacy"
+	FIRST_KEY_ID            = "v1" // ID of the key of the created key file
+	ENCRYPTION_KEY_SIZE     = 32   // AES-256
+	encryptionKeyTagPrefix  = "$"
+	encryptionKeyReloadTime = 10 * time.Second
+)
+
+// legacy built-in key, used only when no key is configured and to decrypt the untagged values
+var SPIDER_KEY = []byte("cloud-barista-cb-spider-cloud-ba") // 32 bytes
+
+var encryptionKeyIdRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+type encryptionKeyRing struct {
+	activeKeyId string
+	keyMap      map[string][]byte // key ID => key
+	keyIdList   []string          // in the configured order
+}
+
+var (
+	keyRingMutex    sync.RWMutex
+	keyRing         *encryptionKeyRing // nil before InitEncryptionKeys() or the first use
+	keyFileModTime  time.Time
+	reEncryptorList []reEncryptor
+	keyWatchOnce    sync.Once
+)
+
+// reEncryptor is a store of the values encrypted with the key ring
+type reEncryptor struct {
+	name          string
+	reEncrypt     ReEncryptFunc
+	listEncrypted ListEncryptedFunc
+}
+
+// ReEncryptFunc re-encrypts the values of a store with the active key, and returns the count of the re-encrypted values.
+type ReEncryptFunc func() (int, error)
+
+// ListEncryptedFunc returns the encrypted values of a store, to count the values of each key.
+type ListEncryptedFunc func() ([]string, error)
+
+func init() {
+	AddReEncryptor("credential", reEncryptCredentials, listEncryptedCredentials)
+}
+
+// InitEncryptionKeys loads the key ring at the server start, and watches the key file.
+// It creates the key file with a new key when the file does not exist.
+// It fails without a configured key, unless SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY is true.
+func InitEncryptionKeys() error {
+	if err := createEncryptionKeyFile(os.ExpandEnv(os.Getenv("SPIDER_ENCRYPTION_KEY_FILE"))); err != nil {
+		return fmt.Errorf("failed to create the encryption key file: %v", err)
+	}
+	if err := ReloadEncryptionKeys(); err != nil {
+		return fmt.Errorf("failed to load the encryption keys: %v", err)
+	}
+	if os.Getenv("SPIDER_ENCRYPTION_KEY_FILE") != "" {
+		keyWatchOnce.Do(func() { go watchEncryptionKeyFile() })
+	}
+	return nil
+}
+
+// createEncryptionKeyFile writes a new key(v1) to the key file when it does not exist
+func createEncryptionKeyFile(keyFile string) error {
+	if keyFile == "" {
+		return nil
+	}
+	if _, err := os.Stat(keyFile); !os.IsNotExist(err) {
+		return err
+	}
+
+	key := make([]byte, ENCRYPTION_KEY_SIZE)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(keyFile), 0700); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(keyFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := fmt.Fprintf(file, "# credential encryption keys of CB-Spider, keep this file with the meta DB\n%s=%s\n",
+		FIRST_KEY_ID, base64.StdEncoding.EncodeToString(key)); err != nil {
+		return err
+	}
+	cblog.Infof("the encryption key file '%s' is created with the key '%s'", keyFile, FIRST_KEY_ID)
+	return nil
+}
+
+// ReloadEncryptionKeys loads the key ring from the key file and the env again.
+func ReloadEncryptionKeys() error {
+	keyFile := os.ExpandEnv(os.Getenv("SPIDER_ENCRYPTION_KEY_FILE"))
+	modTime := getKeyFileModTime(keyFile)
+
+	ring, err := loadEncryptionKeyRing(keyFile)
+	if err != nil {
+		cblog.Error(err)
+		return err
+	}
+	if ring.activeKeyId == LEGACY_KEY_ID && len(ring.keyIdList) == 1 {
+		if !strings.EqualFold(os.Getenv("SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY"), "true") {
+			err := fmt.Errorf("no encryption key is configured! Set SPIDER_ENCRYPTION_KEY_FILE or SPIDER_ENCRYPTION_KEYS, " +
+				"or SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY=true to use the legacy built-in key.")
+			cblog.Error(err)
+			return err
+		}
+		cblog.Warn("no encryption key is configured, the credentials are encrypted with the legacy built-in key.")
+	}
+
+	keyRingMutex.Lock()
+	defer keyRingMutex.Unlock()
+	keyRing = ring
+	keyFileModTime = modTime
+	return nil
+}
+
+func loadEncryptionKeyRing(keyFile string) (*encryptionKeyRing, error) {
+	ring := &encryptionKeyRing{keyMap: map[string][]byte{}}
+
+	var entryList []string
+	if keyFile != "" {
+		contents, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the encryption key file: %v", err)
+		}
+		entryList = append(entryList, strings.Split(string(contents), "\n")...)
+	}
+	if keys := os.Getenv("SPIDER_ENCRYPTION_KEYS"); keys != "" {
+		entryList = append(entryList, strings.Split(keys, ",")...)
+	}
+
+	for _, entry := range entryList {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		keyId, encodedKey, ok := strings.Cut(entry, "=")
+		keyId = strings.TrimSpace(keyId)
+		if !ok || !encryptionKeyIdRegexp.MatchString(keyId) {
+			return nil, fmt.Errorf("invalid encryption key entry of the key ID '%s', use '<key ID>=<base64 key>' with the key ID of [A-Za-z0-9_.-]", keyId)
+		}
+		if keyId == LEGACY_KEY_ID {
+			return nil, fmt.Errorf("the key ID '%s' is reserved for the legacy built-in key", LEGACY_KEY_ID)
+		}
+		if _, exists := ring.keyMap[keyId]; exists {
+			return nil, fmt.Errorf("the encryption key ID '%s' is duplicated", keyId)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+		if err != nil || len(key) != ENCRYPTION_KEY_SIZE {
+			return nil, fmt.Errorf("the encryption key '%s' should be the base64 of %d bytes", keyId, ENCRYPTION_KEY_SIZE)
+		}
+		ring.keyMap[keyId] = key
+		ring.keyIdList = append(ring.keyIdList, keyId)
+	}
+
+	// the legacy key is always kept to decrypt the untagged values
+	ring.keyMap[LEGACY_KEY_ID] = SPIDER_KEY
+	ring.keyIdList = append(ring.keyIdList, LEGACY_KEY_ID)
+
+	ring.activeKeyId = os.Getenv("SPIDER_ENCRYPTION_KEY_ID")
+	if ring.activeKeyId == "" {
+		if len(ring.keyIdList) > 1 {
+			ring.activeKeyId = ring.keyIdList[len(ring.keyIdList)-2]
+		} else {
+			ring.activeKeyId = LEGACY_KEY_ID
+		}
+	}
+	if _, exists := ring.keyMap[ring.activeKeyId]; !exists {
+		return nil, fmt.Errorf("the active encryption key '%s' of SPIDER_ENCRYPTION_KEY_ID does not exist!", ring.activeKeyId)
+	}
+	return ring, nil
+}
+
+// watchEncryptionKeyFile loads the key ring again when the key file is changed
+func watchEncryptionKeyFile() {
+	ticker := time.NewTicker(encryptionKeyReloadTime)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		keyRingMutex.RLock()
+		changed := !getKeyFileModTime(os.ExpandEnv(os.Getenv("SPIDER_ENCRYPTION_KEY_FILE"))).Equal(keyFileModTime)
+		keyRingMutex.RUnlock()
+		if !changed {
+			continue
+		}
+		// keep the current keys when the changed file is invalid
+		if err := ReloadEncryptionKeys(); err != nil {
+			continue
+		}
+		cblog.Infof("the encryption keys are reloaded, the active key is '%s'", GetActiveEncryptionKeyId())
+	}
+}
+
+func getKeyFileModTime(file string) time.Time {
+	if file == "" {
+		return time.Time{}
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// getKeyRing returns the loaded key ring, and loads it at the first use before InitEncryptionKeys(),
+// ex) tests and tools using the stores without the server.
+func getKeyRing() (*encryptionKeyRing, error) {
+	keyRingMutex.RLock()
+	ring := keyRing
+	keyRingMutex.RUnlock()
+	if ring != nil {
+		return ring, nil
+	}
+
+	keyRingMutex.Lock()
+	defer keyRingMutex.Unlock()
+	if keyRing == nil {
+		keyFile := os.ExpandEnv(os.Getenv("SPIDER_ENCRYPTION_KEY_FILE"))
+		ring, err := loadEncryptionKeyRing(keyFile)
+		if err != nil {
+			cblog.Error(err)
+			return nil, err
+		}
+		keyRing = ring
+		keyFileModTime = getKeyFileModTime(keyFile)
+	}
+	return keyRing, nil
+}
+
+// GetActiveEncryptionKeyId returns the ID of the key encrypting the new values, ex) v2, legacy
+func GetActiveEncryptionKeyId() string {
+	ring, err := getKeyRing()
+	if err != nil {
+		return ""
+	}
+	return ring.activeKeyId
+}
+
+// ListEncryptionKeyId returns the IDs of the loaded keys, not the keys.
+func ListEncryptionKeyId() []string {
+	ring, err := getKeyRing()
+	if err != nil {
+		return []string{}
+	}
+	return append([]string{}, ring.keyIdList...)
+}
+
+//================ Encryption with Key ID
+
+// EncryptString encrypts the contents with the active key, ex) $v2$<base64 of iv+ciphertext>
+func EncryptString(contents string) (string, error) {
+	ring, err := getKeyRing()
+	if err != nil {
+		return "", err
+	}
+	keyId, key := ring.activeKeyId, ring.keyMap[ring.activeKeyId]
+
+	encString, err := Encrypt(key, []byte(contents))
+	if err != nil {
+		return "", err
+	}
+	if keyId == LEGACY_KEY_ID {
+		// keep the format readable by the previous versions
+		return encString, nil
+	}
+	return encryptionKeyTagPrefix + keyId + encryptionKeyTagPrefix + encString, nil
+}
+
+// DecryptString decrypts the value with the key of its key ID, or with the legacy key when untagged.
+func DecryptString(value string) (string, error) {
+	keyId, encString := parseEncryptedValue(value)
+
+	ring, err := getKeyRing()
+	if err != nil {
+		return "", err
+	}
+	key, exists := ring.keyMap[keyId]
+	if !exists {
+		return "", fmt.Errorf("the encryption key '%s' does not exist!", keyId)
+	}
+	return Decrypt(key, []byte(encString))
+}
+
+// parseEncryptedValue returns the key ID and the encrypted string of the value.
+// '$' is not in the base64 alphabet, so the untagged value is the legacy one.
+func parseEncryptedValue(value string) (string, string) {
+	if !strings.HasPrefix(value, encryptionKeyTagPrefix) {
+		return LEGACY_KEY_ID, value
+	}
+	keyId, encString, ok := strings.Cut(strings.TrimPrefix(value, encryptionKeyTagPrefix), encryptionKeyTagPrefix)
+	if !ok {
+		return LEGACY_KEY_ID, value
+	}
+	return keyId, encString
+}
+
+// ReEncryptString returns the value encrypted with the active key, and false when it is already.
+func ReEncryptString(value string) (string, bool, error) {
+	keyId, _ := parseEncryptedValue(value)
+	if keyId == GetActiveEncryptionKeyId() {
+		return value, false, nil
+	}
+
+	decString, err := DecryptString(value)
+	if err != nil {
+		return "", false, err
+	}
+	encString, err := EncryptString(decString)
+	if err != nil {
+		return "", false, err
+	}
+	return encString, true, nil
+}
+
+//================ Key Rotation
+
+// AddReEncryptor adds a store encrypted with the key ring for the rotation and the status,
+// ex) the private keys of the drivers.
+func AddReEncryptor(name string, reEncrypt ReEncryptFunc, listEncrypted ListEncryptedFunc) {
+	keyRingMutex.Lock()
+	defer keyRingMutex.Unlock()
+	reEncryptorList = append(reEncryptorList, reEncryptor{name: name, reEncrypt: reEncrypt, listEncrypted: listEncrypted})
+}
+
+func getReEncryptorList() []reEncryptor {
+	keyRingMutex.RLock()
+	defer keyRingMutex.RUnlock()
+	return append([]reEncryptor{}, reEncryptorList...)
+}
+
+// EncryptionKeyStatus is the key IDs of the key ring and the count of the stored values of each key.
+type EncryptionKeyStatus struct {
+	ActiveKeyId   string
+	KeyIdList     []string
+	StoreKeyCount []StoreKeyCountInfo // the values to re-encrypt remain with other than the active key
+}
+
+// StoreKeyCountInfo is the count of the values of each key in a store.
+type StoreKeyCountInfo struct {
+	StoreName string           // ex) credential, privatekey, idempotency
+	KeyCount  []icdrs.KeyValue // ex) {v1, 3}, {v2, 10}
+}
+
+// RotationResult is the count of the values re-encrypted with the active key.
+type RotationResult struct {
+	ActiveKeyId     string
+	ReEncryptedList []icdrs.KeyValue // ex) {credential, 12}, {privatekey, 3}
+}
+
+// GetEncryptionKeyStatus returns the key IDs and the key IDs used by the values of each store.
+func GetEncryptionKeyStatus() (*EncryptionKeyStatus, error) {
+	cblog.Info("call GetEncryptionKeyStatus()")
+
+	status := &EncryptionKeyStatus{
+		ActiveKeyId:   GetActiveEncryptionKeyId(),
+		KeyIdList:     ListEncryptionKeyId(),
+		StoreKeyCount: []StoreKeyCountInfo{},
+	}
+	for _, re := range getReEncryptorList() {
+		valueList, err := re.listEncrypted()
+		if err != nil {
+			cblog.Error(err)
+			return nil, fmt.Errorf("failed to list the %s: %v", re.name, err)
+		}
+		status.StoreKeyCount = append(status.StoreKeyCount, StoreKeyCountInfo{StoreName: re.name, KeyCount: countEncryptionKeyId(valueList)})
+	}
+	return status, nil
+}
+
+// countEncryptionKeyId returns the count of the values of each key ID, sorted by the key ID
+func countEncryptionKeyId(valueList []string) []icdrs.KeyValue {
+	countMap := map[string]int{}
+	for _, value := range valueList {
+		keyId, _ := parseEncryptedValue(value)
+		countMap[keyId]++
+	}
+	keyIdList := make([]string, 0, len(countMap))
+	for keyId := range countMap {
+		keyIdList = append(keyIdList, keyId)
+	}
+	sort.Strings(keyIdList)
+
+	keyCountList := []icdrs.KeyValue{}
+	for _, keyId := range keyIdList {
+		keyCountList = append(keyCountList, icdrs.KeyValue{Key: keyId, Value: fmt.Sprint(countMap[keyId])})
+	}
+	return keyCountList
+}
+
+// RotateEncryptionKey reloads the key ring and re-encrypts all the stored values with the active key.
+// The server keeps serving during the rotation, the values encrypted with the old keys are still decrypted.
+func RotateEncryptionKey() (*RotationResult, error) {
+	cblog.Info("call RotateEncryptionKey()")
+
+	if err := ReloadEncryptionKeys(); err != nil {
+		return nil, err
+	}
+
+	result := &RotationResult{ActiveKeyId: GetActiveEncryptionKeyId()}
+
+	for _, re := range getReEncryptorList() {
+		count, err := re.reEncrypt()
+		if err != nil {
+			cblog.Error(err)
+			return nil, fmt.Errorf("failed to re-encrypt the %s: %v", re.name, err)
+		}
+		result.ReEncryptedList = append(result.ReEncryptedList, icdrs.KeyValue{Key: re.name, Value: fmt.Sprint(count)})
+	}
+
+	cblog.Infof("the stored values are re-encrypted with the key '%s': %v", result.ActiveKeyId, result.ReEncryptedList)
+	return result, nil
+}
+
+// listEncryptedCredentials returns the encrypted values of the credentials
+func listEncryptedCredentials() ([]string, error) {
+	var credentialInfoList []*CredentialInfo
+	err := infostore.List(&credentialInfoList)
+	if err != nil {
+		return nil, err
+	}
+
+	valueList := []string{}
+	for _, credentialInfo := range credentialInfoList {
+		for _, kv := range credentialInfo.KeyValueInfoList {
+			valueList = append(valueList, kv.Value)
+		}
+	}
+	return valueList, nil
+}
+
+// reEncryptCredentials re-encrypts the credentials not encrypted with the active key.
+// A credential is updated only when it is not changed during the re-encryption,
+// not to overwrite a credential re-registered or deleted by a concurrent call.
+func reEncryptCredentials() (int, error) {
+	var credentialInfoList []*CredentialInfo
+	err := infostore.List(&credentialInfoList)
+	if err != nil {
+		return 0, err
+	}
+
+	db, err := infostore.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer infostore.Close(db)
+
+	count := 0
+	for _, credentialInfo := range credentialInfoList {
+		newKvList := make(infostore.KVList, len(credentialInfo.KeyValueInfoList))
+		changed := false
+		for i, kv := range credentialInfo.KeyValueInfoList {
+			newValue, reEncrypted, err := ReEncryptString(kv.Value)
+			if err != nil {
+				return count, fmt.Errorf("failed to re-encrypt the credential '%s': %v", credentialInfo.CredentialName, err)
+			}
+			newKvList[i] = icdrs.KeyValue{Key: kv.Key, Value: newValue}
+			changed = changed || reEncrypted
+		}
+		if !changed {
+			continue
+		}
+
+		ret := db.Model(&CredentialInfo{}).
+			Where(KEY_COLUMN_NAME+" = ? AND key_value_info_list = ?", credentialInfo.CredentialName, credentialInfo.KeyValueInfoList).
+			Update("key_value_info_list", newKvList)
+		if ret.Error != nil {
+			return count, ret.Error
+		}
+		count += int(ret.RowsAffected)
+	}
+	return count, nil
+}
@@ -0,0 +1,173 @@
+// Test for Credential Encryption Key Manager of CB-Spider.
+// The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+// The CB-Spider Mission is to connect all the clouds with a single interface.
+//
+//      * Cloud-Barista: https://github.com/cloud-barista
+//
+// by CB-Spider Team, 2024.
+
+package encryptionkeytest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	cim "github.com/cloud-barista/cb-spider/cloud-info-manager/credential-info-manager"
+)
+
+var (
+	testKeyV1 = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, cim.ENCRYPTION_KEY_SIZE))
+	testKeyV2 = base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, cim.ENCRYPTION_KEY_SIZE))
+)
+
+// setTestEncryptionKeys loads the keys of the env
+func setTestEncryptionKeys(t *testing.T, keys string, activeKeyId string) error {
+	t.Setenv("SPIDER_ENCRYPTION_KEY_FILE", "")
+	t.Setenv("SPIDER_ENCRYPTION_KEYS", keys)
+	t.Setenv("SPIDER_ENCRYPTION_KEY_ID", activeKeyId)
+	return cim.InitEncryptionKeys()
+}
+
+// outside the server, the keys are loaded at the first use with the legacy key as the default.
+// The key ring is loaded once, so this test runs first.
+func TestKeyRingAtFirstUse(t *testing.T) {
+	t.Setenv("SPIDER_ENCRYPTION_KEY_FILE", "")
+	t.Setenv("SPIDER_ENCRYPTION_KEYS", "")
+	t.Setenv("SPIDER_ENCRYPTION_KEY_ID", "")
+	t.Setenv("SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY", "")
+
+	encString, err := cim.EncryptString("secret-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cim.GetActiveEncryptionKeyId() != cim.LEGACY_KEY_ID || strings.HasPrefix(encString, "$") {
+		t.Errorf("the active key = %s, the value = %s, want the legacy key", cim.GetActiveEncryptionKeyId(), encString)
+	}
+	if decString, err := cim.DecryptString(encString); err != nil || decString != "secret-01" {
+		t.Errorf("DecryptString() = (%s, %v), want secret-01", decString, err)
+	}
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	encString, err := cim.Encrypt(cim.SPIDER_KEY, []byte("secret-01"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decString, err := cim.Decrypt(cim.SPIDER_KEY, []byte(encString)); err != nil || decString != "secret-01" {
+		t.Errorf("Decrypt() = (%s, %v), want secret-01", decString, err)
+	}
+
+	otherKey := bytes.Repeat([]byte{1}, cim.ENCRYPTION_KEY_SIZE)
+	if decString, _ := cim.Decrypt(otherKey, []byte(encString)); decString == "secret-01" {
+		t.Errorf("decrypted with another key")
+	}
+	if _, err := cim.Decrypt(cim.SPIDER_KEY, []byte(base64.StdEncoding.EncodeToString([]byte("short")))); err == nil {
+		t.Errorf("the value shorter than the IV is decrypted")
+	}
+}
+
+// the server does not start with the legacy key unless it is allowed
+func TestInitEncryptionKeysWithoutKey(t *testing.T) {
+	t.Setenv("SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY", "")
+	if err := setTestEncryptionKeys(t, "", ""); err == nil {
+		t.Errorf("initialized without a configured key")
+	}
+
+	t.Setenv("SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY", "true")
+	if err := setTestEncryptionKeys(t, "", ""); err != nil {
+		t.Fatal(err)
+	}
+	encString, err := cim.EncryptString("secret-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.HasPrefix(encString, "$") {
+		t.Errorf("the value of the legacy key is tagged: %s", encString)
+	}
+}
+
+// the server creates the key file with a new key, and keeps the key of the existing file
+func TestInitEncryptionKeysWithKeyFile(t *testing.T) {
+	keyFile := filepath.Join(t.TempDir(), "meta_db", "encryption.key")
+	t.Setenv("SPIDER_ENCRYPTION_KEY_FILE", keyFile)
+	t.Setenv("SPIDER_ENCRYPTION_KEYS", "")
+	t.Setenv("SPIDER_ENCRYPTION_KEY_ID", "")
+	t.Setenv("SPIDER_ALLOW_LEGACY_ENCRYPTION_KEY", "")
+
+	if err := cim.InitEncryptionKeys(); err != nil {
+		t.Fatal(err)
+	}
+	if cim.GetActiveEncryptionKeyId() != cim.FIRST_KEY_ID {
+		t.Fatalf("the active key = %s, want %s", cim.GetActiveEncryptionKeyId(), cim.FIRST_KEY_ID)
+	}
+	if info, err := os.Stat(keyFile); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("the key file = (%v, %v), want the mode 0600", info, err)
+	}
+	encString, err := cim.EncryptString("secret-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cim.InitEncryptionKeys(); err != nil {
+		t.Fatal(err)
+	}
+	if decString, err := cim.DecryptString(encString); err != nil || decString != "secret-01" {
+		t.Errorf("DecryptString() after the restart = (%s, %v), want secret-01", decString, err)
+	}
+}
+
+func TestEncryptStringWithKeyId(t *testing.T) {
+	if err := setTestEncryptionKeys(t, "v1="+testKeyV1+",v2="+testKeyV2, "v1"); err != nil {
+		t.Fatal(err)
+	}
+	v1Value, err := cim.EncryptString("secret-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacyValue, err := cim.Encrypt(cim.SPIDER_KEY, []byte("secret-02"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the last key becomes the active key
+	if err := setTestEncryptionKeys(t, "v1="+testKeyV1+",v2="+testKeyV2, ""); err != nil {
+		t.Fatal(err)
+	}
+	v2Value, err := cim.EncryptString("secret-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(v1Value, "$v1$") || !strings.HasPrefix(v2Value, "$v2$") {
+		t.Fatalf("values = (%s, %s), want the tags of v1 and v2", v1Value, v2Value)
+	}
+
+	for _, value := range []string{v1Value, v2Value} {
+		if decString, err := cim.DecryptString(value); err != nil || decString != "secret-01" {
+			t.Errorf("DecryptString(%s) = (%s, %v), want secret-01", value, decString, err)
+		}
+	}
+	if decString, err := cim.DecryptString(legacyValue); err != nil || decString != "secret-02" {
+		t.Errorf("DecryptString(untagged) = (%s, %v), want secret-02", decString, err)
+	}
+	if _, err := cim.DecryptString("$v9$" + strings.TrimPrefix(v2Value, "$v2$")); err == nil {
+		t.Errorf("decrypted with an unknown key ID")
+	}
+
+	// re-encrypted with the active key(v2)
+	for _, value := range []string{v1Value, legacyValue} {
+		newValue, reEncrypted, err := cim.ReEncryptString(value)
+		if err != nil || !reEncrypted || !strings.HasPrefix(newValue, "$v2$") {
+			t.Errorf("ReEncryptString(%s) = (%s, %v, %v), want a v2 value", value, newValue, reEncrypted, err)
+		}
+	}
+	if newValue, reEncrypted, err := cim.ReEncryptString(v2Value); err != nil || reEncrypted || newValue != v2Value {
+		t.Errorf("ReEncryptString(v2 value) = (%s, %v, %v), want the same value", newValue, reEncrypted, err)
+	}
+	if _, _, err := cim.ReEncryptString("$v9$" + strings.TrimPrefix(v2Value, "$v2$")); err == nil {
+		t.Errorf("re-encrypted the value of an unknown key ID")
+	}
+}
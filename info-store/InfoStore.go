@@ -14,6 +14,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
@@ -92,9 +94,10 @@ func (o KVList) Value() (driver.Value, error) {
 	return string(jsonData), nil
 }
 
-type AZList []string
+// StrList type is used for storing a list of string with a json format
+type StrList []string
 
-func (o *AZList) Scan(src any) error {
+func (o *StrList) Scan(src any) error {
 	bytes := []byte(src.(string))
 	err := json.Unmarshal(bytes, o)
 	if err != nil {
@@ -103,7 +106,7 @@ func (o *AZList) Scan(src any) error {
 	return nil
 }
 
-func (o AZList) Value() (driver.Value, error) {
+func (o StrList) Value() (driver.Value, error) {
 	if len(o) == 0 {
 		return nil, nil
 	}
@@ -114,6 +117,9 @@ func (o AZList) Value() (driver.Value, error) {
 	return string(jsonData), nil
 }
 
+// AZList type is used for storing a list of availability zones with a json format
+type AZList = StrList
+
 // Meta DB Opener
 func Open() (*gorm.DB, error) {
 
@@ -128,6 +134,19 @@ func Open() (*gorm.DB, error) {
 	return db, nil
 }
 
+// OpenFile opens another DB file in the meta DB directory,
+// ex) a store of many writes not to lock the meta DB.
+func OpenFile(fileName string) (*gorm.DB, error) {
+	filePath := filepath.Join(filepath.Dir(DB_FILE_PATH), fileName)
+	db, err := gorm.Open(sqlite.Open(filePath), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, err
+	}
+
+	return db, nil
+}
+
 // Meta DB Closer
 func Close(db *gorm.DB) error {
 	sqlDB, err := db.DB()
@@ -529,6 +548,30 @@ func CountNameIDsByConnection(info interface{}, connectionName string) (int64, e
 	return count, nil
 }
 
+// CountNameIDsGroupByConnection counts name_ids in a model by connection_name with one query
+// ex) {"aws-config01": 3, "gcp-config01": 1}, the connections without name_ids are not included
+func CountNameIDsGroupByConnection(info interface{}) (map[string]int64, error) {
+	db, err := Open()
+	if err != nil {
+		return nil, err
+	}
+	defer Close(db)
+
+	var resultList []struct {
+		ConnectionName string
+		Count          int64
+	}
+	if err := db.Model(&info).Select("connection_name, COUNT(*) AS count").Group("connection_name").Scan(&resultList).Error; err != nil {
+		return nil, err
+	}
+
+	countMap := map[string]int64{}
+	for _, result := range resultList {
+		countMap[result.ConnectionName] = result.Count
+	}
+	return countMap, nil
+}
+
 // ListNameIDByConnection retrieves a list of name_ids in a model filtered by connection_name
 func ListNameIDByConnection(info interface{}, connectionName string) ([]string, error) {
 	db, err := Open()
@@ -544,3 +587,19 @@ func ListNameIDByConnection(info interface{}, connectionName string) ([]string,
 
 	return nameIds, nil
 }
+
+// DeleteBefore deletes all Infos whose time column is before the given time, ex) expired keys
+func DeleteBefore(info interface{}, columnName string, before time.Time) (int64, error) {
+	db, err := Open()
+	if err != nil {
+		return 0, err
+	}
+	defer Close(db)
+
+	result := db.Where(columnName+" < ?", before).Delete(info)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,30 @@
+#!/bin/bash
+
+# Append a new credential encryption key to the key file.
+# The last key of the file becomes the active key when SPIDER_ENCRYPTION_KEY_ID is not set,
+# and the running server reloads the changed key file.
+#
+# The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+# The CB-Spider Mission is to connect all the clouds with a single interface.
+#
+#      * Cloud-Barista: https://github.com/cloud-barista
+#
+# by CB-Spider Team, 2024.
+
+# ex) ./1.add_key.sh v2
+KEY_ID=$1
+KEY_FILE=${SPIDER_ENCRYPTION_KEY_FILE:-$CBSPIDER_ROOT/meta_db/encryption.key}
+
+if [ -z "$KEY_ID" ]; then
+	echo "usage: $0 <key ID>, ex) $0 v2"
+	exit 1
+fi
+
+if [ -f "$KEY_FILE" ] && grep -q "^${KEY_ID}=" "$KEY_FILE"; then
+	echo "the key ID '${KEY_ID}' already exists in ${KEY_FILE}"
+	exit 1
+fi
+
+umask 077
+echo "${KEY_ID}=$(head -c 32 /dev/urandom | base64)" >> "$KEY_FILE"
+echo "the key '${KEY_ID}' is added to ${KEY_FILE}"
@@ -0,0 +1,29 @@
+#!/bin/bash
+
+# Re-encrypt all the stored credentials and private keys with the active encryption key.
+# The server keeps serving during the rotation.
+# Remove the old keys from the key file after the rotation.
+#
+# The CB-Spider is a sub-Framework of the Cloud-Barista Multi-Cloud Project.
+# The CB-Spider Mission is to connect all the clouds with a single interface.
+#
+#      * Cloud-Barista: https://github.com/cloud-barista
+#
+# by CB-Spider Team, 2024.
+
+# ex) SPIDER_SCHEME=https SPIDER_CA_FILE=$CBSPIDER_ROOT/cert/ca.crt ./2.rotate_key.sh
+SERVER=${SPIDER_SERVER:-localhost:1024}
+AUTH=""
+if [ -n "$API_USERNAME" ]; then
+	AUTH="-u $API_USERNAME:$API_PASSWORD"
+fi
+# CA certificates file(PEM) to verify the server certificate of HTTPS
+CACERT=""
+if [ -n "$SPIDER_CA_FILE" ]; then
+	CACERT="--cacert $SPIDER_CA_FILE"
+fi
+
+curl -s $CACERT $AUTH -X POST ${SPIDER_SCHEME:-http}://$SERVER/spider/encryptionkey/rotate -H 'Content-Type: application/json' |json_pp
+
+# the key IDs used by the values of each store, only the active key remains after the rotation
+curl -s $CACERT $AUTH -X GET ${SPIDER_SCHEME:-http}://$SERVER/spider/encryptionkey |json_pp